@@ -32,6 +32,42 @@ type TTLResourceSpec struct {
 	// Foo string `json:"foo,omitempty"`
 
 	TTLSeconds int `json:"ttlSeconds"` // TTL 시간 (초)
+
+	TTL *metav1.Duration `json:"ttl,omitempty"` // ms 단위의 정밀한 TTL (예: 500ms). 지정하면 만료 계산에 ttlSeconds 대신 사용 (ttlSeconds는 올림한 값)
+
+	StartTime *metav1.Time `json:"startTime,omitempty"` // TTL 카운트다운 기준 시각 (없으면 TTLResource 생성 시각)
+
+	KeepAfterExpiry *bool `json:"keepAfterExpiry,omitempty"` // owner 삭제 후에도 TTLResource를 남길지 여부 (없으면 --retain-expired 설정을 따름)
+
+	// +kubebuilder:validation:Minimum=0
+	MaxDeletionRetries int `json:"maxDeletionRetries,omitempty"` // 삭제 실패 후 재시도 횟수 상한 (0이면 --deletion-max-retries 설정을 따름). 넘으면 DeletionAbandoned condition을 남기고 포기
+
+	JitterSeconds int `json:"jitterSeconds,omitempty"` // 만료 시각에 더할 무작위 지연의 최댓값 (초, 리소스 UID로 고정된 값)
+
+	TargetRef *TargetReference `json:"targetRef,omitempty"` // 만료 시 삭제할 리소스 (OwnerReference 없이 직접 작성한 TTLResource용, 같은 네임스페이스)
+
+	TargetSelector *TargetSelector `json:"targetSelector,omitempty"` // 만료 시 label이 일치하는 리소스를 모두 삭제 (같은 네임스페이스의 지정한 Kind, 대량 만료용)
+
+	// +kubebuilder:validation:items:Pattern=`^(https?|nats)://.+`
+	NotifyTargets []string `json:"notifyTargets,omitempty"` // 수명 주기 이벤트를 보낼 알림 대상 (http(s) webhook 또는 nats://host:port/subject, 없으면 전역 event sink)
+}
+
+// TargetReference는 TTLResource와 같은 네임스페이스에 있는 삭제 대상 리소스를 가리킵니다.
+type TargetReference struct {
+	APIVersion string `json:"apiVersion"` // 대상 리소스의 API 버전 (예: v1, apps/v1)
+
+	Kind string `json:"kind"` // 대상 리소스의 Kind (예: Pod, Deployment)
+
+	Name string `json:"name"` // 대상 리소스의 이름
+}
+
+// TargetSelector는 TTLResource와 같은 네임스페이스에서 label selector와 일치하는 삭제 대상 리소스를 고릅니다.
+type TargetSelector struct {
+	APIVersion string `json:"apiVersion"` // 대상 리소스의 API 버전 (예: v1, apps/v1)
+
+	Kind string `json:"kind"` // 대상 리소스의 Kind (예: Pod, Deployment)
+
+	Selector metav1.LabelSelector `json:"selector"` // 대상 리소스의 label selector (비어 있으면 거부)
 }
 
 // TTLResourceStatus defines the observed state of TTLResource.
@@ -42,10 +78,33 @@ type TTLResourceStatus struct {
 	Expired   bool         `json:"expired"`             // TTL 시간이 만료되었는지 여부
 	CreatedAt metav1.Time  `json:"createdAt"`           // 리소스가 실제로 생성된 시각
 	ExpiredAt *metav1.Time `json:"expiredAt,omitempty"` // TTL 만료 시각
+	DeletedAt *metav1.Time `json:"deletedAt,omitempty"` // 보존 모드에서 owner 삭제가 끝난 시각
+
+	JitterSeconds int `json:"jitterSeconds,omitempty"` // 만료 시각에 실제로 더한 지연 (초, 0 ~ spec.jitterSeconds)
+
+	RemainingDeletions int `json:"remainingDeletions,omitempty"` // 배치 삭제 중 아직 삭제하지 않은 대상 수
+
+	RetryCount int `json:"retryCount,omitempty"` // 오류로 owner 삭제에 실패한 횟수
+
+	DeferReason string `json:"deferReason,omitempty"` // 만료되었지만 삭제를 미루고 있는 사유 (예: "BlockedByPDB: ...")
+
+	LastRequeueReason string `json:"lastRequeueReason,omitempty"` // 마지막으로 다시 reconcile하도록 예약한 이유 (예: "waiting for expiry", "conflict retry")
+
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"` // 삭제 지연 사유 등 TTLResource의 상태 조건
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="TTL",type=integer,JSONPath=`.spec.ttlSeconds`
+// +kubebuilder:printcolumn:name="Expired",type=boolean,JSONPath=`.status.expired`
+// +kubebuilder:printcolumn:name="ExpiredAt",type=date,JSONPath=`.status.expiredAt`
+// +kubebuilder:printcolumn:name="DeletedAt",type=date,JSONPath=`.status.deletedAt`
+// +kubebuilder:printcolumn:name="Retries",type=integer,JSONPath=`.status.retryCount`
+// +kubebuilder:printcolumn:name="Abandoned",type=string,JSONPath=`.status.conditions[?(@.type=="DeletionAbandoned")].status`,priority=1
+// +kubebuilder:printcolumn:name="Deferred",type=string,JSONPath=`.status.deferReason`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // TTLResource is the Schema for the ttlresources API.
 type TTLResource struct {
@@ -0,0 +1,82 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TTLSummarySpec defines the desired state of TTLSummary.
+type TTLSummarySpec struct {
+	ClusterName string `json:"clusterName,omitempty"` // 중앙 집계 컨트롤러가 클러스터를 구분할 이름 (operator가 채움)
+}
+
+// TTLDeletionRecord는 최근에 owner 삭제가 끝난 TTLResource 하나를 나타냅니다.
+type TTLDeletionRecord struct {
+	Namespace string      `json:"namespace"`           // TTLResource의 네임스페이스
+	Name      string      `json:"name"`                // TTLResource의 이름
+	OwnerKind string      `json:"ownerKind,omitempty"` // 삭제된 owner의 Kind
+	OwnerName string      `json:"ownerName,omitempty"` // 삭제된 owner의 이름
+	DeletedAt metav1.Time `json:"deletedAt"`           // owner 삭제가 끝난 시각
+}
+
+// TTLSummaryStatus defines the observed state of TTLSummary.
+type TTLSummaryStatus struct {
+	Total    int `json:"total"`              // 클러스터 전체 TTLResource 수
+	Pending  int `json:"pending,omitempty"`  // 아직 만료되지 않은 TTLResource 수
+	Expired  int `json:"expired,omitempty"`  // 만료되었지만 아직 owner 삭제가 끝나지 않은 TTLResource 수
+	Deferred int `json:"deferred,omitempty"` // 만료되었지만 삭제를 미루고 있는 TTLResource 수 (expired에 포함)
+	Retained int `json:"retained,omitempty"` // 보존 모드에서 owner 삭제가 끝나 남아 있는 TTLResource 수
+
+	NextExpiryAt       *metav1.Time `json:"nextExpiryAt,omitempty"`       // 가장 먼저 만료될 TTLResource의 만료 시각
+	NextExpiryResource string       `json:"nextExpiryResource,omitempty"` // 가장 먼저 만료될 TTLResource (<namespace>/<name>)
+
+	RecentDeletions []TTLDeletionRecord `json:"recentDeletions,omitempty"` // 최근 owner 삭제 기록 (최신순, 보존된 TTLResource 기준)
+
+	LastUpdated metav1.Time `json:"lastUpdated"` // 마지막 집계 시각
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Total",type=integer,JSONPath=`.status.total`
+// +kubebuilder:printcolumn:name="Pending",type=integer,JSONPath=`.status.pending`
+// +kubebuilder:printcolumn:name="Expired",type=integer,JSONPath=`.status.expired`
+// +kubebuilder:printcolumn:name="NextExpiry",type=date,JSONPath=`.status.nextExpiryAt`
+// +kubebuilder:printcolumn:name="Updated",type=date,JSONPath=`.status.lastUpdated`
+
+// TTLSummary is the Schema for the ttlsummaries API.
+type TTLSummary struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TTLSummarySpec   `json:"spec,omitempty"`
+	Status TTLSummaryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TTLSummaryList contains a list of TTLSummary.
+type TTLSummaryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TTLSummary `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TTLSummary{}, &TTLSummaryList{})
+}
@@ -21,15 +21,32 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TTLDeletionRecord) DeepCopyInto(out *TTLDeletionRecord) {
+	*out = *in
+	in.DeletedAt.DeepCopyInto(&out.DeletedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TTLDeletionRecord.
+func (in *TTLDeletionRecord) DeepCopy() *TTLDeletionRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(TTLDeletionRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TTLResource) DeepCopyInto(out *TTLResource) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -86,6 +103,35 @@ func (in *TTLResourceList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TTLResourceSpec) DeepCopyInto(out *TTLResourceSpec) {
 	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.KeepAfterExpiry != nil {
+		in, out := &in.KeepAfterExpiry, &out.KeepAfterExpiry
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TargetRef != nil {
+		in, out := &in.TargetRef, &out.TargetRef
+		*out = new(TargetReference)
+		**out = **in
+	}
+	if in.TargetSelector != nil {
+		in, out := &in.TargetSelector, &out.TargetSelector
+		*out = new(TargetSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NotifyTargets != nil {
+		in, out := &in.NotifyTargets, &out.NotifyTargets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TTLResourceSpec.
@@ -106,6 +152,17 @@ func (in *TTLResourceStatus) DeepCopyInto(out *TTLResourceStatus) {
 		in, out := &in.ExpiredAt, &out.ExpiredAt
 		*out = (*in).DeepCopy()
 	}
+	if in.DeletedAt != nil {
+		in, out := &in.DeletedAt, &out.DeletedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TTLResourceStatus.
@@ -117,3 +174,135 @@ func (in *TTLResourceStatus) DeepCopy() *TTLResourceStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TTLSummary) DeepCopyInto(out *TTLSummary) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TTLSummary.
+func (in *TTLSummary) DeepCopy() *TTLSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(TTLSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TTLSummary) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TTLSummaryList) DeepCopyInto(out *TTLSummaryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TTLSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TTLSummaryList.
+func (in *TTLSummaryList) DeepCopy() *TTLSummaryList {
+	if in == nil {
+		return nil
+	}
+	out := new(TTLSummaryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TTLSummaryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TTLSummarySpec) DeepCopyInto(out *TTLSummarySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TTLSummarySpec.
+func (in *TTLSummarySpec) DeepCopy() *TTLSummarySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TTLSummarySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TTLSummaryStatus) DeepCopyInto(out *TTLSummaryStatus) {
+	*out = *in
+	if in.NextExpiryAt != nil {
+		in, out := &in.NextExpiryAt, &out.NextExpiryAt
+		*out = (*in).DeepCopy()
+	}
+	if in.RecentDeletions != nil {
+		in, out := &in.RecentDeletions, &out.RecentDeletions
+		*out = make([]TTLDeletionRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastUpdated.DeepCopyInto(&out.LastUpdated)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TTLSummaryStatus.
+func (in *TTLSummaryStatus) DeepCopy() *TTLSummaryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TTLSummaryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetReference) DeepCopyInto(out *TargetReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetReference.
+func (in *TargetReference) DeepCopy() *TargetReference {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetSelector) DeepCopyInto(out *TargetSelector) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSelector.
+func (in *TargetSelector) DeepCopy() *TargetSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetSelector)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// audit는 클러스터의 TTL 관련 annotation을 점검하여 Operator가 무시하는 잘못된 값을 표로 출력합니다.
+// 문제가 발견되면 종료 코드 1로 끝나므로 CI에서도 사용할 수 있습니다.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/seoyeon0201/ttl-operator/internal/controller"
+)
+
+func main() {
+	var namespace, notifyAllowedHosts string
+	flag.StringVar(&namespace, "namespace", "", "Only audit this namespace. Empty audits all namespaces.")
+	flag.StringVar(&notifyAllowedHosts, "notify-allowed-hosts", "",
+		"Comma-separated hosts allowed in notify annotations, the same value as the operator's --notify-allowed-hosts.")
+	flag.Parse()
+
+	c, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: clientgoscheme.Scheme})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to create client: %v\n", err)
+		os.Exit(2)
+	}
+
+	issues, err := controller.AuditAnnotations(context.Background(), c, namespace, splitList(notifyAllowedHosts))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "audit failed: %v\n", err)
+		os.Exit(2)
+	}
+	if len(issues) == 0 {
+		fmt.Println("No invalid TTL annotations found.")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "RESOURCE\tANNOTATION\tVALUE\tREASON")
+	for _, issue := range issues {
+		resource := issue.Kind + "/" + issue.Name
+		if issue.Namespace != "" {
+			resource = issue.Namespace + "/" + resource
+		}
+		fmt.Fprintf(w, "%s\t%s\t%q\t%s\n", resource, issue.Annotation, issue.Value, issue.Reason)
+	}
+	_ = w.Flush()
+	os.Exit(1)
+}
+
+// splitList는 쉼표로 구분된 값을 공백과 빈 항목을 제외한 목록으로 나눕니다.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
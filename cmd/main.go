@@ -17,20 +17,30 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
+	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	"github.com/nats-io/nats.go"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
@@ -39,6 +49,7 @@ import (
 
 	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
 	"github.com/seoyeon0201/ttl-operator/internal/controller"
+	webhookv1 "github.com/seoyeon0201/ttl-operator/internal/webhook/v1"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -47,8 +58,16 @@ var (
 	setupLog = ctrl.Log.WithName("setup")
 )
 
+// version, gitCommit, buildDate는 빌드 시 -ldflags "-X main.version=..."로 채워집니다 (Makefile의 LDFLAGS 참고)
+var (
+	version   = "dev"
+	gitCommit = "unknown"
+	buildDate = "unknown"
+)
+
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
 
 	utilruntime.Must(ttlv1alpha1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
@@ -63,6 +82,75 @@ func main() {
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var selfTest bool
+	var selfTestNamespace string
+	var selfTestTimeout time.Duration
+	var selfTestTTLSeconds int
+	var conflictLogBurst int
+	var conflictLogInterval time.Duration
+	var maxDeletesPerCycle int
+	var ttlConflictPolicy string
+	var targetRefPrecedence string
+	var cleanupSweepInterval time.Duration
+	var listPageSize int64
+	var respectPDB bool
+	var eventSinkNATSURL, eventSinkSubject string
+	var eventSinkNATSCreds, eventSinkNATSCA string
+	var eventSinkBuffer int
+	var notifyAllowedHosts string
+	var deletionLogConfigMap string
+	var deletionLogMaxEntries int
+	var statusSubresourceFallback bool
+	var trashNamespace string
+	var trashTTL time.Duration
+	var expiryAuditLog bool
+	var deletionMaxRetries int
+	var deletionRetryBackoff time.Duration
+	var enableEndpointKinds bool
+	var enableTTLFromSecret bool
+	var zeroTTLBehavior string
+	var extendMinRemaining time.Duration
+	var maxInflightDeletions int
+	var maxDeletionsPerWindow int
+	var deletionWindow time.Duration
+	var soakUntilValue string
+	var requireDeleteOptIn bool
+	var deletionGracePeriod time.Duration
+	var excludeSelector, excludeAnnotations string
+	var ttlResourceNameTemplate string
+	var deletionOrder string
+	var defaultTTL time.Duration
+	var defaultTTLNamespaces, defaultTTLSelector string
+	var retainExpired bool
+	var ownerTraversalDepth int
+	var kindDeletionPolicies string
+	var confirmDeleteNamespaces string
+	var skipPodsControlledBy string
+	var overdueCheckInterval time.Duration
+	var summaryInterval time.Duration
+	var summaryClusterName string
+	var summaryRecentDeletions int
+	var importJobTTL bool
+	var excludedNamespaces string
+	var enableWebhooks bool
+	var webhookExpiryWarnings bool
+	var quotaPressureThreshold float64
+	var quotaPressureInterval time.Duration
+	var quotaPressureMaxPerCycle int
+	var maxRequeueAfter time.Duration
+	var rateLimiterBaseDelay, rateLimiterMaxDelay time.Duration
+	var rateLimiterQPS, rateLimiterJitter float64
+	var rateLimiterBurst int
+	var allowIgnoreSuspend bool
+	var activityPrometheusURL, activityQuery, activityKinds string
+	var activityThreshold float64
+	var activityRecheckInterval time.Duration
+	var keepAliveLabel string
+	var migrateAnnotations string
+	var ownerConditions bool
+	var annotateOwners bool
+	var rbacCheckInterval time.Duration
+	var allowOperatorNamespace bool
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -81,6 +169,207 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.BoolVar(&selfTest, "self-test", false,
+		"If set, create a canary ConfigMap with a short TTL on startup and report whether it gets deleted.")
+	flag.StringVar(&selfTestNamespace, "self-test-namespace", "default",
+		"The namespace in which the self-test canary ConfigMap is created.")
+	flag.DurationVar(&selfTestTimeout, "self-test-timeout", 2*time.Minute,
+		"How long the self-test waits for the canary ConfigMap to be deleted before reporting failure.")
+	flag.IntVar(&selfTestTTLSeconds, "self-test-ttl-seconds", 5,
+		"The TTL in seconds set on the self-test canary ConfigMap. Must be shorter than --self-test-timeout.")
+	flag.IntVar(&conflictLogBurst, "conflict-log-burst", 10,
+		"Maximum number of conflict log lines emitted per --conflict-log-interval. Set to 0 to log every conflict.")
+	flag.DurationVar(&conflictLogInterval, "conflict-log-interval", 30*time.Second,
+		"The sampling window for conflict log lines. A summary of suppressed lines is logged once per window.")
+	flag.IntVar(&maxDeletesPerCycle, "max-deletes-per-reconcile", 50,
+		"Maximum number of objects deleted in a single reconcile; the rest are deleted in later reconciles. "+
+			"Set to 0 for no limit.")
+	flag.StringVar(&ttlConflictPolicy, "ttl-conflict-policy", controller.TTLPolicyPodWins,
+		"How to combine a Pod's TTL with the TTL of the Deployment that manages it: "+
+			"pod-wins, owner-wins, min or max.")
+	flag.StringVar(&targetRefPrecedence, "target-ref-precedence", controller.TargetRefPrecedenceAuto,
+		"Which reference to follow when a TTLResource has both spec.targetRef and ownerReferences: "+
+			"auto (targetRef for hand-authored TTLResources, ownerReferences for controller-created ones), "+
+			"target-ref or owner-references.")
+	flag.DurationVar(&cleanupSweepInterval, "cleanup-sweep-interval", time.Hour,
+		"How often orphaned TTLResources are swept. A sweep always runs on startup; set to 0 to sweep only then.")
+	flag.Int64Var(&listPageSize, "list-page-size", controller.DefaultListPageSize,
+		"Maximum number of TTLResources fetched per list call during cleanup sweeps.")
+	flag.IntVar(&maxInflightDeletions, "max-inflight-deletions", 0,
+		"Maximum number of deletion API calls in flight at once across the whole operator (resource reconciles and "+
+			"cleanup sweeps). Set to 0 for no limit.")
+	flag.IntVar(&maxDeletionsPerWindow, "max-deletions-per-window", 0,
+		"Maximum number of expired owners deleted across the whole operator per --deletion-window. Further deletions are "+
+			"requeued until the window has room again and counted in ttl_deletions_throttled_total. Set to 0 for no limit.")
+	flag.DurationVar(&deletionWindow, "deletion-window", time.Minute,
+		"Sliding window used by --max-deletions-per-window.")
+	flag.DurationVar(&overdueCheckInterval, "overdue-check-interval", time.Minute,
+		"Interval for updating the ttl_resources_overdue metric (TTLResources past expiry whose owner still exists). "+
+			"0 disables it.")
+	flag.DurationVar(&rbacCheckInterval, "rbac-check-interval", 10*time.Minute,
+		"Interval for checking with SelfSubjectAccessReviews whether the operator may delete each watched kind. "+
+			"TTLResources are not created for kinds it cannot delete until the permission is granted. 0 disables the check.")
+	flag.DurationVar(&summaryInterval, "ttl-summary-interval", 0,
+		"If greater than 0, write counts, the next expiry and recent deletions of all TTLResources to the "+
+			"cluster-scoped TTLSummary \"cluster\" at this interval. 0 disables the summary.")
+	flag.StringVar(&summaryClusterName, "ttl-summary-cluster-name", "",
+		"The cluster name recorded in spec.clusterName of the TTLSummary, for central aggregation across clusters.")
+	flag.IntVar(&summaryRecentDeletions, "ttl-summary-recent-deletions", controller.DefaultSummaryRecentDeletions,
+		"Maximum number of recent deletions recorded in the TTLSummary.")
+	flag.BoolVar(&respectPDB, "respect-pdb", false,
+		"If set, expired Pods are removed through the Eviction API so that PodDisruptionBudgets are honored.")
+	flag.StringVar(&eventSinkNATSURL, "event-sink-nats-url", os.Getenv("TTL_EVENT_SINK_NATS_URL"),
+		"If set, TTL lifecycle events are published to this NATS server (e.g. nats://nats:4222). "+
+			"Defaults to $TTL_EVENT_SINK_NATS_URL.")
+	flag.StringVar(&eventSinkSubject, "event-sink-subject", envOrDefault("TTL_EVENT_SINK_SUBJECT", "ttl.events"),
+		"The subject lifecycle events are published to. Defaults to $TTL_EVENT_SINK_SUBJECT or ttl.events.")
+	flag.StringVar(&eventSinkNATSCreds, "event-sink-nats-creds", "",
+		"Path to a NATS credentials (.creds) file used to authenticate to --event-sink-nats-url.")
+	flag.StringVar(&eventSinkNATSCA, "event-sink-nats-ca", "",
+		"Path to a PEM CA bundle used to verify the TLS certificate of --event-sink-nats-url (use a tls:// URL).")
+	flag.IntVar(&eventSinkBuffer, "event-sink-buffer", 1000,
+		"Number of lifecycle events buffered before new events are dropped.")
+	flag.StringVar(&notifyAllowedHosts, "notify-allowed-hosts", "",
+		"Comma-separated hosts (host or host:port) that the ttl.example.com/notify annotation may send lifecycle events to. "+
+			"Targets on any other host are rejected so that annotations cannot make the operator call internal services. "+
+			"Empty disables per-resource notification targets.")
+	flag.StringVar(&deletionLogConfigMap, "deletion-log-configmap", "",
+		"If set, every owner deleted on expiry is recorded with its time, kind, name and reason in this ConfigMap "+
+			"([namespace/]name, the operator's namespace by default) as a longer-lived audit trail than Events. "+
+			"Writes are best-effort and never block deletion.")
+	flag.IntVar(&deletionLogMaxEntries, "deletion-log-max-entries", controller.DefaultDeletionLogMaxEntries,
+		"Maximum number of records kept in the --deletion-log-configmap. Older records are dropped first.")
+	flag.IntVar(&ownerTraversalDepth, "owner-traversal-depth", 0,
+		"How many controller owner levels a resource without a TTL annotation follows to inherit an ancestor's TTL "+
+			"(e.g. 2 for Pod -> ReplicaSet -> Deployment). Set to 0 to disable.")
+	flag.StringVar(&kindDeletionPolicies, "kind-deletion-policies", "",
+		"Per-kind default propagation policy and expiry action as Kind=Policy[/action],... "+
+			"(e.g. Deployment=Background,Pod=Background/evict). Unlisted kinds keep the built-in defaults.")
+	flag.StringVar(&confirmDeleteNamespaces, "confirm-delete-namespaces", "",
+		"Comma-separated namespaces where expired owners are deleted only after the TTLResource is annotated "+
+			"with ttl.example.com/confirm-delete=\"true\".")
+	flag.StringVar(&skipPodsControlledBy, "skip-pods-controlled-by", "",
+		"Comma-separated controller kinds (e.g. ReplicaSet,Job) whose Pods are not managed by TTL. "+
+			"Pods controlled by these kinds get no TTLResource.")
+	flag.StringVar(&excludeSelector, "exclude-selector", "",
+		"Label selector (e.g. app.kubernetes.io/part-of=platform) for resources that are never managed by TTL, "+
+			"in any namespace, even if they carry a TTL annotation.")
+	flag.StringVar(&excludeAnnotations, "exclude-annotations", "",
+		"Comma-separated annotations (key or key=value) marking resources that are never managed by TTL, in any namespace.")
+	flag.DurationVar(&defaultTTL, "default-ttl", 0,
+		"Cluster-wide default TTL (e.g. 72h) for resources without a TTL annotation or namespace default. "+
+			"Resources opt out with ttl.example.com/ttl-seconds set to \"disabled\" or \"0\". 0 disables the default.")
+	flag.StringVar(&defaultTTLNamespaces, "default-ttl-namespaces", "",
+		"Comma-separated namespaces the --default-ttl applies to. Empty applies it to all namespaces.")
+	flag.StringVar(&defaultTTLSelector, "default-ttl-selector", "",
+		"Label selector restricting which resources the --default-ttl applies to. Empty applies it to all resources.")
+	flag.BoolVar(&importJobTTL, "import-job-ttl", false,
+		"Mirror spec.ttlSecondsAfterFinished of Jobs without a TTL annotation into a TTLResource that expires "+
+			"at the same time as the built-in TTL-after-finished controller.")
+	flag.StringVar(&excludedNamespaces, "excluded-namespaces", "",
+		"Comma-separated namespaces where TTL deletions are disabled. TTL annotations there have no effect.")
+	flag.BoolVar(&allowOperatorNamespace, "allow-operator-namespace", false,
+		"If set, resources in the namespace the operator runs in (POD_NAMESPACE or the service account namespace) "+
+			"are processed like any other namespace. By default they are always skipped to prevent the operator from deleting itself.")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"If set, serve the validating webhook that warns when a TTL annotation is added in an excluded namespace. "+
+			"Requires the webhook certificate (see --webhook-cert-path).")
+	flag.BoolVar(&webhookExpiryWarnings, "webhook-expiry-warnings", true,
+		"If set, the validating webhook also warns with the computed expiry time when a resource is created with a TTL annotation.")
+	flag.Float64Var(&quotaPressureThreshold, "quota-pressure-threshold", 0,
+		"If greater than 0, expire the oldest TTLResources early in namespaces where any ResourceQuota usage "+
+			"reaches this fraction of its hard limit (e.g. 0.9). 0 disables quota pressure mode.")
+	flag.DurationVar(&quotaPressureInterval, "quota-pressure-interval", 5*time.Minute,
+		"Interval for checking ResourceQuota usage in quota pressure mode.")
+	flag.IntVar(&quotaPressureMaxPerCycle, "quota-pressure-max-per-cycle", controller.DefaultQuotaPressureMaxPerCycle,
+		"Maximum number of TTLResources expired early per namespace in each quota pressure check.")
+	flag.DurationVar(&maxRequeueAfter, "max-requeue-after", time.Hour,
+		"Maximum delay before a TTLResource waiting for expiry is re-evaluated. Long TTLs are checked at this interval "+
+			"instead of through one far-future requeue. 0 disables the cap.")
+	flag.DurationVar(&rateLimiterBaseDelay, "rate-limiter-base-delay", controller.DefaultRateLimiterBaseDelay,
+		"Initial retry delay for a resource whose reconcile hit a conflict. The delay doubles on each consecutive conflict.")
+	flag.DurationVar(&rateLimiterMaxDelay, "rate-limiter-max-delay", controller.DefaultRateLimiterMaxDelay,
+		"Maximum retry delay for a resource that keeps conflicting.")
+	flag.Float64Var(&rateLimiterJitter, "rate-limiter-jitter", 0,
+		"Maximum random fraction (e.g. 0.2 for up to +20%) added to the per-resource conflict retry delay so that "+
+			"resources that conflicted together do not retry together. 0 disables jitter.")
+	flag.Float64Var(&rateLimiterQPS, "rate-limiter-qps", controller.DefaultRateLimiterQPS,
+		"Overall rate (per second) at which rate-limited reconciles are retried across all resources.")
+	flag.IntVar(&rateLimiterBurst, "rate-limiter-burst", controller.DefaultRateLimiterBurst,
+		"Burst size for the overall reconcile retry rate limit.")
+	flag.BoolVar(&statusSubresourceFallback, "status-subresource-fallback", false,
+		"If set, write TTLResource status with regular updates when the installed CRD has no status subresource "+
+			"instead of exiting at startup.")
+	flag.StringVar(&trashNamespace, "trash-namespace", "",
+		"Namespace where owners with the trash expiry action are copied before the original is deleted. "+
+			"If empty, the trash action deletes without keeping a copy.")
+	flag.DurationVar(&trashTTL, "trash-ttl", 7*24*time.Hour,
+		"TTL set on copies in the trash namespace so they are eventually purged. 0 keeps copies until removed manually.")
+	flag.BoolVar(&expiryAuditLog, "expiry-audit-log", true,
+		"If set, log one structured \"Expiry audit\" line per expiry with the TTL source, computed expiredAt, "+
+			"actual time and the action taken on each owner.")
+	flag.IntVar(&deletionMaxRetries, "deletion-max-retries", controller.DefaultDeletionMaxRetries,
+		"Maximum number of times a failed owner deletion is retried before giving up and leaving the TTLResource "+
+			"with a DeletionAbandoned condition. TTLResources can override it with spec.maxDeletionRetries. "+
+			"0 retries until the deletion succeeds.")
+	flag.DurationVar(&deletionRetryBackoff, "deletion-retry-backoff", 30*time.Second,
+		"Delay before retrying a failed owner deletion. Doubles on each failure up to 10m.")
+	flag.BoolVar(&enableEndpointKinds, "enable-endpoint-kinds", false,
+		"If set, also watch Endpoints and EndpointSlices and apply the TTL annotation to them.")
+	flag.BoolVar(&enableTTLFromSecret, "enable-ttl-from-secret", false,
+		"If set, apply the ttl.example.com/ttl-from-secret annotation. Secret metadata is watched and referenced "+
+			"Secrets are read directly from the API server; requires the RBAC in config/rbac/ttl_from_secret_role.yaml.")
+	flag.StringVar(&zeroTTLBehavior, "zero-ttl-behavior", controller.ZeroTTLBehaviorNeverExpire,
+		"How to treat TTLResources with spec.ttlSeconds 0: never-expire (ignore them) or invalid "+
+			"(record an InvalidTTL condition and a Warning event).")
+	flag.DurationVar(&extendMinRemaining, "extend-min-remaining", time.Minute,
+		"Minimum lifetime left after a ttl.example.com/extend annotation is applied. If the extended expiry would be "+
+			"sooner, the TTL is raised further so the resource lives at least this long. 0 disables the guarantee.")
+	flag.StringVar(&soakUntilValue, "soak-until", "",
+		"If set to an RFC 3339 timestamp (e.g. 2025-06-01T09:00:00Z), expired resources are not deleted until then; "+
+			"the operator only logs and records WouldDelete events for what it would delete. Deletions resume automatically afterward.")
+	flag.BoolVar(&requireDeleteOptIn, "require-delete-optin", false,
+		"If set, expired owners are only deleted when they carry the label ttl.example.com/allow-delete=true; "+
+			"others are kept and their TTLResource records a NotOptedIn condition.")
+	flag.DurationVar(&deletionGracePeriod, "deletion-grace-period", 0,
+		"How long to wait after a TTL expires before deleting the owner. During this window the owner carries the "+
+			"annotation ttl.example.com/pending-deletion-at. 0 deletes immediately on expiry.")
+	flag.StringVar(&ttlResourceNameTemplate, "ttlresource-name-template", "",
+		"Go template for the names of generated TTLResources, with access to .Kind, .Name, .Namespace and .UID "+
+			"(e.g. '{{.Kind | lower}}-{{.Name}}-ttl'). Must render DNS-1123 names. Empty uses ttl-<name>.")
+	flag.StringVar(&deletionOrder, "deletion-order", controller.DeletionOrderOwnerReferences,
+		"Order in which the owners of an expired TTLResource are deleted, also across --max-deletes-per-reconcile batches: "+
+			"owner-references, oldest-first or newest-first (by creationTimestamp).")
+	flag.BoolVar(&retainExpired, "retain-expired", false,
+		"If set, TTLResources are kept after their owners are deleted and record status.deletedAt for auditing.")
+	flag.BoolVar(&allowIgnoreSuspend, "allow-ignore-suspend", false,
+		"If set, expired resources annotated with ttl.example.com/ignore-suspend=\"true\" are deleted even while their "+
+			"namespace is suspended. Leave unset to make namespace suspend apply to every resource.")
+	flag.StringVar(&activityPrometheusURL, "activity-prometheus-url", "",
+		"Prometheus server used to check whether an expired resource is still in use. If set, deletion is deferred "+
+			"(condition StillActive) while --activity-query returns more than --activity-threshold.")
+	flag.StringVar(&activityQuery, "activity-query",
+		`sum(rate(http_requests_total{namespace="{{.Namespace}}",deployment="{{.Name}}"}[1h]))`,
+		"Go template for the PromQL query measuring the activity of an expired resource, with access to .Kind, .Name "+
+			"and .Namespace. The values of the returned vector are summed.")
+	flag.Float64Var(&activityThreshold, "activity-threshold", 0,
+		"Resources whose --activity-query result is above this value are considered active and are not deleted yet.")
+	flag.StringVar(&activityKinds, "activity-kinds", "Deployment",
+		"Comma-separated kinds whose activity is checked before deletion.")
+	flag.DurationVar(&activityRecheckInterval, "activity-recheck-interval", controller.DefaultActivityRecheckInterval,
+		"How often the activity of an expired resource that is still active is checked again.")
+	flag.StringVar(&keepAliveLabel, "keep-alive-label", "",
+		"Owner label whose value changes restart the TTL countdown (e.g. example.com/heartbeat). Empty disables it.")
+	flag.StringVar(&migrateAnnotations, "migrate-annotations", "",
+		"Comma-separated legacy=current annotation key pairs (e.g. old.example.com/ttl=ttl.example.com/ttl-seconds). "+
+			"Resources still carrying a legacy key get its value moved to the current key and the legacy key removed. "+
+			"A current key that is already set wins. Empty disables the migration.")
+	flag.BoolVar(&ownerConditions, "owner-conditions", false,
+		"If set, write TTLExpiring/TTLExpired conditions to the status.conditions of owners that have such an array "+
+			"(including custom resources). The operator needs patch permission on the owner's status subresource.")
+	flag.BoolVar(&annotateOwners, "annotate-owners", false,
+		"If set, annotate each owner with ttl.example.com/ttlresource pointing at the TTLResource the operator created for it. "+
+			"The annotation is removed when the TTLResource is cleaned up.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -89,6 +378,81 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	if err := controller.ValidateTTLConflictPolicy(ttlConflictPolicy); err != nil {
+		setupLog.Error(err, "invalid --ttl-conflict-policy")
+		os.Exit(1)
+	}
+	if err := controller.ValidateZeroTTLBehavior(zeroTTLBehavior); err != nil {
+		setupLog.Error(err, "invalid --zero-ttl-behavior")
+		os.Exit(1)
+	}
+	excludeLabels, err := labels.Parse(excludeSelector)
+	if err != nil {
+		setupLog.Error(err, "invalid --exclude-selector")
+		os.Exit(1)
+	}
+	excludeAnnotationMatchers, err := controller.ParseExcludeAnnotations(splitList(excludeAnnotations))
+	if err != nil {
+		setupLog.Error(err, "invalid --exclude-annotations")
+		os.Exit(1)
+	}
+	if defaultTTL < 0 {
+		setupLog.Error(nil, "--default-ttl must not be negative", "defaultTTL", defaultTTL.String())
+		os.Exit(1)
+	}
+	defaultTTLLabels, err := labels.Parse(defaultTTLSelector)
+	if err != nil {
+		setupLog.Error(err, "invalid --default-ttl-selector")
+		os.Exit(1)
+	}
+	if defaultTTL > 0 {
+		setupLog.Info("Cluster-wide default TTL enabled: resources without a TTL annotation will expire",
+			"defaultTTL", defaultTTL.String(), "namespaces", defaultTTLNamespaces, "selector", defaultTTLLabels.String())
+	}
+	soakUntil, err := controller.ParseSoakUntil(soakUntilValue)
+	if err != nil {
+		setupLog.Error(err, "invalid --soak-until")
+		os.Exit(1)
+	}
+	if !soakUntil.IsZero() {
+		if remaining := time.Until(soakUntil); remaining > 0 {
+			setupLog.Info("SOAK MODE: expired resources will NOT be deleted until the soak period ends; "+
+				"check WouldDelete events and logs for what would be deleted",
+				"soakUntil", soakUntil, "remaining", remaining.Round(time.Second).String())
+		} else {
+			setupLog.Info("Soak period already ended, deletions are enabled", "soakUntil", soakUntil)
+		}
+	}
+	ttlResourceNamer, err := controller.ParseTTLResourceNameTemplate(ttlResourceNameTemplate)
+	if err != nil {
+		setupLog.Error(err, "invalid --ttlresource-name-template")
+		os.Exit(1)
+	}
+	if err := controller.ValidateTargetRefPrecedence(targetRefPrecedence); err != nil {
+		setupLog.Error(err, "invalid --target-ref-precedence")
+		os.Exit(1)
+	}
+	if err := controller.ValidateDeletionOrder(deletionOrder); err != nil {
+		setupLog.Error(err, "invalid --deletion-order")
+		os.Exit(1)
+	}
+	annotationMigrations, err := controller.ParseAnnotationMigrations(migrateAnnotations)
+	if err != nil {
+		setupLog.Error(err, "invalid --migrate-annotations")
+		os.Exit(1)
+	}
+	deletionPolicies, err := controller.ParseKindDeletionPolicies(kindDeletionPolicies)
+	if err != nil {
+		setupLog.Error(err, "invalid --kind-deletion-policies")
+		os.Exit(1)
+	}
+	for kind, policy := range deletionPolicies {
+		if policy.Action == controller.ExpiryActionTrash && trashNamespace == "" {
+			setupLog.Error(nil, "--kind-deletion-policies uses the trash action but --trash-namespace is not set", "kind", kind)
+			os.Exit(1)
+		}
+	}
+
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -143,6 +507,10 @@ func main() {
 		TLSOpts:       tlsOpts,
 	}
 
+	// 실행 중인 operator의 버전과 켜진 기능을 /version으로 제공 (기능 목록은 컨트롤러를 설정한 뒤 채움)
+	buildInfo := &controller.BuildInfo{Version: version, GitCommit: gitCommit, BuildDate: buildDate}
+	metricsServerOptions.ExtraHandlers = map[string]http.Handler{"/version": buildInfo}
+
 	if secureMetrics {
 		// FilterProvider is used to protect the metrics endpoint with authn/authz.
 		// These configurations ensure that only authorized users and service accounts
@@ -202,15 +570,262 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err := (&controller.ResourceReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
+	// 오래되었거나 잘못 설치된 CRD에는 status subresource가 없어 status 업데이트가 모두 실패하므로 시작 시 확인
+	statusViaUpdate := false
+	statusEnabled, err := controller.StatusSubresourceEnabled(context.Background(), mgr.GetAPIReader())
+	switch {
+	case err != nil:
+		setupLog.Info("Unable to inspect the TTLResource CRD, assuming the status subresource is enabled",
+			"crd", controller.TTLResourceCRDName, "error", err.Error())
+	case statusEnabled:
+		setupLog.Info("TTLResource CRD has the status subresource", "crd", controller.TTLResourceCRDName)
+	case statusSubresourceFallback:
+		setupLog.Info("TTLResource CRD has no status subresource; writing status with regular updates. "+
+			"Reinstall the CRD with 'make install' to fix this", "crd", controller.TTLResourceCRDName)
+		statusViaUpdate = true
+	default:
+		setupLog.Error(nil, "TTLResource CRD has no status subresource, so status updates would fail. "+
+			"Reinstall the CRD with 'make install', or pass --status-subresource-fallback to write status with regular updates",
+			"crd", controller.TTLResourceCRDName)
+		os.Exit(1)
+	}
+
+	// 전역 event sink가 없어도 notify annotation으로 알림 대상을 지정한 리소스의 이벤트는 보냄
+	var globalPublisher controller.Publisher
+	if eventSinkNATSURL != "" {
+		setupLog.Info("Publishing lifecycle events", "url", eventSinkNATSURL, "subject", eventSinkSubject)
+		var natsOptions []nats.Option
+		if eventSinkNATSCreds != "" {
+			natsOptions = append(natsOptions, nats.UserCredentials(eventSinkNATSCreds))
+		}
+		if eventSinkNATSCA != "" {
+			natsOptions = append(natsOptions, nats.RootCAs(eventSinkNATSCA))
+		}
+		globalPublisher = &controller.NATSPublisher{
+			URL:     eventSinkNATSURL,
+			Subject: eventSinkSubject,
+			Options: natsOptions,
+		}
+	}
+	eventSink := controller.NewEventSink(globalPublisher, eventSinkBuffer)
+	eventSink.AllowedHosts = splitList(notifyAllowedHosts)
+	if err := mgr.Add(eventSink); err != nil {
+		setupLog.Error(err, "unable to add event sink to manager")
+		os.Exit(1)
+	}
+
+	// Event는 만료되므로 삭제 이력을 ConfigMap에 오래 남김
+	var deletionLog *controller.DeletionLog
+	if deletionLogConfigMap != "" {
+		namespace, name, found := strings.Cut(deletionLogConfigMap, "/")
+		if !found {
+			namespace, name = controller.DetectOperatorNamespace(), deletionLogConfigMap
+		}
+		if namespace == "" || name == "" {
+			setupLog.Error(nil, "--deletion-log-configmap needs a namespace when the operator's namespace cannot be detected",
+				"value", deletionLogConfigMap)
+			os.Exit(1)
+		}
+		setupLog.Info("Recording deletions", "configMap", namespace+"/"+name, "maxEntries", deletionLogMaxEntries)
+		deletionLog = controller.NewDeletionLog(mgr.GetClient(),
+			types.NamespacedName{Namespace: namespace, Name: name}, deletionLogMaxEntries)
+		if err := mgr.Add(deletionLog); err != nil {
+			setupLog.Error(err, "unable to add deletion log to manager")
+			os.Exit(1)
+		}
+	}
+
+	// 만료된 리소스가 아직 사용 중이면 삭제를 미루도록 활동량을 확인
+	var activityChecker controller.ActivityChecker
+	if activityPrometheusURL != "" {
+		checker, err := controller.NewPrometheusActivityChecker(activityPrometheusURL, activityQuery, activityThreshold,
+			splitList(activityKinds))
+		if err != nil {
+			setupLog.Error(err, "invalid activity check configuration")
+			os.Exit(1)
+		}
+		setupLog.Info("Checking activity before deletion", "url", activityPrometheusURL, "kinds", checker.Kinds)
+		activityChecker = checker
+	}
+
+	// 잘못 붙은 TTL annotation으로 operator 자신이 삭제되지 않도록 operator 네임스페이스는 항상 제외
+	operatorNamespace := ""
+	if !allowOperatorNamespace {
+		operatorNamespace = controller.DetectOperatorNamespace()
+		if operatorNamespace != "" {
+			setupLog.Info("Skipping resources in the operator's own namespace", "namespace", operatorNamespace)
+		} else {
+			setupLog.Info("Could not detect the operator's namespace, it is not excluded from TTL deletion")
+		}
+	}
+
+	// 충돌이 멈춰도 생략된 로그 개수를 요약하도록 주기적으로 구간을 닫음
+	conflictLog := controller.NewLogSampler(conflictLogInterval, conflictLogBurst)
+	if conflictLog != nil {
+		if err := mgr.Add(conflictLog); err != nil {
+			setupLog.Error(err, "unable to add conflict log sampler to manager")
+			os.Exit(1)
+		}
+	}
+
+	// reconcile과 cleanup sweep의 삭제 API 호출을 함께 제한
+	deletionLimiter := controller.NewDeletionLimiter(maxInflightDeletions)
+	resourceReconciler := &controller.ResourceReconciler{
+		// lastModified 기준 TTL이 operator 자신의 수정을 구분할 수 있도록 field manager를 지정
+		Client:                  client.WithFieldOwner(mgr.GetClient(), controller.FieldManager),
+		Scheme:                  mgr.GetScheme(),
+		Recorder:                mgr.GetEventRecorderFor("ttl-operator"),
+		ConflictLog:             conflictLog,
+		MaxDeletesPerCycle:      maxDeletesPerCycle,
+		TTLConflictPolicy:       ttlConflictPolicy,
+		RespectPDB:              respectPDB,
+		Events:                  eventSink,
+		NotifyAllowedHosts:      splitList(notifyAllowedHosts),
+		DeletionLog:             deletionLog,
+		RetainExpired:           retainExpired,
+		OwnerTraversalDepth:     ownerTraversalDepth,
+		KindDeletionPolicies:    deletionPolicies,
+		ConfirmDeleteNamespaces: splitList(confirmDeleteNamespaces),
+		SkipPodsControlledBy:    splitList(skipPodsControlledBy),
+		ExcludeSelector:         excludeLabels,
+		ExcludeAnnotations:      excludeAnnotationMatchers,
+		ImportJobTTL:            importJobTTL,
+		ExcludedNamespaces:      splitList(excludedNamespaces),
+		OperatorNamespace:       operatorNamespace,
+		MaxRequeueAfter:         maxRequeueAfter,
+		RateLimiterBaseDelay:    rateLimiterBaseDelay,
+		RateLimiterMaxDelay:     rateLimiterMaxDelay,
+		RateLimiterJitter:       rateLimiterJitter,
+		RateLimiterQPS:          rateLimiterQPS,
+		RateLimiterBurst:        rateLimiterBurst,
+		TargetRefPrecedence:     targetRefPrecedence,
+		StatusViaUpdate:         statusViaUpdate,
+		TrashNamespace:          trashNamespace,
+		TrashTTL:                trashTTL,
+		ExpiryAuditLog:          expiryAuditLog,
+		DeletionMaxRetries:      deletionMaxRetries,
+		DeletionRetryBackoff:    deletionRetryBackoff,
+		EnableEndpointKinds:     enableEndpointKinds,
+		EnableTTLFromSecret:     enableTTLFromSecret,
+		APIReader:               mgr.GetAPIReader(),
+		ZeroTTLBehavior:         zeroTTLBehavior,
+		ExtendMinRemaining:      extendMinRemaining,
+		DeletionLimiter:         deletionLimiter,
+		SoakUntil:               soakUntil,
+		RequireDeleteOptIn:      requireDeleteOptIn,
+		DeletionGracePeriod:     deletionGracePeriod,
+		TTLResourceNamer:        ttlResourceNamer,
+		DeletionOrder:           deletionOrder,
+		DefaultTTL:              defaultTTL,
+		DefaultTTLNamespaces:    splitList(defaultTTLNamespaces),
+		DefaultTTLSelector:      defaultTTLLabels,
+		AllowIgnoreSuspend:      allowIgnoreSuspend,
+		DeletionBudget:          controller.NewDeletionBudget(maxDeletionsPerWindow, deletionWindow),
+		ActivityChecker:         activityChecker,
+		ActivityRecheckInterval: activityRecheckInterval,
+		KeepAliveLabel:          keepAliveLabel,
+		AnnotationMigrations:    annotationMigrations,
+		OwnerConditions:         ownerConditions,
+		AnnotateOwners:          annotateOwners,
+	}
+	if rbacCheckInterval > 0 {
+		resourceReconciler.DeletePermissions = &controller.DeletePermissionChecker{
+			Client:   mgr.GetClient(),
+			Kinds:    resourceReconciler.Kinds(),
+			Interval: rbacCheckInterval,
+		}
+		setupLog.Info("Adding RBAC delete permission check to manager", "interval", rbacCheckInterval)
+		if err := mgr.Add(resourceReconciler.DeletePermissions); err != nil {
+			setupLog.Error(err, "unable to add RBAC delete permission check to manager")
+			os.Exit(1)
+		}
+	}
+	if err := resourceReconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Resource")
 		os.Exit(1)
 	}
+	if enableWebhooks {
+		if err := webhookv1.SetupTTLAnnotationWebhookWithManager(mgr, splitList(excludedNamespaces), webhookExpiryWarnings,
+			splitList(notifyAllowedHosts)); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "TTLAnnotation")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
+	setupLog.Info("Adding cleanup sweep to manager", "interval", cleanupSweepInterval, "pageSize", listPageSize)
+	if err := mgr.Add(&controller.CleanupSweep{
+		Client:          mgr.GetClient(),
+		Reader:          mgr.GetAPIReader(),
+		Interval:        cleanupSweepInterval,
+		PageSize:        listPageSize,
+		DeletionLimiter: deletionLimiter,
+	}); err != nil {
+		setupLog.Error(err, "unable to add cleanup sweep to manager")
+		os.Exit(1)
+	}
+
+	if overdueCheckInterval > 0 {
+		setupLog.Info("Adding overdue monitor to manager", "interval", overdueCheckInterval)
+		if err := mgr.Add(&controller.OverdueMonitor{
+			Client:   mgr.GetClient(),
+			Interval: overdueCheckInterval,
+		}); err != nil {
+			setupLog.Error(err, "unable to add overdue monitor to manager")
+			os.Exit(1)
+		}
+	}
+
+	if summaryInterval > 0 {
+		setupLog.Info("Adding TTL summary aggregator to manager",
+			"interval", summaryInterval, "clusterName", summaryClusterName)
+		if err := mgr.Add(&controller.SummaryAggregator{
+			Client:          mgr.GetClient(),
+			Interval:        summaryInterval,
+			ClusterName:     summaryClusterName,
+			RecentDeletions: summaryRecentDeletions,
+		}); err != nil {
+			setupLog.Error(err, "unable to add TTL summary aggregator to manager")
+			os.Exit(1)
+		}
+	}
+
+	if quotaPressureThreshold > 0 {
+		setupLog.Info("Adding quota pressure reclaimer to manager",
+			"threshold", quotaPressureThreshold, "interval", quotaPressureInterval, "maxPerCycle", quotaPressureMaxPerCycle)
+		if err := mgr.Add(&controller.QuotaPressureReclaimer{
+			Client:          mgr.GetClient(),
+			Threshold:       quotaPressureThreshold,
+			Interval:        quotaPressureInterval,
+			MaxPerCycle:     quotaPressureMaxPerCycle,
+			StatusViaUpdate: statusViaUpdate,
+		}); err != nil {
+			setupLog.Error(err, "unable to add quota pressure reclaimer to manager")
+			os.Exit(1)
+		}
+	}
+
+	if selfTest {
+		if selfTestTTLSeconds <= 0 || time.Duration(selfTestTTLSeconds)*time.Second >= selfTestTimeout {
+			setupLog.Error(nil, "--self-test-ttl-seconds must be positive and shorter than --self-test-timeout",
+				"ttlSeconds", selfTestTTLSeconds, "timeout", selfTestTimeout)
+			os.Exit(1)
+		}
+		setupLog.Info("Adding self-test to manager", "namespace", selfTestNamespace, "ttlSeconds", selfTestTTLSeconds,
+			"timeout", selfTestTimeout)
+		if err := mgr.Add(&controller.SelfTest{
+			Client:           mgr.GetClient(),
+			Namespace:        selfTestNamespace,
+			TTLSeconds:       selfTestTTLSeconds,
+			Timeout:          selfTestTimeout,
+			TTLResourceNamer: ttlResourceNamer,
+			DeletionBlocker:  resourceReconciler.SelfTestBlocker,
+		}); err != nil {
+			setupLog.Error(err, "unable to add self-test to manager")
+			os.Exit(1)
+		}
+	}
+
 	if metricsCertWatcher != nil {
 		setupLog.Info("Adding metrics certificate watcher to manager")
 		if err := mgr.Add(metricsCertWatcher); err != nil {
@@ -227,6 +842,27 @@ func main() {
 		}
 	}
 
+	buildInfo.Features = resourceReconciler.Features()
+	for feature, on := range map[string]bool{
+		"enable-webhooks": enableWebhooks,
+		"deletion-log":    deletionLogConfigMap != "",
+		"event-sink":      eventSinkNATSURL != "",
+		"overdue-monitor": overdueCheckInterval > 0,
+		"quota-pressure":  quotaPressureThreshold > 0,
+		"rbac-check":      rbacCheckInterval > 0,
+		"self-test":       selfTest,
+		"summary":         summaryInterval > 0,
+	} {
+		if on {
+			buildInfo.Features = append(buildInfo.Features, feature)
+		}
+	}
+	slices.Sort(buildInfo.Features)
+	buildInfo.Kinds = resourceReconciler.Kinds()
+	buildInfo.Register()
+	setupLog.Info("Build info", "version", buildInfo.Version, "gitCommit", buildInfo.GitCommit,
+		"buildDate", buildInfo.BuildDate, "features", buildInfo.Features, "kinds", buildInfo.Kinds)
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -242,3 +878,22 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// envOrDefault는 환경 변수 값이 있으면 그 값을, 없으면 def를 반환합니다.
+func envOrDefault(key, def string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return def
+}
+
+// splitList는 쉼표로 구분된 값을 공백과 빈 항목을 제외한 목록으로 나눕니다.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
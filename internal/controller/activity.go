@@ -0,0 +1,207 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// ConditionStillActive는 만료된 owner가 아직 사용 중(활동량이 기준 이상)이어서 삭제를 미루고 있음을 나타냅니다
+const ConditionStillActive = "StillActive"
+
+const (
+	// DefaultActivityRecheckInterval은 사용 중이라 삭제를 미룬 owner의 활동량을 다시 확인하는 기본 간격입니다
+	DefaultActivityRecheckInterval = 5 * time.Minute
+	// activityQueryTimeout은 활동량 조회 한 번의 timeout입니다
+	activityQueryTimeout = 10 * time.Second
+)
+
+// ActivityChecker는 만료된 owner가 아직 사용 중인지 확인하는 확장 지점입니다.
+// 만료되었지만 트래픽을 처리하고 있는 Deployment처럼 바쁜 리소스의 삭제를 미룰 때 사용합니다.
+type ActivityChecker interface {
+	// Active는 owner가 아직 사용 중이면 true와 그 근거(예: 현재 요청 수)를 반환합니다.
+	// 오류를 반환하면 활동량을 알 수 없으므로 삭제를 미루고 나중에 다시 확인합니다
+	Active(ctx context.Context, owner client.Object) (bool, string, error)
+}
+
+// waitForActivity는 ActivityChecker로 owner가 아직 사용 중인지 확인하여 삭제를 미뤄야 하는지 확인합니다.
+// 미뤄야 하면 TTLResource에 기록할 condition을 반환합니다. checker가 없거나 owner가 없으면 미루지 않습니다.
+func (r *ResourceReconciler) waitForActivity(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource,
+	ownerRef metav1.OwnerReference, logger logr.Logger) (metav1.Condition, bool, error) {
+	if r.ActivityChecker == nil {
+		return metav1.Condition{}, false, nil
+	}
+	owner, err := r.getOwnerObject(ctx, ownerRef, ttlResource.Namespace)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return metav1.Condition{}, false, nil
+		}
+		return metav1.Condition{}, false, err
+	}
+	// 타입이 있는 객체는 Get 후 GVK가 비어 있으므로 checker가 Kind를 알 수 있도록 채워 줍니다
+	owner.GetObjectKind().SetGroupVersionKind(schema.FromAPIVersionAndKind(ownerRef.APIVersion, ownerRef.Kind))
+
+	condition := metav1.Condition{Type: ConditionStillActive, Status: metav1.ConditionTrue}
+	active, detail, err := r.ActivityChecker.Active(ctx, owner)
+	if err != nil {
+		logger.Info("Failed to check owner activity, deferring deletion", "owner", ownerRef.Name, "error", err.Error())
+		condition.Reason = "ActivityCheckFailed"
+		condition.Message = fmt.Sprintf("checking activity of %s/%s failed: %v", ownerRef.Kind, ownerRef.Name, err)
+		return condition, true, nil
+	}
+	if !active {
+		return metav1.Condition{}, false, nil
+	}
+	logger.Info("Owner is still active, deferring deletion", "owner", ownerRef.Name, "kind", ownerRef.Kind, "activity", detail)
+	condition.Reason = "ActivityAboveThreshold"
+	condition.Message = fmt.Sprintf("%s/%s is still active: %s", ownerRef.Kind, ownerRef.Name, detail)
+	return condition, true, nil
+}
+
+// activityRecheckInterval은 사용 중이라 삭제를 미룬 owner를 다시 확인하는 간격입니다.
+func (r *ResourceReconciler) activityRecheckInterval() time.Duration {
+	if r.ActivityRecheckInterval <= 0 {
+		return DefaultActivityRecheckInterval
+	}
+	return r.ActivityRecheckInterval
+}
+
+// PrometheusActivityChecker는 Prometheus 쿼리 결과가 Threshold보다 크면 owner가 사용 중이라고 판단하는 ActivityChecker입니다.
+// 쿼리는 owner의 .Kind, .Name, .Namespace를 사용하는 Go 템플릿이며, 결과 vector의 값을 모두 더해 비교합니다.
+// 결과가 비어 있으면 활동이 없는 것으로 봅니다.
+type PrometheusActivityChecker struct {
+	// URL은 Prometheus 서버 주소입니다 (예: http://prometheus.monitoring:9090)
+	URL string
+	// Query는 활동량을 구하는 PromQL 템플릿입니다
+	Query *template.Template
+	// Threshold보다 큰 값이면 사용 중입니다
+	Threshold float64
+	// Kinds에 있는 Kind의 owner만 확인합니다. 비어 있으면 Deployment만 확인합니다
+	Kinds []string
+	// Client가 nil이면 activityQueryTimeout을 timeout으로 쓰는 기본 클라이언트를 사용합니다
+	Client *http.Client
+}
+
+// NewPrometheusActivityChecker는 PromQL 템플릿을 파싱하여 PrometheusActivityChecker를 만듭니다.
+func NewPrometheusActivityChecker(prometheusURL, query string, threshold float64, kinds []string) (*PrometheusActivityChecker, error) {
+	if _, err := url.Parse(prometheusURL); err != nil || prometheusURL == "" {
+		return nil, fmt.Errorf("invalid Prometheus URL %q", prometheusURL)
+	}
+	tmpl, err := template.New("activity").Option("missingkey=error").Parse(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid activity query template: %w", err)
+	}
+	return &PrometheusActivityChecker{URL: prometheusURL, Query: tmpl, Threshold: threshold, Kinds: kinds}, nil
+}
+
+// prometheusQueryResponse는 Prometheus /api/v1/query 응답 중 instant vector 결과입니다.
+type prometheusQueryResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Value []any `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// Active는 owner에 대해 쿼리를 실행하여 결과가 Threshold보다 큰지 확인합니다.
+func (p *PrometheusActivityChecker) Active(ctx context.Context, owner client.Object) (bool, string, error) {
+	kind := owner.GetObjectKind().GroupVersionKind().Kind
+	kinds := p.Kinds
+	if len(kinds) == 0 {
+		kinds = []string{"Deployment"}
+	}
+	if !slices.Contains(kinds, kind) {
+		return false, "", nil
+	}
+
+	var query strings.Builder
+	if err := p.Query.Execute(&query, map[string]string{
+		"Kind": kind, "Name": owner.GetName(), "Namespace": owner.GetNamespace(),
+	}); err != nil {
+		return false, "", fmt.Errorf("rendering activity query: %w", err)
+	}
+	value, err := p.query(ctx, query.String())
+	if err != nil {
+		return false, "", err
+	}
+	detail := fmt.Sprintf("%s = %g (threshold %g)", query.String(), value, p.Threshold)
+	return value > p.Threshold, detail, nil
+}
+
+// query는 PromQL instant query를 실행하여 결과 vector의 값을 더해 반환합니다.
+func (p *PrometheusActivityChecker) query(ctx context.Context, promQL string) (float64, error) {
+	ctx, cancel := context.WithTimeout(ctx, activityQueryTimeout)
+	defer cancel()
+	endpoint := strings.TrimSuffix(p.URL, "/") + "/api/v1/query?" + url.Values{"query": {promQL}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return 0, err
+	}
+	httpClient := p.Client
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: activityQueryTimeout}
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var body prometheusQueryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return 0, fmt.Errorf("decoding Prometheus response (HTTP %d): %w", resp.StatusCode, err)
+	}
+	if body.Status != "success" {
+		return 0, fmt.Errorf("prometheus query failed: %s", body.Error)
+	}
+	if body.Data.ResultType != "vector" {
+		return 0, fmt.Errorf("prometheus query must return an instant vector, got %s", body.Data.ResultType)
+	}
+	var total float64
+	for _, sample := range body.Data.Result {
+		if len(sample.Value) != 2 {
+			continue
+		}
+		raw, _ := sample.Value[1].(string)
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid sample value %q", raw)
+		}
+		total += value
+	}
+	return total, nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// fakePrometheus는 마지막으로 받은 쿼리를 기록하고 rate 값을 하나의 vector 결과로 돌려주는 Prometheus입니다.
+func fakePrometheus(t *testing.T, rate *atomic.Value, lastQuery *atomic.Value) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		lastQuery.Store(req.URL.Query().Get("query"))
+		_, _ = fmt.Fprintf(w, `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,%q]}]}}`,
+			rate.Load().(string))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestActivityCheckerDefersDeletionWhileOwnerIsActive(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	var rate, lastQuery atomic.Value
+	rate.Store("12.5")
+	server := fakePrometheus(t, &rate, &lastQuery)
+	checker, err := NewPrometheusActivityChecker(server.URL,
+		`sum(rate(http_requests_total{namespace="{{.Namespace}}",deployment="{{.Name}}"}[1h]))`, 1, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	deployment, ttlResource := expiredOwner(t, &appsv1.Deployment{
+		Spec:   appsv1.DeploymentSpec{Replicas: ptr.To[int32](1)},
+		Status: appsv1.DeploymentStatus{Replicas: 1},
+	}, nil)
+	r := newTestReconciler(t, deployment, ttlResource)
+	r.ActivityChecker = checker
+
+	// 요청이 기준보다 많으면 삭제하지 않고 StillActive condition을 기록
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(Equal(DefaultActivityRecheckInterval))
+	g.Expect(lastQuery.Load()).To(Equal(`sum(rate(http_requests_total{namespace="default",deployment="web"}[1h]))`))
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(deployment), &appsv1.Deployment{})).To(Succeed())
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	condition := meta.FindStatusCondition(latest.Status.Conditions, ConditionStillActive)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Reason).To(Equal("ActivityAboveThreshold"))
+	g.Expect(condition.Message).To(ContainSubstring("12.5"))
+
+	// 활동이 기준 이하로 떨어지면 다음 확인에서 삭제
+	rate.Store("0.2")
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(deployment), &appsv1.Deployment{}))).To(BeTrue())
+}
+
+func TestActivityCheckFailureDefersDeletion(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"status":"error","errorType":"bad_data","error":"parse error"}`))
+	}))
+	t.Cleanup(server.Close)
+	checker, err := NewPrometheusActivityChecker(server.URL, `up{job="{{.Name}}"}`, 0, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	deployment, ttlResource := expiredOwner(t, &appsv1.Deployment{
+		Spec:   appsv1.DeploymentSpec{Replicas: ptr.To[int32](1)},
+		Status: appsv1.DeploymentStatus{Replicas: 1},
+	}, nil)
+	r := newTestReconciler(t, deployment, ttlResource)
+	r.ActivityChecker = checker
+
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(deployment), &appsv1.Deployment{})).To(Succeed())
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	condition := meta.FindStatusCondition(latest.Status.Conditions, ConditionStillActive)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Reason).To(Equal("ActivityCheckFailed"))
+	g.Expect(condition.Message).To(ContainSubstring("parse error"))
+}
+
+func TestActivityCheckerSkipsOtherKinds(t *testing.T) {
+	g := NewWithT(t)
+
+	var rate, lastQuery atomic.Value
+	rate.Store("100")
+	server := fakePrometheus(t, &rate, &lastQuery)
+	checker, err := NewPrometheusActivityChecker(server.URL, `up{pod="{{.Name}}"}`, 0, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// 기본 Kinds는 Deployment뿐이므로 Pod는 쿼리하지 않고 삭제
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	r := newTestReconciler(t, pod, ttlResource)
+	r.ActivityChecker = checker
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(lastQuery.Load()).To(BeNil())
+	g.Expect(errors.IsNotFound(r.Get(context.Background(), client.ObjectKeyFromObject(pod), &corev1.Pod{}))).To(BeTrue())
+
+	_, err = NewPrometheusActivityChecker(server.URL, `up{pod="{{.Name"}`, 0, nil)
+	g.Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AnnotationMigration은 이전 annotation 키(Legacy)를 현재 키(Current)로 옮기는 규칙입니다.
+type AnnotationMigration struct {
+	Legacy  string
+	Current string
+}
+
+// ParseAnnotationMigrations는 "legacy=current,..." 형식의 --migrate-annotations 값을 파싱합니다.
+// 예: "ttl.example.com/ttl=ttl.example.com/ttl-seconds"
+func ParseAnnotationMigrations(value string) ([]AnnotationMigration, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	var migrations []AnnotationMigration
+	seen := map[string]bool{}
+	for _, entry := range strings.Split(value, ",") {
+		legacy, current, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || legacy == "" || current == "" {
+			return nil, fmt.Errorf("expected legacy=current, got %q", entry)
+		}
+		if legacy == current {
+			return nil, fmt.Errorf("legacy and current annotation keys are the same: %q", legacy)
+		}
+		for _, key := range []string{legacy, current} {
+			if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+				return nil, fmt.Errorf("invalid annotation key %q: %s", key, strings.Join(errs, "; "))
+			}
+		}
+		if seen[legacy] {
+			return nil, fmt.Errorf("legacy annotation key %q is listed more than once", legacy)
+		}
+		seen[legacy] = true
+		migrations = append(migrations, AnnotationMigration{Legacy: legacy, Current: current})
+	}
+	return migrations, nil
+}
+
+// migrateLegacyAnnotations는 리소스에 이전 annotation 키가 있으면 값을 현재 키로 옮기고 이전 키를 제거합니다.
+// 현재 키가 이미 있으면 현재 키의 값을 유지하고 이전 키만 제거합니다. 이전 키가 없으면 아무것도 하지 않으므로
+// 여러 번 실행해도 안전합니다. 리소스를 patch했으면 true를 반환하며, patch로 인한 다음 reconcile에서 현재 키로 처리됩니다.
+func (r *ResourceReconciler) migrateLegacyAnnotations(ctx context.Context, obj client.Object, kind string, logger logr.Logger) (bool, error) {
+	annotations := obj.GetAnnotations()
+	var migrated []AnnotationMigration
+	for _, migration := range r.AnnotationMigrations {
+		if _, ok := annotations[migration.Legacy]; ok {
+			migrated = append(migrated, migration)
+		}
+	}
+	if len(migrated) == 0 {
+		return false, nil
+	}
+
+	original := obj.DeepCopyObject().(client.Object)
+	updated := make(map[string]string, len(annotations))
+	for k, v := range annotations {
+		updated[k] = v
+	}
+	for _, migration := range migrated {
+		value := updated[migration.Legacy]
+		delete(updated, migration.Legacy)
+		if current, ok := updated[migration.Current]; ok {
+			logger.Info("Removing legacy annotation, current annotation already set", "resource", client.ObjectKeyFromObject(obj),
+				"kind", kind, "legacy", migration.Legacy, "current", migration.Current, "legacyValue", value, "currentValue", current)
+			continue
+		}
+		updated[migration.Current] = value
+		logger.Info("Migrated legacy annotation", "resource", client.ObjectKeyFromObject(obj), "kind", kind,
+			"legacy", migration.Legacy, "current", migration.Current, "value", value)
+	}
+
+	obj.SetAnnotations(updated)
+	if err := r.Patch(ctx, obj, client.MergeFrom(original)); err != nil {
+		return false, fmt.Errorf("failed to migrate legacy annotations of %s: %w", obj.GetName(), err)
+	}
+	return true, nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestParseAnnotationMigrations(t *testing.T) {
+	g := NewWithT(t)
+
+	migrations, err := ParseAnnotationMigrations(" old.example.com/ttl=ttl.example.com/ttl-seconds , old.example.com/extend=ttl.example.com/extend")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(migrations).To(Equal([]AnnotationMigration{
+		{Legacy: "old.example.com/ttl", Current: TTLAnnotationKey},
+		{Legacy: "old.example.com/extend", Current: ExtendAnnotationKey},
+	}))
+
+	migrations, err = ParseAnnotationMigrations("")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(migrations).To(BeEmpty())
+
+	for _, invalid := range []string{"old", "a=a", "a=", "bad key=ttl.example.com/ttl-seconds", "a=b,a=c"} {
+		_, err := ParseAnnotationMigrations(invalid)
+		g.Expect(err).To(HaveOccurred(), invalid)
+	}
+}
+
+func TestLegacyAnnotationIsMigrated(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		Namespace:   "default",
+		Annotations: map[string]string{"old.example.com/ttl": "60"},
+	}}
+	r := newTestReconciler(t, pod)
+	r.AnnotationMigrations = []AnnotationMigration{{Legacy: "old.example.com/ttl", Current: TTLAnnotationKey}}
+
+	// 첫 reconcile에서 annotation을 옮기고, patch로 인한 다음 reconcile에서 현재 키로 TTLResource 생성
+	reconcileKey(t, r, "default", "web")
+	var latest corev1.Pod
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &latest)).To(Succeed())
+	g.Expect(latest.Annotations).To(Equal(map[string]string{TTLAnnotationKey: "60"}))
+
+	reconcileKey(t, r, "default", "web")
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.Spec.TTLSeconds).To(Equal(60))
+
+	// 다시 실행해도 바뀌지 않음
+	resourceVersion := latest.ResourceVersion
+	reconcileKey(t, r, "default", "web")
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &latest)).To(Succeed())
+	g.Expect(latest.ResourceVersion).To(Equal(resourceVersion))
+}
+
+func TestLegacyAnnotationDoesNotOverrideCurrent(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		Namespace:   "default",
+		Annotations: map[string]string{"old.example.com/ttl": "60", TTLAnnotationKey: "120"},
+	}}
+	r := newTestReconciler(t, pod)
+	r.AnnotationMigrations = []AnnotationMigration{{Legacy: "old.example.com/ttl", Current: TTLAnnotationKey}}
+
+	reconcileKey(t, r, "default", "web")
+	var latest corev1.Pod
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &latest)).To(Succeed())
+	g.Expect(latest.Annotations).To(Equal(map[string]string{TTLAnnotationKey: "120"}))
+}
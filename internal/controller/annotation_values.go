@@ -0,0 +1,129 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// fractionalSecondsPattern은 ms 단위까지의 소수 초 TTL 값 (예: 0.5, 1.25)입니다
+var fractionalSecondsPattern = regexp.MustCompile(`^[0-9]*\.[0-9]{1,3}$`)
+
+// secondsPattern은 단위 없이 초로 지정한 TTL 값입니다. 이 형식이 아니면 단위가 붙은 기간(예: 30m, 7d)으로 파싱합니다
+var secondsPattern = regexp.MustCompile(`^[-+]?[0-9.]*$`)
+
+// dayWeekPattern은 time.ParseDuration이 지원하지 않는 일(d)과 주(w) 단위입니다
+var dayWeekPattern = regexp.MustCompile(`([0-9]+(?:\.[0-9]+)?)([dw])`)
+
+// parseTTL은 TTL annotation 값을 파싱합니다. 양의 정수 초 외에 "0.5"처럼 ms 단위까지의 소수 초와
+// "30m", "2h", "7d", "1w"처럼 단위가 붙은 기간도 받습니다.
+// reconcile과 annotation 점검이 같은 규칙을 쓰도록 이 함수로만 파싱합니다.
+func parseTTL(value string) (time.Duration, error) {
+	if !secondsPattern.MatchString(value) {
+		return parseTTLDuration(value)
+	}
+	if !fractionalSecondsPattern.MatchString(value) {
+		seconds, err := parseTTLSeconds(value)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(seconds) * time.Second, nil
+	}
+	seconds, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("not a number of seconds: %q", value)
+	}
+	ttl := time.Duration(math.Round(seconds*1000)) * time.Millisecond
+	if ttl <= 0 {
+		return 0, fmt.Errorf("must be a positive number of seconds, got %s", value)
+	}
+	return ttl, nil
+}
+
+// parseTTLDuration은 단위가 붙은 TTL 값을 파싱합니다. time.ParseDuration 형식에 일(d = 24h)과 주(w = 7d) 단위를 더해
+// "7d", "1d12h"처럼 지정할 수 있습니다. 만료 계산은 ms 단위이므로 그보다 작은 단위는 반올림합니다.
+func parseTTLDuration(value string) (time.Duration, error) {
+	expanded := dayWeekPattern.ReplaceAllStringFunc(value, func(match string) string {
+		parts := dayWeekPattern.FindStringSubmatch(match)
+		n, _ := strconv.ParseFloat(parts[1], 64)
+		hours := n * 24
+		if parts[2] == "w" {
+			hours *= 7
+		}
+		return strconv.FormatFloat(hours, 'f', -1, 64) + "h"
+	})
+	ttl, err := time.ParseDuration(expanded)
+	if err != nil {
+		return 0, fmt.Errorf("not an integer number of seconds or a duration like 30m, 2h or 7d: %q", value)
+	}
+	ttl = ttl.Round(time.Millisecond)
+	if ttl <= 0 {
+		return 0, fmt.Errorf("must be a positive duration, got %s", value)
+	}
+	return ttl, nil
+}
+
+// parseTTLSeconds는 ttl-seconds 형식의 annotation 값(양의 정수 초)을 파싱합니다.
+// reconcile과 annotation 점검(AuditAnnotations)이 같은 규칙을 쓰도록 이 함수로만 파싱합니다.
+func parseTTLSeconds(value string) (int, error) {
+	seconds, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("not an integer number of seconds: %q", value)
+	}
+	if seconds <= 0 {
+		return 0, fmt.Errorf("must be a positive number of seconds, got %d", seconds)
+	}
+	return seconds, nil
+}
+
+// parseRelativeFraction은 relative-fraction annotation 값(0 초과 1 이하의 실수)을 파싱합니다.
+func parseRelativeFraction(value string) (float64, error) {
+	fraction, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("not a number: %q", value)
+	}
+	if fraction <= 0 || fraction > 1 {
+		return 0, fmt.Errorf("must be greater than 0 and at most 1, got %v", fraction)
+	}
+	return fraction, nil
+}
+
+// parseTerminationGrace는 termination-grace-seconds annotation 값(0 이상의 정수 초)을 파싱합니다.
+func parseTerminationGrace(value string) (int64, error) {
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("not an integer number of seconds: %q", value)
+	}
+	if seconds < 0 {
+		return 0, fmt.Errorf("must not be negative, got %d", seconds)
+	}
+	return seconds, nil
+}
+
+// parseTTLAnnotationSeconds는 다른 리소스의 TTL annotation 값을 parseTTL과 같은 규칙으로 파싱해 초 단위(올림)로 반환합니다.
+// 상위 리소스의 TTL을 상속하거나 비교할 때 "2h", "7d" 같은 값도 reconcile과 똑같이 해석하도록 사용합니다.
+func parseTTLAnnotationSeconds(value string) (int, error) {
+	ttl, err := parseTTL(value)
+	if err != nil {
+		return 0, err
+	}
+	return ttlSecondsCeil(ttl), nil
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AnnotationIssue는 Operator가 무시하거나 의도와 다르게 처리하는 annotation 하나입니다.
+type AnnotationIssue struct {
+	Kind       string
+	Namespace  string
+	Name       string
+	Annotation string
+	Value      string
+	Reason     string
+}
+
+// AuditAnnotations는 지원하는 모든 Kind와 네임스페이스의 TTL 관련 annotation을 점검하여,
+// reconcile이 로그만 남기고 무시하는 잘못된 값(정수가 아님, 0 이하 등)과 서로 충돌하는 설정을 반환합니다.
+// namespace가 비어 있으면 모든 네임스페이스를 점검합니다. reconcile과 같은 파싱 함수를 사용합니다.
+// notifyAllowedHosts는 Operator의 --notify-allowed-hosts와 같은 값이어야 합니다.
+func AuditAnnotations(ctx context.Context, c client.Reader, namespace string, notifyAllowedHosts []string) ([]AnnotationIssue, error) {
+	var issues []AnnotationIssue
+
+	for _, kind := range kindFallbackOrder {
+		list := &metav1.PartialObjectMetadataList{}
+		list.SetGroupVersionKind(supportedKinds[kind].GroupVersion().WithKind(kind + "List"))
+		if err := c.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			return nil, fmt.Errorf("listing %s: %w", kind, err)
+		}
+		for i := range list.Items {
+			issues = append(issues, auditObjectAnnotations(kind, &list.Items[i], notifyAllowedHosts)...)
+		}
+	}
+
+	// Job의 ttlSecondsAfterFinished는 spec에 있으므로 따로 조회
+	var jobs batchv1.JobList
+	if err := c.List(ctx, &jobs, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("listing Job: %w", err)
+	}
+	for _, job := range jobs.Items {
+		value, ok := job.Annotations[TTLAnnotationKey]
+		if ok && job.Spec.TTLSecondsAfterFinished != nil {
+			issues = append(issues, AnnotationIssue{
+				Kind: "Job", Namespace: job.Namespace, Name: job.Name, Annotation: TTLAnnotationKey, Value: value,
+				Reason: "conflicts with spec.ttlSecondsAfterFinished; the Job controller may delete the Job at a different time",
+			})
+		}
+	}
+
+	var namespaces corev1.NamespaceList
+	if err := c.List(ctx, &namespaces); err != nil {
+		return nil, fmt.Errorf("listing Namespace: %w", err)
+	}
+	for _, ns := range namespaces.Items {
+		if namespace != "" && ns.Name != namespace {
+			continue
+		}
+		if value, ok := ns.Annotations[NamespaceDefaultTTLAnnotationKey]; ok {
+			if _, err := parseTTL(value); err != nil {
+				issues = append(issues, AnnotationIssue{
+					Kind: "Namespace", Name: ns.Name, Annotation: NamespaceDefaultTTLAnnotationKey, Value: value, Reason: err.Error(),
+				})
+			}
+		}
+	}
+	return issues, nil
+}
+
+// auditObjectAnnotations는 리소스 하나의 annotation을 reconcile과 같은 규칙으로 점검합니다.
+func auditObjectAnnotations(kind string, obj client.Object, notifyAllowedHosts []string) []AnnotationIssue {
+	var issues []AnnotationIssue
+	annotations := obj.GetAnnotations()
+	report := func(key, reason string) {
+		issues = append(issues, AnnotationIssue{
+			Kind: kind, Namespace: obj.GetNamespace(), Name: obj.GetName(),
+			Annotation: key, Value: annotations[key], Reason: reason,
+		})
+	}
+
+	if value, ok := annotations[TTLAnnotationKey]; ok && value != TTLDisabledValue {
+		if _, err := parseTTL(value); err != nil {
+			report(TTLAnnotationKey, err.Error())
+		}
+	}
+	if value, ok := annotations[EphemeralDebugTTLAnnotationKey]; ok {
+		if kind != "Pod" {
+			report(EphemeralDebugTTLAnnotationKey, "only applies to Pods")
+		} else if _, err := parseTTLSeconds(value); err != nil {
+			report(EphemeralDebugTTLAnnotationKey, err.Error())
+		}
+	}
+	if value, ok := annotations[TerminationGraceAnnotationKey]; ok {
+		if kind != "Pod" {
+			report(TerminationGraceAnnotationKey, "only applies to Pods")
+		} else if _, err := parseTerminationGrace(value); err != nil {
+			report(TerminationGraceAnnotationKey, err.Error())
+		}
+	}
+	if value, ok := annotations[ExtendAnnotationKey]; ok && value != annotations[ExtendAppliedAnnotationKey] {
+		if _, err := parseExtendDuration(value); err != nil {
+			report(ExtendAnnotationKey, err.Error())
+		}
+	}
+	if value, ok := annotations[PropagationPolicyAnnotationKey]; ok {
+		if _, err := parsePropagationPolicy(value); err != nil {
+			report(PropagationPolicyAnnotationKey, err.Error())
+		}
+	}
+	if value, ok := annotations[ExpiryActionAnnotationKey]; ok {
+		if err := validateExpiryAction(value); err != nil {
+			report(ExpiryActionAnnotationKey, err.Error())
+		}
+	}
+	if value, ok := annotations[RelativeFractionAnnotationKey]; ok {
+		if _, err := parseRelativeFraction(value); err != nil {
+			report(RelativeFractionAnnotationKey, err.Error())
+		}
+	}
+	if value, ok := annotations[DeleteIfAnnotationKey]; ok {
+		if _, err := CompileDeleteIf(value); err != nil {
+			report(DeleteIfAnnotationKey, err.Error())
+		}
+	}
+	if value, ok := annotations[NotifyAnnotationKey]; ok {
+		if _, err := ParseNotifyTargets(value, notifyAllowedHosts); err != nil {
+			report(NotifyAnnotationKey, err.Error())
+		}
+	}
+	if value, ok := annotations[TargetKindAnnotationKey]; ok {
+		if _, supported := supportedKinds[value]; !supported {
+			report(TargetKindAnnotationKey, fmt.Sprintf("unsupported kind %q", value))
+		}
+	}
+	return issues
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestAuditAnnotationsReportsIgnoredValues(t *testing.T) {
+	g := NewWithT(t)
+
+	objs := []struct {
+		namespace, name string
+		annotations     map[string]string
+	}{
+		{"default", "valid", map[string]string{TTLAnnotationKey: "60"}},
+		{"default", "word", map[string]string{TTLAnnotationKey: "ten"}},
+		{"default", "zero", map[string]string{TTLAnnotationKey: "0"}},
+		{"default", "grace", map[string]string{TTLAnnotationKey: "60", TerminationGraceAnnotationKey: "-1"}},
+		{"other", "action", map[string]string{TTLAnnotationKey: "60", ExpiryActionAnnotationKey: "shred"}},
+	}
+	builder := fake.NewClientBuilder().WithScheme(newTestScheme(t))
+	for _, o := range objs {
+		builder = builder.WithObjects(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Namespace: o.namespace, Name: o.name, Annotations: o.annotations,
+		}})
+	}
+	builder = builder.WithObjects(
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default", Name: "cm", Annotations: map[string]string{EphemeralDebugTTLAnnotationKey: "60"},
+		}},
+		&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "job", Annotations: map[string]string{TTLAnnotationKey: "60"}},
+			Spec:       batchv1.JobSpec{TTLSecondsAfterFinished: ptr.To[int32](30)},
+		},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name: "default", Annotations: map[string]string{NamespaceDefaultTTLAnnotationKey: "-5"},
+		}},
+	)
+	c := builder.Build()
+
+	issues, err := AuditAnnotations(context.Background(), c, "", nil)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	found := map[string]string{}
+	for _, issue := range issues {
+		found[issue.Kind+"/"+issue.Name+" "+issue.Annotation] = issue.Reason
+	}
+	g.Expect(found).To(HaveLen(7))
+	g.Expect(found).To(HaveKeyWithValue("Pod/word "+TTLAnnotationKey, ContainSubstring("not an integer")))
+	g.Expect(found).To(HaveKeyWithValue("Pod/zero "+TTLAnnotationKey, ContainSubstring("positive")))
+	g.Expect(found).To(HaveKey("Pod/grace " + TerminationGraceAnnotationKey))
+	g.Expect(found).To(HaveKey("Pod/action " + ExpiryActionAnnotationKey))
+	g.Expect(found).To(HaveKeyWithValue("ConfigMap/cm "+EphemeralDebugTTLAnnotationKey, "only applies to Pods"))
+	g.Expect(found).To(HaveKeyWithValue("Job/job "+TTLAnnotationKey, ContainSubstring("ttlSecondsAfterFinished")))
+	g.Expect(found).To(HaveKey("Namespace/default " + NamespaceDefaultTTLAnnotationKey))
+
+	// 네임스페이스를 지정하면 그 네임스페이스만 점검
+	issues, err = AuditAnnotations(context.Background(), c, "other", nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(issues).To(HaveLen(1))
+	g.Expect(issues[0].Name).To(Equal("action"))
+}
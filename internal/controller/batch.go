@@ -0,0 +1,283 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"context"
+	stderrors "errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// batchRequeueInterval은 남은 삭제 대상을 이어서 처리하기 위한 재큐잉 간격입니다.
+const batchRequeueInterval = time.Second
+
+const (
+	// DeletionOrderOwnerReferences는 OwnerReference 순서대로 삭제합니다 (기본값)
+	DeletionOrderOwnerReferences = "owner-references"
+	// DeletionOrderOldestFirst는 creationTimestamp가 오래된 owner부터 삭제합니다
+	DeletionOrderOldestFirst = "oldest-first"
+	// DeletionOrderNewestFirst는 creationTimestamp가 최근인 owner부터 삭제합니다
+	DeletionOrderNewestFirst = "newest-first"
+)
+
+// ValidateDeletionOrder는 배치 삭제 순서 값이 올바른지 확인합니다.
+func ValidateDeletionOrder(order string) error {
+	switch order {
+	case "", DeletionOrderOwnerReferences, DeletionOrderOldestFirst, DeletionOrderNewestFirst:
+		return nil
+	default:
+		return fmt.Errorf("unknown deletion order %q (expected %s, %s or %s)",
+			order, DeletionOrderOwnerReferences, DeletionOrderOldestFirst, DeletionOrderNewestFirst)
+	}
+}
+
+// orderOwnersForDeletion은 DeletionOrder에 따라 owners를 creationTimestamp 순으로 정렬한 사본을 반환합니다.
+// 생성 시각이 같으면 원래 순서를 유지하고, 조회할 수 없는(이미 삭제되었거나 지원하지 않는 Kind) owner는 뒤로 보냅니다.
+func (r *ResourceReconciler) orderOwnersForDeletion(ctx context.Context, namespace string,
+	owners []metav1.OwnerReference) ([]metav1.OwnerReference, error) {
+	if r.DeletionOrder != DeletionOrderOldestFirst && r.DeletionOrder != DeletionOrderNewestFirst {
+		return owners, nil
+	}
+	created := make(map[int]time.Time, len(owners))
+	for i, ownerRef := range owners {
+		owner, err := r.getOwnerObject(ctx, ownerRef, namespace)
+		if err != nil {
+			if _, _, parseErr := newOwnerObject(ownerRef, namespace); errors.IsNotFound(err) || parseErr != nil {
+				continue
+			}
+			return nil, err
+		}
+		created[i] = owner.GetCreationTimestamp().Time
+	}
+
+	indexes := make([]int, len(owners))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	slices.SortStableFunc(indexes, func(a, b int) int {
+		ta, okA := created[a]
+		tb, okB := created[b]
+		switch {
+		case !okA || !okB:
+			// 조회할 수 없는 owner는 뒤로
+			return cmp.Compare(boolRank(!okA), boolRank(!okB))
+		case r.DeletionOrder == DeletionOrderNewestFirst:
+			return tb.Compare(ta)
+		default:
+			return ta.Compare(tb)
+		}
+	})
+	ordered := make([]metav1.OwnerReference, 0, len(owners))
+	for _, i := range indexes {
+		ordered = append(ordered, owners[i])
+	}
+	return ordered, nil
+}
+
+// boolRank는 정렬 비교를 위해 false를 0, true를 1로 바꿉니다.
+func boolRank(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// batchResult는 한 번의 배치 삭제 결과입니다.
+type batchResult struct {
+	// remaining은 배치 한도 때문에 아직 삭제하지 않은 대상 수입니다. 이미 사라진 대상은 포함하지 않습니다
+	remaining int
+	// blockedByPDB는 PodDisruptionBudget 때문에 삭제하지 못한 Pod 이름입니다
+	blockedByPDB []string
+	// forbidden은 권한 부족이나 admission 거부로 삭제하지 못한 대상("Kind/name")입니다
+	forbidden []string
+	// failed는 그 밖의 오류로 삭제하지 못한 대상("Kind/name")입니다
+	failed []string
+	// blockedByHook은 DeletionHook이 삭제를 막은 대상("Kind/name: 사유")입니다
+	blockedByHook []string
+	// waitingForLB는 삭제했지만 클라우드 load balancer 정리가 끝나지 않은 Service 이름입니다
+	waitingForLB []string
+	// deleted는 이번 배치에서 처리한 대상과 수행한 동작("Kind/name=action")입니다
+	deleted []string
+	// throttled는 API 서버가 삭제를 늦추라고 응답했을 때 서버가 제안한 재시도 지연입니다
+	throttled time.Duration
+	// overBudget은 전역 삭제 한도에 걸려 삭제하지 않은 대상 수입니다
+	overBudget int
+	// budgetWait는 전역 삭제 한도에 다시 여유가 생길 때까지 남은 시간입니다
+	budgetWait time.Duration
+}
+
+// deleteOwnersInBatch는 owners(deletionTargets로 정한 삭제 대상)가 가리키는 리소스를 DeletionOrder 순서로 최대 MaxDeletesPerCycle개까지 삭제하고,
+// 남은 대상과 삭제가 막힌 대상을 반환합니다.
+// 모든 owner가 사라지기 전까지는 TTLResource가 GC되지 않으므로 여러 reconcile에 걸쳐 나눠 삭제할 수 있습니다.
+func (r *ResourceReconciler) deleteOwnersInBatch(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource,
+	owners []metav1.OwnerReference, logger logr.Logger) (batchResult, error) {
+	// 배치 한도가 있어도 DeletionOrder의 생성 시각 순서대로 나눠 삭제되도록 먼저 정렬
+	owners, err := r.orderOwnersForDeletion(ctx, ttlResource.Namespace, owners)
+	if err != nil {
+		return batchResult{}, err
+	}
+	// 배치 안의 delete-after 관계가 있으면 의존 리소스를 먼저 삭제하도록 다시 정렬
+	owners, dependencies := r.orderOwnersByDeleteAfter(ctx, ttlResource, owners, logger)
+	// pending은 이번 배치에서 삭제가 받아들여지지 않은 대상으로, 이를 기다리는 대상도 삭제하지 않음
+	pending := map[resourceRef]bool{}
+	deleted := 0
+	var result batchResult
+	for _, ownerRef := range owners {
+		ref := resourceRef{Kind: ownerRef.Kind, Name: ownerRef.Name}
+		if dependency, ok := dependencies[ref]; ok && pending[dependency] {
+			logger.Info("Deferring owner until its delete-after dependency is deleted", "owner", ref.String(), "dependency", dependency.String())
+			pending[ref] = true
+			result.remaining++
+			continue
+		}
+		pending[ref] = true
+		unwatched := false
+		if _, _, parseErr := newOwnerObject(ownerRef, ttlResource.Namespace); parseErr != nil {
+			if !unwatchedTarget(ttlResource, ownerRef) {
+				// watch하지 않는 Kind는 targetRef로 지정한 경우에만 삭제 대상
+				logger.Error(parseErr, "Failed to delete owner resource", "ownerRef", ownerRef)
+				continue
+			}
+			unwatched = true
+		}
+		owner, err := r.getOwnerObject(ctx, ownerRef, ttlResource.Namespace)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				// 이미 삭제된 대상은 건너뜀
+				delete(pending, ref)
+				continue
+			}
+			if unwatched {
+				// 권한이나 Kind 문제로 조회할 수 없는 대상은 원인을 알 수 있도록 기록하고 나중에 다시 시도
+				r.recordUnwatchedTargetError(&result, ownerRef, err, logger)
+				continue
+			}
+			return batchResult{}, err
+		}
+
+		if waitingForLBCleanup(owner) {
+			// 이미 삭제를 요청했고 load balancer 정리만 기다리는 중
+			result.waitingForLB = append(result.waitingForLB, ownerRef.Name)
+			continue
+		}
+
+		if r.MaxDeletesPerCycle > 0 && deleted >= r.MaxDeletesPerCycle {
+			result.remaining++
+			continue
+		}
+
+		// 전역 삭제 한도를 다 썼으면 이 대상은 한도에 여유가 생긴 뒤에 삭제
+		if result.overBudget > 0 {
+			result.overBudget++
+			continue
+		}
+		if wait, ok := r.DeletionBudget.Take(r.now()); !ok {
+			result.overBudget++
+			result.budgetWait = wait
+			continue
+		}
+
+		if err := r.runBeforeDeleteHooks(ctx, owner, logger); err != nil {
+			ownerDeletionsTotal.WithLabelValues(metricKind(ownerRef.Kind), "blocked").Inc()
+			logger.Info("Deletion of owner resource blocked by hook", "kind", ownerRef.Kind, "name", ownerRef.Name, "error", err.Error())
+			result.blockedByHook = append(result.blockedByHook, ownerRef.Kind+"/"+ownerRef.Name+": "+err.Error())
+			continue
+		}
+
+		action, err := r.deleteOwnerResource(ctx, ownerRef, ttlResource.Namespace)
+		if delay, throttled := throttleDelay(err); throttled {
+			// 서버가 늦추라고 응답하면 이번 배치의 남은 삭제도 보내지 않고 지연 후 다시 시도
+			apiThrottledTotal.WithLabelValues("delete").Inc()
+			logger.Info("Deletion of owner resource throttled by API server", "kind", ownerRef.Kind, "name", ownerRef.Name, "retryAfter", delay.String())
+			result.throttled = delay
+			return result, nil
+		}
+		kind := metricKind(ownerRef.Kind)
+		switch {
+		case stderrors.Is(err, errBlockedByPDB):
+			ownerDeletionsTotal.WithLabelValues(kind, "blocked").Inc()
+			logger.Info("Eviction blocked by PodDisruptionBudget", "name", ownerRef.Name)
+			result.blockedByPDB = append(result.blockedByPDB, ownerRef.Name)
+		case errors.IsForbidden(err):
+			ownerDeletionsTotal.WithLabelValues(kind, "forbidden").Inc()
+			logger.Info("Deletion of owner resource forbidden", "kind", ownerRef.Kind, "name", ownerRef.Name, "error", err.Error())
+			if unwatched {
+				result.forbidden = append(result.forbidden, missingRBACTarget(ownerRef))
+			} else {
+				result.forbidden = append(result.forbidden, ownerRef.Kind+"/"+ownerRef.Name)
+			}
+		case err != nil:
+			ownerDeletionsTotal.WithLabelValues(kind, "failed").Inc()
+			logger.Error(err, "Failed to delete owner resource", "ownerRef", ownerRef)
+			result.failed = append(result.failed, ownerRef.Kind+"/"+ownerRef.Name)
+		default:
+			ownerDeletionsTotal.WithLabelValues(kind, "deleted").Inc()
+			logger.Info("Deleted owner resource", "kind", ownerRef.Kind, "name", ownerRef.Name)
+			result.deleted = append(result.deleted, ownerRef.Kind+"/"+ownerRef.Name+"="+action)
+			r.DeletionLog.Record(newDeletionRecord(ttlResource, ownerRef, action, r.now()))
+			// HPA, NetworkPolicy 정리에 실패해도 owner는 이미 삭제되었으므로 TTLResource 처리는 계속함
+			if err := r.deleteAssociatedHPAs(ctx, owner, logger); err != nil {
+				logger.Error(err, "Failed to delete HorizontalPodAutoscalers", "deployment", ownerRef.Name)
+			}
+			if err := r.deleteAssociatedNetworkPolicies(ctx, owner, logger); err != nil {
+				logger.Error(err, "Failed to delete NetworkPolicies", "kind", ownerRef.Kind, "name", ownerRef.Name)
+			}
+			r.runAfterDeleteHooks(ctx, owner, logger)
+			// LoadBalancer Service는 finalizer 때문에 바로 사라지지 않으므로 정리가 끝날 때까지 추적
+			// (정리가 끝나기 전에는 이 Service를 delete-after로 기다리는 대상도 삭제하지 않음)
+			if latest, err := r.getOwnerObject(ctx, ownerRef, ttlResource.Namespace); err == nil && waitingForLBCleanup(latest) {
+				result.waitingForLB = append(result.waitingForLB, ownerRef.Name)
+			} else {
+				delete(pending, ref)
+			}
+		}
+		deleted++
+	}
+	return result, nil
+}
+
+// recordRemainingDeletions는 남은 삭제 대상 수를 status에 기록하고 다음 배치를 위해 재큐잉합니다.
+func (r *ResourceReconciler) recordRemainingDeletions(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource, remaining int, logger logr.Logger) (ctrl.Result, error) {
+	logger.Info("Deletion batch limit reached, will continue",
+		"name", ttlResource.Name, "remaining", remaining, "maxPerCycle", r.MaxDeletesPerCycle)
+
+	if ttlResource.Status.RemainingDeletions != remaining || ttlResource.Status.LastRequeueReason != RequeueReasonBatchLimit {
+		ttlResource.Status.RemainingDeletions = remaining
+		ttlResource.Status.LastRequeueReason = RequeueReasonBatchLimit
+		if err := r.updateStatus(ctx, ttlResource); err != nil {
+			if errors.IsConflict(err) {
+				r.ConflictLog.Info(logger.V(1), "Conflict updating TTLResource status, will retry", "name", ttlResource.Name)
+				return r.retryOnConflict(ctx, ttlResource, logger), nil
+			}
+			if errors.IsNotFound(err) {
+				return ctrl.Result{}, nil
+			}
+			return ctrl.Result{}, err
+		}
+	}
+	return ctrl.Result{RequeueAfter: batchRequeueInterval}, nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// buildInfo는 operator 버전과 켜진 기능을 label로 가지는 값이 항상 1인 metric입니다
+var buildInfo = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "ttl_operator_build_info",
+		Help: "Build information of the running TTL operator with its enabled features and supported kinds. Always 1.",
+	},
+	[]string{"version", "git_commit", "build_date", "go_version", "features", "kinds"},
+)
+
+func init() {
+	metrics.Registry.MustRegister(buildInfo)
+}
+
+// BuildInfo는 실행 중인 operator의 버전과 켜진 기능, TTL을 적용하는 Kind입니다.
+// 여러 버전이 섞인 클러스터에서 어떤 배포가 어떤 동작을 지원하는지 확인할 때 사용합니다.
+// Version, GitCommit, BuildDate는 빌드 시 -ldflags로 채웁니다.
+type BuildInfo struct {
+	Version   string   `json:"version"`
+	GitCommit string   `json:"gitCommit"`
+	BuildDate string   `json:"buildDate"`
+	GoVersion string   `json:"goVersion"`
+	Features  []string `json:"features"`
+	Kinds     []string `json:"kinds"`
+}
+
+// Register는 ttl_operator_build_info metric을 이 값으로 설정합니다. 이전에 설정한 값은 지웁니다.
+func (b *BuildInfo) Register() {
+	if b.GoVersion == "" {
+		b.GoVersion = runtime.Version()
+	}
+	buildInfo.Reset()
+	buildInfo.WithLabelValues(b.Version, b.GitCommit, b.BuildDate, b.GoVersion,
+		strings.Join(b.Features, ","), strings.Join(b.Kinds, ",")).Set(1)
+}
+
+// ServeHTTP는 /version 요청에 BuildInfo를 JSON으로 응답합니다.
+func (b *BuildInfo) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(b)
+}
+
+// Features는 설정으로 켜진 선택 기능을 해당 플래그 이름으로 정렬해 반환합니다.
+func (r *ResourceReconciler) Features() []string {
+	var features []string
+	enabled := func(name string, on bool) {
+		if on {
+			features = append(features, name)
+		}
+	}
+	enabled("allow-ignore-suspend", r.AllowIgnoreSuspend)
+	enabled("activity-check", r.ActivityChecker != nil)
+	enabled("annotate-owners", r.AnnotateOwners)
+	enabled("confirm-delete", len(r.ConfirmDeleteNamespaces) > 0)
+	enabled("default-ttl", r.DefaultTTL > 0)
+	enabled("max-deletions-per-window", r.DeletionBudget != nil)
+	enabled("deletion-grace-period", r.DeletionGracePeriod > 0)
+	enabled("enable-endpoint-kinds", r.EnableEndpointKinds)
+	enabled("expiry-audit-log", r.ExpiryAuditLog)
+	enabled("import-job-ttl", r.ImportJobTTL)
+	enabled("keep-alive-label", r.KeepAliveLabel != "")
+	enabled("migrate-annotations", len(r.AnnotationMigrations) > 0)
+	enabled("owner-conditions", r.OwnerConditions)
+	enabled("owner-traversal", r.OwnerTraversalDepth > 0)
+	enabled("require-delete-optin", r.RequireDeleteOptIn)
+	enabled("respect-pdb", r.RespectPDB)
+	enabled("retain-expired", r.RetainExpired)
+	enabled("soak-until", !r.SoakUntil.IsZero())
+	enabled("trash", r.TrashNamespace != "")
+	slices.Sort(features)
+	return features
+}
+
+// Kinds는 TTL annotation을 watch하는 Kind 목록을 반환합니다.
+func (r *ResourceReconciler) Kinds() []string {
+	return slices.Clone(r.watchedKinds())
+}
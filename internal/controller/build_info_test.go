@@ -0,0 +1,50 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestBuildInfoReportsVersionFeaturesAndKinds(t *testing.T) {
+	g := NewWithT(t)
+
+	r := &ResourceReconciler{RespectPDB: true, ImportJobTTL: true, EnableEndpointKinds: true}
+	g.Expect(r.Features()).To(Equal([]string{"enable-endpoint-kinds", "import-job-ttl", "respect-pdb"}))
+	g.Expect(r.Kinds()).To(ContainElements("Pod", "Deployment", "EndpointSlice"))
+	g.Expect((&ResourceReconciler{}).Features()).To(BeEmpty())
+
+	info := &BuildInfo{Version: "v1.2.3", GitCommit: "abc123", BuildDate: "2025-06-01T00:00:00Z",
+		Features: r.Features(), Kinds: []string{"Pod", "Job"}}
+	info.Register()
+	g.Expect(testutil.CollectAndCount(buildInfo)).To(Equal(1))
+	g.Expect(testutil.ToFloat64(buildInfo.WithLabelValues("v1.2.3", "abc123", "2025-06-01T00:00:00Z", info.GoVersion,
+		"enable-endpoint-kinds,import-job-ttl,respect-pdb", "Pod,Job"))).To(Equal(1.0))
+
+	recorder := httptest.NewRecorder()
+	info.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/version", nil))
+	g.Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+	var served BuildInfo
+	g.Expect(json.Unmarshal(recorder.Body.Bytes(), &served)).To(Succeed())
+	g.Expect(served).To(Equal(*info))
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// clockOrReal은 c가 nil이면 실제 시계를 반환합니다.
+// 테스트에서는 k8s.io/utils/clock/testing의 FakeClock을 넣어 실제로 기다리지 않고 시간을 앞당길 수 있습니다.
+func clockOrReal(c clock.Clock) clock.Clock {
+	if c == nil {
+		return clock.RealClock{}
+	}
+	return c
+}
+
+// now는 Clock 기준의 현재 시각을 반환합니다.
+func (r *ResourceReconciler) now() time.Time {
+	return clockOrReal(r.Clock).Now()
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestFakeClockAdvancesExpiryWithoutSleeping(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	ttlResource := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "ttl-web",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(fakeClock.Now()),
+			OwnerReferences:   []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: "web"}},
+		},
+		Spec: ttlv1alpha1.TTLResourceSpec{TTLSeconds: 3600},
+	}
+	r := newTestReconciler(t, pod, ttlResource)
+	r.Clock = fakeClock
+
+	// 만료 전에는 남은 시간만큼 재큐잉
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(Equal(time.Hour))
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+
+	fakeClock.Step(30 * time.Minute)
+	result = reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(Equal(30 * time.Minute))
+
+	// 만료 시각이 지나면 실제로 기다리지 않고 바로 삭제
+	fakeClock.Step(30 * time.Minute)
+	reconcileKey(t, r, "default", "ttl-web")
+	err := r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestRecordDeletionUsesClock(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	ttlResource.CreationTimestamp = metav1.NewTime(fakeClock.Now().Add(-2 * time.Minute))
+	r := newTestReconciler(t, pod, ttlResource)
+	r.Clock = fakeClock
+	r.RetainExpired = true
+
+	// OwnerReference를 떼어낸 뒤 owner를 삭제하고 삭제 시각을 기록
+	reconcileKey(t, r, "default", "ttl-web")
+	reconcileKey(t, r, "default", "ttl-web")
+
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(latest.Status.DeletedAt).NotTo(BeNil())
+	g.Expect(latest.Status.DeletedAt.Time.Equal(fakeClock.Now())).To(BeTrue())
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"slices"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TTLSourceClusterDefault는 --default-ttl로 지정한 클러스터 전역 기본 TTL입니다
+const TTLSourceClusterDefault = "cluster-default"
+
+// TTLDisabledValue는 TTL annotation에 지정하면 네임스페이스 기본 TTL과 클러스터 기본 TTL을 모두 적용하지 않는 값입니다
+const TTLDisabledValue = "disabled"
+
+// ttlOptedOut은 리소스가 TTL annotation에 disabled를 지정하여 기본 TTL을 거부했는지 확인합니다.
+// 클러스터 기본 TTL이 켜져 있으면 "0"도 거부로 보아, 잘못된 값으로 무시되는 동안 기본 TTL로 만든 TTLResource가 남지 않게 합니다.
+func (r *ResourceReconciler) ttlOptedOut(obj client.Object) bool {
+	value := obj.GetAnnotations()[TTLAnnotationKey]
+	return value == TTLDisabledValue || (r.DefaultTTL > 0 && value == "0")
+}
+
+// clusterDefaultTTL은 TTL annotation과 네임스페이스 기본 TTL이 모두 없는 리소스에 적용할 --default-ttl 값을
+// TTL annotation과 같은 형식(초)으로 반환합니다.
+// DefaultTTLNamespaces나 DefaultTTLSelector를 지정하면 해당하는 리소스에만 적용합니다.
+func (r *ResourceReconciler) clusterDefaultTTL(obj client.Object) (string, bool) {
+	if r.DefaultTTL <= 0 {
+		return "", false
+	}
+	if len(r.DefaultTTLNamespaces) > 0 && !slices.Contains(r.DefaultTTLNamespaces, obj.GetNamespace()) {
+		return "", false
+	}
+	if r.DefaultTTLSelector != nil && !r.DefaultTTLSelector.Matches(labels.Set(obj.GetLabels())) {
+		return "", false
+	}
+	return strconv.FormatFloat(r.DefaultTTL.Seconds(), 'f', -1, 64), true
+}
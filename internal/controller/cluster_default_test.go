@@ -0,0 +1,102 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestClusterDefaultTTLAppliesUnlessOverridden(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod := func(namespace, name string, annotations map[string]string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name: name, Namespace: namespace, Annotations: annotations,
+			Labels: map[string]string{"env": "ephemeral"},
+		}}
+	}
+	existing := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{Name: "ttl-never", Namespace: "dev", Labels: map[string]string{TTLResourceLabelKey: TTLResourceLabelValue}},
+		Spec:       ttlv1alpha1.TTLResourceSpec{TTLSeconds: 3600},
+	}
+	namespaceDefault := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name: "team", Annotations: map[string]string{NamespaceDefaultTTLAnnotationKey: "600"},
+	}}
+	r := newTestReconciler(t,
+		pod("dev", "plain", nil),
+		pod("dev", "explicit", map[string]string{TTLAnnotationKey: "60"}),
+		pod("dev", "never", map[string]string{TTLAnnotationKey: "0"}),
+		pod("dev", "opted-out", map[string]string{TTLAnnotationKey: TTLDisabledValue}),
+		pod("prod", "elsewhere", nil),
+		pod("team", "inherits", nil),
+		namespaceDefault, existing,
+	)
+	r.DefaultTTL = time.Hour
+	r.DefaultTTLNamespaces = []string{"dev", "team"}
+	selector, err := labels.Parse("env=ephemeral")
+	g.Expect(err).NotTo(HaveOccurred())
+	r.DefaultTTLSelector = selector
+
+	ttlOf := func(namespace, name string) (*ttlv1alpha1.TTLResource, error) {
+		reconcileKey(t, r, namespace, name)
+		var ttlResource ttlv1alpha1.TTLResource
+		err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "ttl-" + name}, &ttlResource)
+		return &ttlResource, err
+	}
+
+	plain, err := ttlOf("dev", "plain")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(plain.Spec.TTLSeconds).To(Equal(3600))
+	g.Expect(ttlSourceOf(plain)).To(Equal(TTLSourceClusterDefault))
+
+	// 명시적인 annotation과 네임스페이스 기본 TTL이 클러스터 기본값보다 우선
+	explicit, err := ttlOf("dev", "explicit")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(explicit.Spec.TTLSeconds).To(Equal(60))
+	inherits, err := ttlOf("team", "inherits")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(inherits.Spec.TTLSeconds).To(Equal(600))
+
+	// disabled와 "0"은 기본 TTL을 거부하고 이미 있던 TTLResource도 정리
+	_, err = ttlOf("dev", "opted-out")
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+	_, err = ttlOf("dev", "never")
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+
+	// 대상 네임스페이스가 아니면 적용하지 않음
+	_, err = ttlOf("prod", "elsewhere")
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+
+	// label이 일치하지 않으면 적용하지 않음
+	unlabeled := pod("dev", "unlabeled", nil)
+	unlabeled.Labels = nil
+	g.Expect(r.Create(ctx, unlabeled)).To(Succeed())
+	_, err = ttlOf("dev", "unlabeled")
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+}
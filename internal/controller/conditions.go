@@ -0,0 +1,116 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+const (
+	// ConditionBlockedByPDB는 PodDisruptionBudget 때문에 Pod 삭제가 미뤄지고 있음을 나타냅니다
+	ConditionBlockedByPDB = "BlockedByPDB"
+	// ConditionDeletionForbidden은 권한 부족이나 admission 거부로 owner를 삭제하지 못하고 있음을 나타냅니다
+	ConditionDeletionForbidden = "DeletionForbidden"
+	// ConditionDeletionFailed는 권한 외의 오류(API 서버 오류, 타임아웃 등)로 owner를 삭제하지 못하고 있음을 나타냅니다
+	ConditionDeletionFailed = "DeletionFailed"
+	// ConditionDeletionAbandoned는 삭제 실패가 재시도 횟수 상한을 넘어 더 이상 시도하지 않고 수동 조치를 기다리고 있음을 나타냅니다
+	ConditionDeletionAbandoned = "DeletionAbandoned"
+	// ConditionWaitingForDependency는 delete-after로 지정한 리소스가 먼저 삭제되기를 기다리고 있음을 나타냅니다
+	ConditionWaitingForDependency = "WaitingForDependency"
+)
+
+const (
+	// forbiddenBackoffMin과 forbiddenBackoffMax는 삭제가 거부되었을 때 재시도 간격의 범위입니다
+	forbiddenBackoffMin = 10 * time.Second
+	forbiddenBackoffMax = 10 * time.Minute
+	// deletionFailedRequeueInterval은 오류로 실패한 삭제를 다시 시도하는 간격입니다
+	deletionFailedRequeueInterval = 30 * time.Second
+)
+
+// deferDeletion은 삭제를 미루는 사유를 condition과 status.deferReason으로 기록하고 requeueAfter 후에 다시 시도하도록 합니다.
+// 이미 같은 내용이면 status를 업데이트하지 않습니다.
+func (r *ResourceReconciler) deferDeletion(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource,
+	condition metav1.Condition, requeueAfter time.Duration, logger logr.Logger) (ctrl.Result, error) {
+	logger.Info("Deferring deletion", "name", ttlResource.Name, "reason", condition.Reason, "message", condition.Message)
+
+	condition.ObservedGeneration = ttlResource.Generation
+	condition.LastTransitionTime = metav1.NewTime(r.now())
+	changed := meta.SetStatusCondition(&ttlResource.Status.Conditions, condition)
+	// 여러 사유의 condition이 남아 있어도 지금 삭제를 막고 있는 사유를 한눈에 볼 수 있도록 따로 기록
+	if deferReason := condition.Type + ": " + condition.Message; ttlResource.Status.DeferReason != deferReason {
+		ttlResource.Status.DeferReason = deferReason
+		changed = true
+	}
+	if requeueReason := deferredRequeueReason(condition.Type); ttlResource.Status.LastRequeueReason != requeueReason {
+		ttlResource.Status.LastRequeueReason = requeueReason
+		changed = true
+	}
+	if changed {
+		if err := r.updateStatus(ctx, ttlResource); err != nil {
+			if errors.IsConflict(err) {
+				r.ConflictLog.Info(logger.V(1), "Conflict updating TTLResource status, will retry", "name", ttlResource.Name)
+				return r.retryOnConflict(ctx, ttlResource, logger), nil
+			}
+			if errors.IsNotFound(err) {
+				return ctrl.Result{}, nil
+			}
+			return ctrl.Result{}, err
+		}
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// deferForbiddenDeletion은 삭제가 거부된 대상을 DeletionForbidden condition과 Warning 이벤트로 알리고,
+// 거부가 계속된 시간에 비례하여 늘어나는 간격으로 다시 시도합니다.
+func (r *ResourceReconciler) deferForbiddenDeletion(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource,
+	forbidden []string, logger logr.Logger) (ctrl.Result, error) {
+	message := fmt.Sprintf("deletion of %s was forbidden", strings.Join(forbidden, ", "))
+	r.recordEvent(ttlResource, corev1.EventTypeWarning, ConditionDeletionForbidden, message)
+
+	// 처음 거부된 뒤 지난 시간만큼 기다리므로 재시도 간격이 약 두 배씩 늘어남
+	backoff := forbiddenBackoffMin
+	if c := meta.FindStatusCondition(ttlResource.Status.Conditions, ConditionDeletionForbidden); c != nil && c.Status == metav1.ConditionTrue {
+		backoff = min(max(r.now().Sub(c.LastTransitionTime.Time), forbiddenBackoffMin), forbiddenBackoffMax)
+	}
+
+	return r.deferDeletion(ctx, ttlResource, metav1.Condition{
+		Type:    ConditionDeletionForbidden,
+		Status:  metav1.ConditionTrue,
+		Reason:  "DeleteRejected",
+		Message: message,
+	}, backoff, logger)
+}
+
+// recordEvent는 Recorder가 설정되어 있으면 TTLResource에 Kubernetes Event를 기록합니다.
+func (r *ResourceReconciler) recordEvent(ttlResource *ttlv1alpha1.TTLResource, eventType, reason, message string) {
+	if r.Recorder == nil {
+		return
+	}
+	r.Recorder.Event(ttlResource, eventType, reason, message)
+}
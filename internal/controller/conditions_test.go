@@ -0,0 +1,91 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestForbiddenDeletionKeepsTTLResource(t *testing.T) {
+	g := NewWithT(t)
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	scheme := newTestScheme(t)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(pod, ttlResource).
+		WithStatusSubresource(&ttlv1alpha1.TTLResource{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				if _, ok := obj.(*corev1.Pod); ok {
+					return errors.NewForbidden(schema.GroupResource{Resource: "pods"}, obj.GetName(),
+						stderrors.New("admission webhook denied the request"))
+				}
+				return c.Delete(ctx, obj, opts...)
+			},
+		}).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &ResourceReconciler{Client: c, Scheme: scheme, Recorder: recorder}
+
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(Equal(forbiddenBackoffMin))
+
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(context.Background(), client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(meta.IsStatusConditionTrue(latest.Status.Conditions, ConditionDeletionForbidden)).To(BeTrue())
+	g.Expect(recorder.Events).To(Receive(ContainSubstring("Warning DeletionForbidden")))
+}
+
+func TestDeferReasonExplainsPendingDeletion(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	pod.Annotations = map[string]string{DeleteAfterAnnotationKey: "Service/web"}
+	service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	r := newTestReconciler(t, pod, service, ttlResource)
+
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(Equal(deleteAfterRequeueInterval))
+
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(latest.Status.DeferReason).To(Equal("WaitingForDependency: waiting for Service/web to be deleted first"))
+	g.Expect(meta.IsStatusConditionTrue(latest.Status.Conditions, ConditionWaitingForDependency)).To(BeTrue())
+
+	// 사유가 바뀌면 deferReason도 지금의 사유로 바뀜
+	r.ConfirmDeleteNamespaces = []string{"default"}
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(latest.Status.DeferReason).To(HavePrefix(ConditionAwaitingConfirmation + ": "))
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// ConfirmDeleteAnnotationKey는 확인이 필요한 네임스페이스에서 만료된 owner의 삭제를 승인하는 TTLResource annotation 키입니다
+const ConfirmDeleteAnnotationKey = "ttl.example.com/confirm-delete"
+
+// ConditionAwaitingConfirmation은 만료되었지만 삭제 승인을 기다리고 있음을 나타냅니다
+const ConditionAwaitingConfirmation = "AwaitingConfirmation"
+
+// confirmationRequeueInterval은 삭제 승인을 다시 확인하는 간격입니다.
+// annotation이 추가되면 TTLResource watch로 바로 reconcile되므로 놓친 이벤트에 대비한 값입니다
+const confirmationRequeueInterval = time.Minute
+
+// requiresConfirmation은 TTLResource가 삭제 전에 승인이 필요한 네임스페이스에 있는지 확인합니다.
+func (r *ResourceReconciler) requiresConfirmation(ttlResource *ttlv1alpha1.TTLResource) bool {
+	return slices.Contains(r.ConfirmDeleteNamespaces, ttlResource.Namespace)
+}
+
+// deletionConfirmed는 TTLResource에 confirm-delete: "true" annotation이 있는지 확인합니다.
+func deletionConfirmed(ttlResource *ttlv1alpha1.TTLResource) bool {
+	return ttlResource.GetAnnotations()[ConfirmDeleteAnnotationKey] == "true"
+}
+
+// awaitConfirmation은 TTLResource를 AwaitingConfirmation 상태로 표시하고 승인될 때까지 삭제를 미룹니다.
+// 처음 대기 상태가 될 때만 Event를 기록합니다.
+func (r *ResourceReconciler) awaitConfirmation(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource, logger logr.Logger) (ctrl.Result, error) {
+	if !meta.IsStatusConditionTrue(ttlResource.Status.Conditions, ConditionAwaitingConfirmation) {
+		r.recordEvent(ttlResource, corev1.EventTypeNormal, ConditionAwaitingConfirmation,
+			"TTL expired; add annotation "+ConfirmDeleteAnnotationKey+"=\"true\" to delete the owner")
+	}
+	return r.deferDeletion(ctx, ttlResource, metav1.Condition{
+		Type:    ConditionAwaitingConfirmation,
+		Status:  metav1.ConditionTrue,
+		Reason:  "ConfirmationRequired",
+		Message: "waiting for annotation " + ConfirmDeleteAnnotationKey + "=\"true\"",
+	}, confirmationRequeueInterval, logger)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestConfirmDeleteWaitsForAnnotation(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	r := newTestReconciler(t, pod, ttlResource)
+	r.ConfirmDeleteNamespaces = []string{"default"}
+
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(Equal(confirmationRequeueInterval))
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(meta.IsStatusConditionTrue(latest.Status.Conditions, ConditionAwaitingConfirmation)).To(BeTrue())
+
+	// "true"가 아닌 값은 승인으로 보지 않음
+	latest.Annotations = map[string]string{ConfirmDeleteAnnotationKey: "yes"}
+	g.Expect(r.Update(ctx, &latest)).To(Succeed())
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	latest.Annotations = map[string]string{ConfirmDeleteAnnotationKey: "true"}
+	g.Expect(r.Update(ctx, &latest)).To(Succeed())
+	reconcileKey(t, r, "default", "ttl-web")
+	err := r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue(), "owner Pod should be deleted after confirmation")
+}
+
+func TestConfirmDeleteOnlyAppliesToListedNamespaces(t *testing.T) {
+	g := NewWithT(t)
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	r := newTestReconciler(t, pod, ttlResource)
+	r.ConfirmDeleteNamespaces = []string{"production"}
+
+	reconcileKey(t, r, "default", "ttl-web")
+	err := r.Get(context.Background(), client.ObjectKeyFromObject(pod), &corev1.Pod{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+}
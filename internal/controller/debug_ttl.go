@@ -0,0 +1,81 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// EphemeralDebugTTLAnnotationKey는 Pod에 ephemeral debug 컨테이너가 붙은 뒤 Pod를 삭제하기까지의 시간(초)을 지정하는 annotation 키입니다
+const EphemeralDebugTTLAnnotationKey = "ttl.example.com/ephemeral-debug-ttl"
+
+// ephemeralDebugTTL은 ephemeral-debug-ttl annotation이 있는 Pod에서 가장 먼저 시작된 ephemeral 컨테이너의 시작 시각부터
+// TTL을 세는 spec을 반환합니다. 컨테이너는 따로 삭제할 수 없으므로 Pod 전체가 삭제 대상입니다.
+// 시작된 ephemeral 컨테이너가 없거나, Pod의 TTL annotation이 더 먼저 만료되면 false를 반환합니다.
+func ephemeralDebugTTL(pod *corev1.Pod, logger logr.Logger) (ttlv1alpha1.TTLResourceSpec, bool) {
+	value, ok := pod.Annotations[EphemeralDebugTTLAnnotationKey]
+	if !ok {
+		return ttlv1alpha1.TTLResourceSpec{}, false
+	}
+	ttlSeconds, err := parseTTLSeconds(value)
+	if err != nil {
+		logger.Info("Invalid ephemeral-debug-ttl annotation value, ignoring", "value", value, "pod", pod.Name, "error", err.Error())
+		return ttlv1alpha1.TTLResourceSpec{}, false
+	}
+
+	startedAt, ok := firstEphemeralContainerStart(pod)
+	if !ok {
+		return ttlv1alpha1.TTLResourceSpec{}, false
+	}
+	debugExpiry := startedAt.Add(time.Duration(ttlSeconds) * time.Second)
+
+	// Pod 자체의 TTL이 더 먼저 만료되면 그 TTL을 그대로 사용
+	if podTTL, err := parseTTLAnnotationSeconds(pod.Annotations[TTLAnnotationKey]); err == nil {
+		if pod.CreationTimestamp.Add(time.Duration(podTTL) * time.Second).Before(debugExpiry) {
+			return ttlv1alpha1.TTLResourceSpec{}, false
+		}
+	}
+	return ttlv1alpha1.TTLResourceSpec{TTLSeconds: ttlSeconds, StartTime: &startedAt}, true
+}
+
+// firstEphemeralContainerStart는 Pod의 ephemeral 컨테이너 중 가장 먼저 시작된 시각을 반환합니다.
+func firstEphemeralContainerStart(pod *corev1.Pod) (metav1.Time, bool) {
+	var first metav1.Time
+	found := false
+	for _, status := range pod.Status.EphemeralContainerStatuses {
+		var startedAt metav1.Time
+		switch {
+		case status.State.Running != nil:
+			startedAt = status.State.Running.StartedAt
+		case status.State.Terminated != nil:
+			startedAt = status.State.Terminated.StartedAt
+		default:
+			continue
+		}
+		if !found || startedAt.Before(&first) {
+			first = startedAt
+			found = true
+		}
+	}
+	return first, found
+}
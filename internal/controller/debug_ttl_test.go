@@ -0,0 +1,87 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func debuggedPod(annotations map[string]string, debugStarted ...time.Time) *corev1.Pod {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:              "web",
+		Namespace:         "default",
+		CreationTimestamp: metav1.NewTime(time.Now().Add(-24 * time.Hour)),
+		Annotations:       annotations,
+	}}
+	for _, startedAt := range debugStarted {
+		pod.Status.EphemeralContainerStatuses = append(pod.Status.EphemeralContainerStatuses, corev1.ContainerStatus{
+			Name:  "debugger",
+			State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: metav1.NewTime(startedAt)}},
+		})
+	}
+	return pod
+}
+
+func TestEphemeralDebugTTLUsesFirstDebugContainerStart(t *testing.T) {
+	g := NewWithT(t)
+	logger := logf.Log
+
+	first := time.Now().Add(-20 * time.Minute)
+	pod := debuggedPod(map[string]string{EphemeralDebugTTLAnnotationKey: "1800"}, time.Now().Add(-5*time.Minute), first)
+	spec, ok := ephemeralDebugTTL(pod, logger)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(spec.TTLSeconds).To(Equal(1800))
+	g.Expect(spec.StartTime.Unix()).To(Equal(first.Unix()))
+
+	// debug 컨테이너가 아직 시작되지 않았으면 적용하지 않음
+	_, ok = ephemeralDebugTTL(debuggedPod(map[string]string{EphemeralDebugTTLAnnotationKey: "1800"}), logger)
+	g.Expect(ok).To(BeFalse())
+
+	// Pod의 TTL이 debug TTL보다 먼저 만료되면 Pod의 TTL을 사용
+	_, ok = ephemeralDebugTTL(debuggedPod(map[string]string{
+		EphemeralDebugTTLAnnotationKey: "1800",
+		TTLAnnotationKey:               "3600",
+	}, first), logger)
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestEphemeralDebugTTLDeletesPod(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod := debuggedPod(map[string]string{EphemeralDebugTTLAnnotationKey: "600"}, time.Now().Add(-time.Hour))
+	r := newTestReconciler(t, pod)
+
+	reconcileKey(t, r, "default", "web")
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &ttlResource)).To(Succeed())
+
+	reconcileKey(t, r, "default", "ttl-web")
+	err := r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue(), "Pod should be deleted once the debug container outlives the TTL")
+}
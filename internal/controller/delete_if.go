@@ -0,0 +1,140 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/cel-go/cel"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+const (
+	// DeleteIfAnnotationKey는 만료 시 owner 객체(object)로 평가할 CEL 식을 지정하는 annotation 키입니다.
+	// 식이 true일 때만 삭제합니다 (예: "object.status.replicas == 0")
+	DeleteIfAnnotationKey = "ttl.example.com/delete-if"
+	// ConditionDeleteIfNotMet은 delete-if 식이 true가 아니어서 삭제를 미루고 있음을 나타냅니다
+	ConditionDeleteIfNotMet = "DeleteIfNotMet"
+	// deleteIfRequeueInterval은 delete-if 식을 다시 평가하기까지의 간격입니다.
+	// owner의 status 변화는 TTLResource의 reconcile을 일으키지 않으므로 주기적으로 다시 확인합니다
+	deleteIfRequeueInterval = 30 * time.Second
+	// deleteIfCostLimit은 식 하나를 평가할 때 허용하는 최대 비용입니다 (큰 리스트를 도는 식이 reconcile을 막지 않도록)
+	deleteIfCostLimit = 1000000
+)
+
+// deleteIfEnv는 delete-if 식을 컴파일하는 CEL 환경입니다. owner 객체는 object 변수로 전달됩니다
+var deleteIfEnv, deleteIfEnvErr = cel.NewEnv(cel.Variable("object", cel.DynType))
+
+// CompileDeleteIf는 delete-if annotation의 CEL 식을 컴파일합니다. 식의 결과는 bool이어야 합니다.
+// admission webhook이 잘못된 식을 거부할 때도 같은 함수를 사용합니다.
+func CompileDeleteIf(expression string) (cel.Program, error) {
+	if deleteIfEnvErr != nil {
+		return nil, deleteIfEnvErr
+	}
+	ast, issues := deleteIfEnv.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("expression must evaluate to bool, got %s", ast.OutputType())
+	}
+	return deleteIfEnv.Program(ast, cel.CostLimit(deleteIfCostLimit))
+}
+
+// deleteIfProgram은 컴파일한 식을 식 문자열별로 캐시하여 reconcile마다 다시 컴파일하지 않습니다.
+func (r *ResourceReconciler) deleteIfProgram(expression string) (cel.Program, error) {
+	if cached, ok := r.deleteIfPrograms.Load(expression); ok {
+		return cached.(cel.Program), nil
+	}
+	program, err := CompileDeleteIf(expression)
+	if err != nil {
+		return nil, err
+	}
+	r.deleteIfPrograms.Store(expression, program)
+	return program, nil
+}
+
+// evaluateDeleteIf는 owner 객체로 식을 평가합니다. 필드가 없는 등 평가에 실패하면 오류를 반환합니다.
+func evaluateDeleteIf(program cel.Program, owner runtime.Object) (bool, error) {
+	object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(owner)
+	if err != nil {
+		return false, err
+	}
+	out, _, err := program.Eval(map[string]any{"object": object})
+	if err != nil {
+		return false, err
+	}
+	met, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression evaluated to %v, not bool", out.Value())
+	}
+	return met, nil
+}
+
+// waitForDeleteIf는 owner의 delete-if 식을 최신 owner 객체로 평가하여 삭제를 미뤄야 하는지 확인합니다.
+// 미뤄야 하면 TTLResource에 기록할 condition을 반환합니다. owner가 없거나 annotation이 없으면 미루지 않습니다.
+func (r *ResourceReconciler) waitForDeleteIf(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource,
+	ownerRef metav1.OwnerReference, logger logr.Logger) (metav1.Condition, bool, error) {
+	owner, err := r.getOwnerObject(ctx, ownerRef, ttlResource.Namespace)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return metav1.Condition{}, false, nil
+		}
+		return metav1.Condition{}, false, err
+	}
+	expression, ok := owner.GetAnnotations()[DeleteIfAnnotationKey]
+	if !ok {
+		return metav1.Condition{}, false, nil
+	}
+
+	condition := metav1.Condition{Type: ConditionDeleteIfNotMet, Status: metav1.ConditionTrue}
+	program, err := r.deleteIfProgram(expression)
+	if err != nil {
+		// webhook을 거치지 않고 저장된 잘못된 식은 삭제를 허용하지 않고 알림
+		logger.Info("Invalid delete-if expression, not deleting", "owner", ownerRef.Name, "expression", expression, "error", err.Error())
+		message := fmt.Sprintf("invalid %s expression on %s/%s: %v", DeleteIfAnnotationKey, ownerRef.Kind, ownerRef.Name, err)
+		// 재평가할 때마다 같은 Event를 남기지 않도록 처음 발견했을 때만 기록
+		if c := meta.FindStatusCondition(ttlResource.Status.Conditions, ConditionDeleteIfNotMet); c == nil || c.Reason != "InvalidExpression" {
+			r.recordEvent(ttlResource, corev1.EventTypeWarning, ConditionDeleteIfNotMet, message)
+		}
+		condition.Reason, condition.Message = "InvalidExpression", message
+		return condition, true, nil
+	}
+	met, err := evaluateDeleteIf(program, owner)
+	if err != nil {
+		logger.Info("Failed to evaluate delete-if expression, not deleting", "owner", ownerRef.Name, "expression", expression, "error", err.Error())
+		condition.Reason = "EvaluationFailed"
+		condition.Message = fmt.Sprintf("evaluating %q on %s/%s failed: %v", expression, ownerRef.Kind, ownerRef.Name, err)
+		return condition, true, nil
+	}
+	if !met {
+		logger.Info("delete-if expression is false, deferring deletion", "owner", ownerRef.Name, "expression", expression)
+		condition.Reason = "ExpressionFalse"
+		condition.Message = fmt.Sprintf("%q is false for %s/%s", expression, ownerRef.Kind, ownerRef.Name)
+		return condition, true, nil
+	}
+	return metav1.Condition{}, false, nil
+}
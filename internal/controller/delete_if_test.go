@@ -0,0 +1,95 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestDeleteIfDefersUntilExpressionIsTrue(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	deployment, ttlResource := expiredOwner(t, &appsv1.Deployment{
+		Spec:   appsv1.DeploymentSpec{Replicas: ptr.To[int32](2)},
+		Status: appsv1.DeploymentStatus{Replicas: 2},
+	}, map[string]string{DeleteIfAnnotationKey: "object.spec.replicas == 0"})
+	r := newTestReconciler(t, deployment, ttlResource)
+
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(Equal(deleteIfRequeueInterval))
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	condition := meta.FindStatusCondition(latest.Status.Conditions, ConditionDeleteIfNotMet)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Reason).To(Equal("ExpressionFalse"))
+
+	// 최신 owner 객체로 다시 평가하므로 scale-down 후에는 삭제됨
+	deployment.Spec.Replicas = ptr.To[int32](0)
+	g.Expect(r.Update(ctx, deployment)).To(Succeed())
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(deployment), &appsv1.Deployment{}))).To(BeTrue())
+}
+
+func TestDeleteIfInvalidOrFailingExpressionBlocksDeletion(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	for expression, reason := range map[string]string{
+		"object.spec.replicas ==":    "InvalidExpression",
+		"object.status.missing == 0": "EvaluationFailed",
+	} {
+		deployment, ttlResource := expiredOwner(t, &appsv1.Deployment{}, map[string]string{DeleteIfAnnotationKey: expression})
+		r := newTestReconciler(t, deployment, ttlResource)
+		recorder := record.NewFakeRecorder(10)
+		r.Recorder = recorder
+
+		reconcileKey(t, r, "default", "ttl-web")
+		reconcileKey(t, r, "default", "ttl-web")
+		g.Expect(r.Get(ctx, client.ObjectKeyFromObject(deployment), &appsv1.Deployment{})).To(Succeed(), expression)
+		var latest ttlv1alpha1.TTLResource
+		g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+		g.Expect(meta.FindStatusCondition(latest.Status.Conditions, ConditionDeleteIfNotMet).Reason).To(Equal(reason))
+		if reason == "InvalidExpression" {
+			// 다시 평가해도 Warning 이벤트는 한 번만 기록
+			g.Expect(recorder.Events).To(HaveLen(1))
+		}
+	}
+}
+
+func TestDeleteIfProgramIsCompiledOnce(t *testing.T) {
+	g := NewWithT(t)
+
+	r := &ResourceReconciler{}
+	first, err := r.deleteIfProgram("object.metadata.name == 'web'")
+	g.Expect(err).NotTo(HaveOccurred())
+	second, err := r.deleteIfProgram("object.metadata.name == 'web'")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(second).To(BeIdenticalTo(first))
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// DeleteOptInLabelKey는 --require-delete-optin이 켜져 있을 때 만료된 owner의 삭제를 허용하는 owner label 키입니다
+const DeleteOptInLabelKey = "ttl.example.com/allow-delete"
+
+// ConditionNotOptedIn은 owner에 삭제 허용 label이 없어 삭제를 미루고 있음을 나타냅니다
+const ConditionNotOptedIn = "NotOptedIn"
+
+// deleteOptInRequeueInterval은 삭제 허용 label을 다시 확인하는 간격입니다.
+// owner의 label 변경은 TTLResource reconcile을 일으키지 않으므로 이 간격으로 확인합니다
+const deleteOptInRequeueInterval = time.Minute
+
+// waitForDeleteOptIn은 --require-delete-optin이 켜져 있을 때 삭제할 owner 중 allow-delete=true label이 없는 것이 있는지 확인합니다.
+// 하나라도 없으면 모든 owner의 삭제를 미루도록 NotOptedIn condition과 true를 반환합니다. 이미 없는 owner는 확인하지 않습니다.
+func (r *ResourceReconciler) waitForDeleteOptIn(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource,
+	owners []metav1.OwnerReference, logger logr.Logger) (metav1.Condition, bool, error) {
+	if !r.RequireDeleteOptIn {
+		return metav1.Condition{}, false, nil
+	}
+	for _, ownerRef := range owners {
+		owner, err := r.getOwnerObject(ctx, ownerRef, ttlResource.Namespace)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return metav1.Condition{}, false, err
+		}
+		if owner.GetLabels()[DeleteOptInLabelKey] == "true" {
+			continue
+		}
+		message := fmt.Sprintf("%s/%s has no label %s=true; not deleting", ownerRef.Kind, ownerRef.Name, DeleteOptInLabelKey)
+		logger.Info("Owner has not opted in to deletion, deferring", "owner", ownerRef.Name, "kind", ownerRef.Kind)
+		// 재확인할 때마다 같은 Event를 남기지 않도록 처음 대기 상태가 될 때만 기록
+		if !meta.IsStatusConditionTrue(ttlResource.Status.Conditions, ConditionNotOptedIn) {
+			r.recordEvent(ttlResource, corev1.EventTypeWarning, ConditionNotOptedIn, message)
+		}
+		return metav1.Condition{
+			Type:    ConditionNotOptedIn,
+			Status:  metav1.ConditionTrue,
+			Reason:  "MissingAllowDeleteLabel",
+			Message: message,
+		}, true, nil
+	}
+	return metav1.Condition{}, false, nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestRequireDeleteOptInKeepsOwnersWithoutLabel(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	r := newTestReconciler(t, pod, ttlResource)
+	recorder := record.NewFakeRecorder(10)
+	r.Recorder = recorder
+	r.RequireDeleteOptIn = true
+
+	// label이 없으면 삭제하지 않고 NotOptedIn condition을 기록
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(Equal(deleteOptInRequeueInterval))
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	condition := meta.FindStatusCondition(latest.Status.Conditions, ConditionNotOptedIn)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Reason).To(Equal("MissingAllowDeleteLabel"))
+	g.Expect(recorder.Events).To(Receive(ContainSubstring(DeleteOptInLabelKey)))
+
+	// 다시 확인해도 Event는 한 번만 남김
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(recorder.Events).NotTo(Receive())
+
+	// label을 붙이면 다음 확인에서 삭제
+	var current corev1.Pod
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &current)).To(Succeed())
+	current.Labels = map[string]string{DeleteOptInLabelKey: "true"}
+	g.Expect(r.Update(ctx, &current)).To(Succeed())
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{}))).To(BeTrue())
+}
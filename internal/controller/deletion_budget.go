@@ -0,0 +1,64 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+)
+
+// DeletionBudget은 Operator 전체에서 Window 동안 삭제할 수 있는 owner 수를 제한합니다.
+// 잘못된 설정으로 수천 개의 리소스가 한꺼번에 삭제되는 것을 막기 위한 안전장치이며,
+// 최근 Window 안의 삭제 시각을 기록하는 sliding window로 계산합니다. nil DeletionBudget은 제한하지 않습니다.
+type DeletionBudget struct {
+	limit  int
+	window time.Duration
+
+	mu sync.Mutex
+	// taken은 최근 window 안에 허용한 삭제 시각이며 오래된 순으로 정렬되어 있습니다
+	taken []time.Time
+}
+
+// NewDeletionBudget은 window마다 limit개까지 삭제를 허용하는 DeletionBudget을 생성합니다.
+// limit이나 window가 0 이하이면 nil(제한 없음)입니다.
+func NewDeletionBudget(limit int, window time.Duration) *DeletionBudget {
+	if limit <= 0 || window <= 0 {
+		return nil
+	}
+	return &DeletionBudget{limit: limit, window: window}
+}
+
+// Take는 now에 삭제 하나를 허용할 수 있으면 기록하고 true를 반환합니다.
+// 한도에 도달했으면 다음 삭제가 가능해질 때까지 남은 시간과 false를 반환합니다.
+func (b *DeletionBudget) Take(now time.Time) (time.Duration, bool) {
+	if b == nil {
+		return 0, true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	expired := 0
+	for expired < len(b.taken) && !b.taken[expired].Add(b.window).After(now) {
+		expired++
+	}
+	b.taken = b.taken[expired:]
+	if len(b.taken) >= b.limit {
+		return b.taken[0].Add(b.window).Sub(now), false
+	}
+	b.taken = append(b.taken, now)
+	return 0, true
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestDeletionBudgetSlidingWindow(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	g.Expect(NewDeletionBudget(0, time.Minute)).To(BeNil())
+	var unlimited *DeletionBudget
+	_, ok := unlimited.Take(now)
+	g.Expect(ok).To(BeTrue())
+
+	budget := NewDeletionBudget(2, time.Minute)
+	_, ok = budget.Take(now)
+	g.Expect(ok).To(BeTrue())
+	_, ok = budget.Take(now.Add(20 * time.Second))
+	g.Expect(ok).To(BeTrue())
+
+	// 한도에 도달하면 가장 오래된 삭제가 window를 벗어날 때까지 기다림
+	wait, ok := budget.Take(now.Add(30 * time.Second))
+	g.Expect(ok).To(BeFalse())
+	g.Expect(wait).To(Equal(30 * time.Second))
+
+	_, ok = budget.Take(now.Add(time.Minute))
+	g.Expect(ok).To(BeTrue())
+}
+
+func TestDeletionBudgetThrottlesExpiredOwners(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	other := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"}}
+	otherTTLResource := ttlResource.DeepCopy()
+	otherTTLResource.Name = "ttl-api"
+	otherTTLResource.OwnerReferences = []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: "api"}}
+	r := newTestReconciler(t, pod, ttlResource, other, otherTTLResource)
+	r.DeletionBudget = NewDeletionBudget(1, time.Hour)
+	throttledBefore := testutil.ToFloat64(deletionsThrottledTotal)
+
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{}))).To(BeTrue())
+
+	// 한도를 다 썼으므로 다음 owner는 삭제하지 않고 한도에 여유가 생길 때 다시 시도
+	result := reconcileKey(t, r, "default", "ttl-api")
+	g.Expect(result.RequeueAfter).To(BeNumerically(">", 59*time.Minute))
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(other), &corev1.Pod{})).To(Succeed())
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(otherTTLResource), &ttlv1alpha1.TTLResource{})).To(Succeed())
+	g.Expect(testutil.ToFloat64(deletionsThrottledTotal) - throttledBefore).To(Equal(1.0))
+}
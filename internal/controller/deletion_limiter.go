@@ -0,0 +1,66 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DeletionLimiter는 Operator 전체에서 동시에 진행되는 삭제 API 호출 수를 제한하는 semaphore입니다.
+// ResourceReconciler와 CleanupSweep이 같은 DeletionLimiter를 공유하여 API 서버에 가는 삭제 부하를 한 값으로 조절합니다.
+// nil DeletionLimiter는 제한하지 않습니다.
+type DeletionLimiter struct {
+	slots chan struct{}
+}
+
+// NewDeletionLimiter는 동시에 limit개까지 삭제를 허용하는 DeletionLimiter를 생성합니다. limit이 0 이하이면 nil(제한 없음)입니다.
+func NewDeletionLimiter(limit int) *DeletionLimiter {
+	if limit <= 0 {
+		return nil
+	}
+	return &DeletionLimiter{slots: make(chan struct{}, limit)}
+}
+
+// Acquire는 삭제 슬롯을 얻을 때까지 기다리고, 슬롯을 돌려주는 함수를 반환합니다.
+// ctx가 먼저 끝나면 ctx의 오류를 반환합니다.
+func (l *DeletionLimiter) Acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	select {
+	case l.slots <- struct{}{}:
+		deletionsInFlight.Inc()
+		return func() {
+			deletionsInFlight.Dec()
+			<-l.slots
+		}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Delete는 슬롯을 얻은 뒤 c로 obj를 삭제합니다.
+func (l *DeletionLimiter) Delete(ctx context.Context, c client.Writer, obj client.Object, opts ...client.DeleteOption) error {
+	release, err := l.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return c.Delete(ctx, obj, opts...)
+}
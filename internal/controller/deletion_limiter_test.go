@@ -0,0 +1,99 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestDeletionLimiterBoundsConcurrentDeletes(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	var inFlight, peak atomic.Int32
+	var pods []client.Object
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		pods = append(pods, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}})
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(newTestScheme(t)).
+		WithObjects(pods...).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				current := inFlight.Add(1)
+				defer inFlight.Add(-1)
+				for {
+					old := peak.Load()
+					if current <= old || peak.CompareAndSwap(old, current) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				return c.Delete(ctx, obj, opts...)
+			},
+		}).
+		Build()
+
+	// reconciler와 sweep처럼 여러 곳에서 같은 limiter로 동시에 삭제해도 상한을 넘지 않음
+	limiter := NewDeletionLimiter(2)
+	var wg sync.WaitGroup
+	for _, pod := range pods {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			g.Expect(limiter.Delete(ctx, c, pod)).To(Succeed())
+		}()
+	}
+	wg.Wait()
+	g.Expect(peak.Load()).To(BeNumerically("<=", 2))
+}
+
+func TestDeletionLimiterAcquireHonorsContext(t *testing.T) {
+	g := NewWithT(t)
+
+	limiter := NewDeletionLimiter(1)
+	release, err := limiter.Acquire(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = limiter.Acquire(ctx)
+	g.Expect(err).To(MatchError(context.DeadlineExceeded))
+
+	// 슬롯을 돌려주면 다시 얻을 수 있음
+	release()
+	release, err = limiter.Acquire(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+	release()
+
+	// 0 이하는 제한 없음
+	g.Expect(NewDeletionLimiter(0)).To(BeNil())
+	release, err = NewDeletionLimiter(0).Acquire(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	release()
+}
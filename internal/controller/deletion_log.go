@@ -0,0 +1,205 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+const (
+	// DeletionLogDataKey는 삭제 기록 ConfigMap에서 기록을 JSON Lines로 담는 data 키입니다
+	DeletionLogDataKey = "deletions.jsonl"
+	// DefaultDeletionLogMaxEntries는 삭제 기록 ConfigMap에 남기는 기본 기록 수입니다
+	DefaultDeletionLogMaxEntries = 500
+	// deletionLogBufferSize는 쓰기를 기다리는 삭제 기록 버퍼 크기입니다
+	deletionLogBufferSize = 1000
+)
+
+// DeletionRecord는 만료로 삭제한 owner 하나의 기록입니다.
+type DeletionRecord struct {
+	Time        time.Time `json:"time"`
+	Namespace   string    `json:"namespace"`
+	Kind        string    `json:"kind"`
+	Name        string    `json:"name"`
+	Action      string    `json:"action"`
+	Reason      string    `json:"reason"`
+	TTLResource string    `json:"ttlResource"`
+}
+
+// DeletionLog는 삭제 기록을 ConfigMap에 링 버퍼로 남겨 Event보다 오래 보존되는 삭제 이력을 제공합니다.
+// 삭제를 막지 않도록 기록은 버퍼에 넣고 백그라운드에서 쓰며, 버퍼가 가득 차거나 쓰기에 실패하면 기록을 버립니다(best-effort).
+// nil DeletionLog는 아무 것도 하지 않으며, manager.Runnable로 등록해야 쓰기가 시작됩니다.
+type DeletionLog struct {
+	Client client.Client
+	// ConfigMap은 기록을 남길 ConfigMap입니다. 없으면 만듭니다
+	ConfigMap types.NamespacedName
+	// MaxEntries는 남길 최대 기록 수입니다. 넘으면 오래된 기록부터 지웁니다. 0이면 DefaultDeletionLogMaxEntries입니다
+	MaxEntries int
+
+	records chan DeletionRecord
+}
+
+// NewDeletionLog는 configMap에 최대 maxEntries개의 삭제 기록을 남기는 DeletionLog를 생성합니다.
+func NewDeletionLog(c client.Client, configMap types.NamespacedName, maxEntries int) *DeletionLog {
+	return &DeletionLog{
+		Client:     c,
+		ConfigMap:  configMap,
+		MaxEntries: maxEntries,
+		records:    make(chan DeletionRecord, deletionLogBufferSize),
+	}
+}
+
+// Record는 기록을 버퍼에 넣습니다. 버퍼가 가득 차면 기다리지 않고 버립니다.
+func (l *DeletionLog) Record(record DeletionRecord) {
+	if l == nil {
+		return
+	}
+	select {
+	case l.records <- record:
+	default:
+		deletionLogRecordsTotal.WithLabelValues("dropped").Inc()
+	}
+}
+
+// Start는 ctx가 끝날 때까지 버퍼의 기록을 ConfigMap에 씁니다. 한 번에 쌓인 기록은 한 번의 쓰기로 묶습니다.
+func (l *DeletionLog) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("deletion-log")
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case record := <-l.records:
+			l.write(ctx, append([]DeletionRecord{record}, l.drain()...), logger)
+		}
+	}
+}
+
+// NeedLeaderElection은 리더가 아니게 된 뒤에도 버퍼에 남은 기록을 쓸 수 있도록 false를 반환합니다.
+func (l *DeletionLog) NeedLeaderElection() bool {
+	return false
+}
+
+// drain은 버퍼에 이미 쌓여 있는 기록을 기다리지 않고 모두 꺼냅니다.
+func (l *DeletionLog) drain() []DeletionRecord {
+	var records []DeletionRecord
+	for {
+		select {
+		case record := <-l.records:
+			records = append(records, record)
+		default:
+			return records
+		}
+	}
+}
+
+// write는 records를 ConfigMap 끝에 덧붙이고 MaxEntries를 넘는 오래된 기록을 지웁니다.
+// 다른 replica와 동시에 쓰면 resourceVersion 충돌이 나므로 다시 읽어 재시도합니다. 실패는 로그만 남깁니다.
+func (l *DeletionLog) write(ctx context.Context, records []DeletionRecord, logger logr.Logger) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		return l.append(ctx, records)
+	})
+	if err != nil {
+		logger.Error(err, "Failed to write deletion records", "configMap", l.ConfigMap, "records", len(records))
+		deletionLogRecordsTotal.WithLabelValues("failure").Add(float64(len(records)))
+		return
+	}
+	deletionLogRecordsTotal.WithLabelValues("success").Add(float64(len(records)))
+}
+
+// append는 ConfigMap을 한 번 읽고 기록을 덧붙여 씁니다. ConfigMap이 없으면 만듭니다.
+func (l *DeletionLog) append(ctx context.Context, records []DeletionRecord) error {
+	var configMap corev1.ConfigMap
+	if err := l.Client.Get(ctx, l.ConfigMap, &configMap); errors.IsNotFound(err) {
+		data, err := l.encode(nil, records)
+		if err != nil {
+			return err
+		}
+		return l.Client.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      l.ConfigMap.Name,
+				Namespace: l.ConfigMap.Namespace,
+				Labels:    map[string]string{"app.kubernetes.io/managed-by": "ttl-operator"},
+			},
+			Data: map[string]string{DeletionLogDataKey: data},
+		})
+	} else if err != nil {
+		return err
+	}
+
+	data, err := l.encode([]byte(configMap.Data[DeletionLogDataKey]), records)
+	if err != nil {
+		return err
+	}
+	base := configMap.DeepCopy()
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[DeletionLogDataKey] = data
+	return l.Client.Patch(ctx, &configMap, client.MergeFromWithOptions(base, client.MergeFromWithOptimisticLock{}))
+}
+
+// encode는 기존 기록(JSON Lines) 뒤에 records를 덧붙이고 최근 MaxEntries개만 남긴 JSON Lines를 반환합니다.
+func (l *DeletionLog) encode(existing []byte, records []DeletionRecord) (string, error) {
+	var lines [][]byte
+	if trimmed := bytes.TrimSpace(existing); len(trimmed) > 0 {
+		lines = bytes.Split(trimmed, []byte("\n"))
+	}
+	for _, record := range records {
+		line, err := json.Marshal(record)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode deletion record: %w", err)
+		}
+		lines = append(lines, line)
+	}
+	maxEntries := l.MaxEntries
+	if maxEntries <= 0 {
+		maxEntries = DefaultDeletionLogMaxEntries
+	}
+	if len(lines) > maxEntries {
+		lines = lines[len(lines)-maxEntries:]
+	}
+	return string(bytes.Join(lines, []byte("\n"))) + "\n", nil
+}
+
+// newDeletionRecord는 TTLResource 만료로 owner를 처리한 기록을 만듭니다.
+// reason에는 만료된 TTL을 어디에서 가져왔는지(TTL 출처)를 남깁니다.
+func newDeletionRecord(ttlResource *ttlv1alpha1.TTLResource, ownerRef metav1.OwnerReference, action string, now time.Time) DeletionRecord {
+	return DeletionRecord{
+		Time:        now.UTC(),
+		Namespace:   ttlResource.Namespace,
+		Kind:        ownerRef.Kind,
+		Name:        ownerRef.Name,
+		Action:      action,
+		Reason:      "TTL expired (source: " + ttlSourceOf(ttlResource) + ")",
+		TTLResource: ttlResource.Name,
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// deletionLogRecords는 삭제 기록 ConfigMap의 기록을 순서대로 읽습니다.
+func deletionLogRecords(t *testing.T, c client.Client, key types.NamespacedName) []DeletionRecord {
+	t.Helper()
+	g := NewWithT(t)
+	var configMap corev1.ConfigMap
+	g.Expect(c.Get(context.Background(), key, &configMap)).To(Succeed())
+	var records []DeletionRecord
+	for _, line := range strings.Split(strings.TrimSpace(configMap.Data[DeletionLogDataKey]), "\n") {
+		var record DeletionRecord
+		g.Expect(json.Unmarshal([]byte(line), &record)).To(Succeed())
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestDeletionLogWriteKeepsMaxEntries(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	key := types.NamespacedName{Namespace: "ttl-operator-system", Name: "ttl-deletions"}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme(t)).Build()
+	deletionLog := NewDeletionLog(c, key, 3)
+	now := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	record := func(name string) DeletionRecord {
+		return DeletionRecord{Time: now, Namespace: "default", Kind: "Pod", Name: name, Action: "delete"}
+	}
+
+	// ConfigMap이 없으면 만듦
+	deletionLog.write(ctx, []DeletionRecord{record("a"), record("b")}, logf.Log)
+	g.Expect(deletionLogRecords(t, c, key)).To(HaveLen(2))
+
+	// 최대 기록 수를 넘으면 오래된 기록부터 지움
+	deletionLog.write(ctx, []DeletionRecord{record("c"), record("d")}, logf.Log)
+	records := deletionLogRecords(t, c, key)
+	g.Expect(records).To(HaveLen(3))
+	g.Expect(records[0].Name).To(Equal("b"))
+	g.Expect(records[2].Name).To(Equal("d"))
+	g.Expect(records[2].Time).To(Equal(now))
+}
+
+func TestDeletionLogRecordDoesNotBlock(t *testing.T) {
+	g := NewWithT(t)
+
+	// nil DeletionLog는 아무 것도 하지 않음
+	var disabled *DeletionLog
+	disabled.Record(DeletionRecord{Name: "web"})
+
+	deletionLog := &DeletionLog{records: make(chan DeletionRecord, 1)}
+	deletionLog.Record(DeletionRecord{Name: "first"})
+	// 버퍼가 가득 차면 기다리지 않고 버림
+	deletionLog.Record(DeletionRecord{Name: "second"})
+	records := deletionLog.drain()
+	g.Expect(records).To(HaveLen(1))
+	g.Expect(records[0].Name).To(Equal("first"))
+}
+
+func TestDeleteExpiredResourcesRecordsDeletion(t *testing.T) {
+	g := NewWithT(t)
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	ttlResource.Annotations = map[string]string{TTLSourceAnnotationKey: TTLSourceAnnotation}
+	r := newTestReconciler(t, pod, ttlResource)
+	r.DeletionLog = NewDeletionLog(r.Client, types.NamespacedName{Namespace: "ttl-operator-system", Name: "ttl-deletions"}, 0)
+
+	reconcileKey(t, r, "default", "ttl-web")
+
+	records := r.DeletionLog.drain()
+	g.Expect(records).To(HaveLen(1))
+	g.Expect(records[0].Time).NotTo(BeZero())
+	records[0].Time = time.Time{}
+	g.Expect(records[0]).To(Equal(DeletionRecord{
+		Namespace:   "default",
+		Kind:        "Pod",
+		Name:        "web",
+		Action:      "delete",
+		Reason:      "TTL expired (source: annotation)",
+		TTLResource: "ttl-web",
+	}))
+}
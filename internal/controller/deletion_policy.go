@@ -0,0 +1,162 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// PropagationPolicyAnnotationKey는 owner 삭제 시 사용할 propagation policy(Background, Foreground, Orphan)를 지정하는 annotation 키입니다
+	PropagationPolicyAnnotationKey = "ttl.example.com/propagation-policy"
+	// ExpiryActionAnnotationKey는 만료 시 owner에 수행할 동작(delete, evict)을 지정하는 annotation 키입니다
+	ExpiryActionAnnotationKey = "ttl.example.com/expiry-action"
+)
+
+// 만료 시 owner에 수행하는 동작
+const (
+	// ExpiryActionDelete는 owner를 삭제합니다
+	ExpiryActionDelete = "delete"
+	// ExpiryActionEvict는 Pod를 Eviction API로 내보냅니다. Pod가 아니면 delete와 같습니다
+	ExpiryActionEvict = "evict"
+	// ExpiryActionTrash는 owner의 사본을 --trash-namespace에 만든 뒤 원본을 삭제합니다
+	ExpiryActionTrash = "trash"
+)
+
+// DeletionPolicy는 owner를 삭제하는 방식입니다.
+type DeletionPolicy struct {
+	// PropagationPolicy는 삭제 시 하위 리소스 처리 방식입니다. 비어 있으면 API 서버 기본값을 사용합니다
+	PropagationPolicy metav1.DeletionPropagation
+	// Action은 만료 시 owner에 수행할 동작입니다
+	Action string
+}
+
+// DefaultKindDeletionPolicies는 Kind별 기본 삭제 방식입니다.
+// Deployment는 ReplicaSet과 Pod가 먼저 정리되도록 Foreground로 삭제합니다.
+var DefaultKindDeletionPolicies = map[string]DeletionPolicy{
+	"Pod":           {PropagationPolicy: metav1.DeletePropagationBackground, Action: ExpiryActionDelete},
+	"Service":       {PropagationPolicy: metav1.DeletePropagationBackground, Action: ExpiryActionDelete},
+	"Deployment":    {PropagationPolicy: metav1.DeletePropagationForeground, Action: ExpiryActionDelete},
+	"ConfigMap":     {PropagationPolicy: metav1.DeletePropagationBackground, Action: ExpiryActionDelete},
+	"Job":           {PropagationPolicy: metav1.DeletePropagationBackground, Action: ExpiryActionDelete},
+	"Endpoints":     {PropagationPolicy: metav1.DeletePropagationBackground, Action: ExpiryActionDelete},
+	"EndpointSlice": {PropagationPolicy: metav1.DeletePropagationBackground, Action: ExpiryActionDelete},
+}
+
+// ParseKindDeletionPolicies는 "Kind=Policy[/action],..." 형식의 문자열을 파싱하여 기본값에 덮어쓴 Kind별 삭제 방식을 반환합니다.
+// 예: "Deployment=Background,Pod=Background/evict"
+func ParseKindDeletionPolicies(value string) (map[string]DeletionPolicy, error) {
+	policies := make(map[string]DeletionPolicy, len(DefaultKindDeletionPolicies))
+	for kind, policy := range DefaultKindDeletionPolicies {
+		policies[kind] = policy
+	}
+	if strings.TrimSpace(value) == "" {
+		return policies, nil
+	}
+
+	for _, entry := range strings.Split(value, ",") {
+		kind, spec, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok {
+			return nil, fmt.Errorf("expected Kind=Policy[/action], got %q", entry)
+		}
+		if _, supported := supportedKinds[kind]; !supported {
+			return nil, fmt.Errorf("unsupported kind %q", kind)
+		}
+		propagation, action, _ := strings.Cut(spec, "/")
+		policy := policies[kind]
+		if propagation != "" {
+			p, err := parsePropagationPolicy(propagation)
+			if err != nil {
+				return nil, err
+			}
+			policy.PropagationPolicy = p
+		}
+		if action != "" {
+			if err := validateExpiryAction(action); err != nil {
+				return nil, err
+			}
+			policy.Action = action
+		}
+		policies[kind] = policy
+	}
+	return policies, nil
+}
+
+func parsePropagationPolicy(value string) (metav1.DeletionPropagation, error) {
+	switch p := metav1.DeletionPropagation(value); p {
+	case metav1.DeletePropagationBackground, metav1.DeletePropagationForeground, metav1.DeletePropagationOrphan:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unknown propagation policy %q (expected Background, Foreground or Orphan)", value)
+	}
+}
+
+func validateExpiryAction(action string) error {
+	switch action {
+	case ExpiryActionDelete, ExpiryActionEvict, ExpiryActionTrash:
+		return nil
+	default:
+		return fmt.Errorf("unknown expiry action %q (expected %s, %s or %s)", action, ExpiryActionDelete, ExpiryActionEvict, ExpiryActionTrash)
+	}
+}
+
+// deletionPolicyFor는 owner의 annotation과 Kind별 기본값으로 삭제 방식을 결정합니다. annotation이 우선합니다.
+// owner가 nil이면 Kind별 기본값만 사용합니다.
+func (r *ResourceReconciler) deletionPolicyFor(kind string, owner client.Object, logger logr.Logger) DeletionPolicy {
+	policies := r.KindDeletionPolicies
+	if policies == nil {
+		policies = DefaultKindDeletionPolicies
+	}
+	policy, ok := policies[kind]
+	if !ok {
+		policy = DeletionPolicy{Action: ExpiryActionDelete}
+	}
+	if r.RespectPDB {
+		// --respect-pdb는 Pod의 기본 동작을 evict로 바꿈
+		policy.Action = ExpiryActionEvict
+	}
+	if owner == nil {
+		return policy
+	}
+
+	annotations := owner.GetAnnotations()
+	if value, ok := annotations[PropagationPolicyAnnotationKey]; ok {
+		if p, err := parsePropagationPolicy(value); err == nil {
+			policy.PropagationPolicy = p
+		} else {
+			logger.Info("Invalid propagation-policy annotation, using default", "owner", owner.GetName(), "error", err.Error())
+		}
+	}
+	if value, ok := annotations[ExpiryActionAnnotationKey]; ok {
+		if err := validateExpiryAction(value); err == nil {
+			policy.Action = value
+		} else {
+			logger.Info("Invalid expiry-action annotation, using default", "owner", owner.GetName(), "error", err.Error())
+		}
+	}
+	if policy.Action == ExpiryActionTrash && r.TrashNamespace == "" {
+		// 보관할 네임스페이스가 없으면 사본 없이 삭제
+		logger.Info("Expiry action trash requires --trash-namespace, deleting instead", "owner", owner.GetName())
+		policy.Action = ExpiryActionDelete
+	}
+	return policy
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestParseKindDeletionPolicies(t *testing.T) {
+	g := NewWithT(t)
+
+	policies, err := ParseKindDeletionPolicies("")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(policies).To(Equal(DefaultKindDeletionPolicies))
+
+	policies, err = ParseKindDeletionPolicies("Deployment=Background, Pod=/evict")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(policies["Deployment"]).To(Equal(DeletionPolicy{PropagationPolicy: metav1.DeletePropagationBackground, Action: ExpiryActionDelete}))
+	g.Expect(policies["Pod"]).To(Equal(DeletionPolicy{PropagationPolicy: metav1.DeletePropagationBackground, Action: ExpiryActionEvict}))
+	g.Expect(policies["Service"]).To(Equal(DefaultKindDeletionPolicies["Service"]))
+	// 기본값은 바뀌지 않아야 함
+	g.Expect(DefaultKindDeletionPolicies["Deployment"].PropagationPolicy).To(Equal(metav1.DeletePropagationForeground))
+
+	for _, invalid := range []string{"Deployment", "Secret=Background", "Pod=Sideways", "Pod=Background/archive"} {
+		_, err := ParseKindDeletionPolicies(invalid)
+		g.Expect(err).To(HaveOccurred(), "%q should be rejected", invalid)
+	}
+}
+
+// reconcileExpiredPodCapturingPropagation은 만료된 Pod를 삭제하고 Pod 삭제 요청의 PropagationPolicy를 반환합니다.
+func reconcileExpiredPodCapturingPropagation(t *testing.T, policies map[string]DeletionPolicy, annotations map[string]string) *metav1.DeletionPropagation {
+	t.Helper()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	pod.Annotations = annotations
+
+	var propagation *metav1.DeletionPropagation
+	scheme := newTestScheme(t)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(pod, ttlResource).
+		WithStatusSubresource(&ttlv1alpha1.TTLResource{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				if _, ok := obj.(*corev1.Pod); ok {
+					propagation = (&client.DeleteOptions{}).ApplyOptions(opts).PropagationPolicy
+				}
+				return c.Delete(ctx, obj, opts...)
+			},
+		}).
+		Build()
+	r := &ResourceReconciler{Client: c, Scheme: scheme, KindDeletionPolicies: policies}
+
+	reconcileKey(t, r, "default", "ttl-web")
+	return propagation
+}
+
+func TestKindDeletionPolicyAppliedToOwnerDeletion(t *testing.T) {
+	g := NewWithT(t)
+
+	// 설정이 없으면 내장 기본값(Pod는 Background) 사용
+	propagation := reconcileExpiredPodCapturingPropagation(t, nil, nil)
+	g.Expect(propagation).NotTo(BeNil())
+	g.Expect(*propagation).To(Equal(metav1.DeletePropagationBackground))
+
+	policies := map[string]DeletionPolicy{"Pod": {PropagationPolicy: metav1.DeletePropagationForeground, Action: ExpiryActionDelete}}
+	propagation = reconcileExpiredPodCapturingPropagation(t, policies, nil)
+	g.Expect(propagation).NotTo(BeNil())
+	g.Expect(*propagation).To(Equal(metav1.DeletePropagationForeground))
+
+	// 리소스의 annotation이 Kind별 기본값보다 우선
+	propagation = reconcileExpiredPodCapturingPropagation(t, policies,
+		map[string]string{PropagationPolicyAnnotationKey: string(metav1.DeletePropagationOrphan)})
+	g.Expect(propagation).NotTo(BeNil())
+	g.Expect(*propagation).To(Equal(metav1.DeletePropagationOrphan))
+
+	// 잘못된 annotation은 무시
+	propagation = reconcileExpiredPodCapturingPropagation(t, policies,
+		map[string]string{PropagationPolicyAnnotationKey: "Sideways"})
+	g.Expect(propagation).NotTo(BeNil())
+	g.Expect(*propagation).To(Equal(metav1.DeletePropagationForeground))
+}
+
+func TestExpiryActionAnnotationEvictsPod(t *testing.T) {
+	g := NewWithT(t)
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	pod.Annotations = map[string]string{ExpiryActionAnnotationKey: ExpiryActionEvict}
+
+	evicted := false
+	scheme := newTestScheme(t)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(pod, ttlResource).
+		WithStatusSubresource(&ttlv1alpha1.TTLResource{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceCreate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+				if subResourceName == "eviction" {
+					evicted = true
+				}
+				return c.SubResource(subResourceName).Create(ctx, obj, subResource, opts...)
+			},
+		}).
+		Build()
+	r := &ResourceReconciler{Client: c, Scheme: scheme}
+
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(evicted).To(BeTrue(), "expiry-action=evict should use the Eviction API")
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// DeletionMaxRetriesAnnotationKey는 TTLResource마다 삭제 실패 후 재시도 횟수 상한을 지정하는 annotation 키입니다.
+// spec.maxDeletionRetries와 --deletion-max-retries보다 우선하며, 0이면 성공할 때까지 재시도합니다
+const DeletionMaxRetriesAnnotationKey = "ttl.example.com/deletion-max-retries"
+
+// DefaultDeletionMaxRetries는 --deletion-max-retries의 기본값입니다
+const DefaultDeletionMaxRetries = 10
+
+// deletionRetryBackoffMax는 삭제 실패 후 재시도 간격의 상한입니다
+const deletionRetryBackoffMax = 10 * time.Minute
+
+// deletionMaxRetries는 TTLResource의 annotation, spec.maxDeletionRetries, --deletion-max-retries 순으로
+// 재시도 횟수 상한을 결정합니다. 0이면 제한이 없습니다.
+func (r *ResourceReconciler) deletionMaxRetries(ttlResource *ttlv1alpha1.TTLResource, logger logr.Logger) int {
+	defaultMaxRetries := r.DeletionMaxRetries
+	if ttlResource.Spec.MaxDeletionRetries > 0 {
+		defaultMaxRetries = ttlResource.Spec.MaxDeletionRetries
+	}
+	value, ok := ttlResource.Annotations[DeletionMaxRetriesAnnotationKey]
+	if !ok {
+		return defaultMaxRetries
+	}
+	maxRetries, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || maxRetries < 0 {
+		logger.Info("Invalid deletion-max-retries annotation, using default", "name", ttlResource.Name, "value", value)
+		return defaultMaxRetries
+	}
+	return maxRetries
+}
+
+// deletionRetriesExhausted는 삭제 실패가 재시도 횟수 상한을 넘어 더 이상 시도하지 않아야 하는지 확인합니다.
+// annotation으로 상한을 올리면 다시 시도합니다.
+func (r *ResourceReconciler) deletionRetriesExhausted(ttlResource *ttlv1alpha1.TTLResource, logger logr.Logger) bool {
+	maxRetries := r.deletionMaxRetries(ttlResource, logger)
+	return maxRetries > 0 && ttlResource.Status.RetryCount > maxRetries
+}
+
+// deletionRetryBackoff는 retryCount번째 실패 후의 재시도 간격을 반환합니다.
+// DeletionRetryBackoff(기본 30초)에서 시작하여 실패할 때마다 두 배씩 늘어나며 최대 10분입니다.
+func (r *ResourceReconciler) deletionRetryBackoff(retryCount int) time.Duration {
+	backoff := r.DeletionRetryBackoff
+	if backoff <= 0 {
+		backoff = deletionFailedRequeueInterval
+	}
+	for i := 1; i < retryCount && backoff < deletionRetryBackoffMax; i++ {
+		backoff *= 2
+	}
+	return min(backoff, deletionRetryBackoffMax)
+}
+
+// deferFailedDeletion은 오류로 삭제하지 못한 대상을 DeletionFailed condition과 status.retryCount로 기록하고
+// 늘어나는 간격으로 다시 시도합니다. 재시도 횟수 상한을 넘으면 Warning 이벤트와 DeletionAbandoned condition을 남기고
+// 더 이상 재큐잉하지 않습니다. TTLResource는 수동 조치를 위해 그대로 남습니다.
+func (r *ResourceReconciler) deferFailedDeletion(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource,
+	failed []string, logger logr.Logger) (ctrl.Result, error) {
+	ttlResource.Status.RetryCount++
+	targets := strings.Join(failed, ", ")
+
+	if r.deletionRetriesExhausted(ttlResource, logger) {
+		message := fmt.Sprintf("gave up deleting %s after %d failed attempts", targets, ttlResource.Status.RetryCount)
+		r.recordEvent(ttlResource, corev1.EventTypeWarning, ConditionDeletionAbandoned, message)
+		meta.SetStatusCondition(&ttlResource.Status.Conditions, metav1.Condition{
+			Type:               ConditionDeletionFailed,
+			Status:             metav1.ConditionTrue,
+			Reason:             "RetriesExhausted",
+			Message:            message,
+			ObservedGeneration: ttlResource.Generation,
+			LastTransitionTime: metav1.NewTime(r.now()),
+		})
+		return r.deferDeletion(ctx, ttlResource, metav1.Condition{
+			Type:    ConditionDeletionAbandoned,
+			Status:  metav1.ConditionTrue,
+			Reason:  "RetriesExhausted",
+			Message: message,
+		}, 0, logger)
+	}
+
+	// 상한을 올려 다시 시도하는 중이면 포기 상태를 지움
+	meta.RemoveStatusCondition(&ttlResource.Status.Conditions, ConditionDeletionAbandoned)
+
+	return r.deferDeletion(ctx, ttlResource, metav1.Condition{
+		Type:    ConditionDeletionFailed,
+		Status:  metav1.ConditionTrue,
+		Reason:  "DeleteError",
+		Message: fmt.Sprintf("failed to delete %s (attempt %d)", targets, ttlResource.Status.RetryCount),
+	}, r.deletionRetryBackoff(ttlResource.Status.RetryCount), logger)
+}
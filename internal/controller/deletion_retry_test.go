@@ -0,0 +1,126 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestDeletionRetryBackoffDoublesUpToMax(t *testing.T) {
+	g := NewWithT(t)
+
+	r := &ResourceReconciler{}
+	g.Expect(r.deletionRetryBackoff(1)).To(Equal(30 * time.Second))
+	g.Expect(r.deletionRetryBackoff(2)).To(Equal(time.Minute))
+	g.Expect(r.deletionRetryBackoff(3)).To(Equal(2 * time.Minute))
+	g.Expect(r.deletionRetryBackoff(20)).To(Equal(deletionRetryBackoffMax))
+
+	r.DeletionRetryBackoff = time.Second
+	g.Expect(r.deletionRetryBackoff(4)).To(Equal(8 * time.Second))
+}
+
+func TestFailedDeletionIsRetriedUntilMaxRetries(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	scheme := newTestScheme(t)
+	failing := true
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(pod, ttlResource).
+		WithStatusSubresource(&ttlv1alpha1.TTLResource{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				if _, ok := obj.(*corev1.Pod); ok && failing {
+					return stderrors.New("connection reset by peer")
+				}
+				return c.Delete(ctx, obj, opts...)
+			},
+		}).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &ResourceReconciler{Client: c, Scheme: scheme, Recorder: recorder, DeletionMaxRetries: 2}
+
+	latest := func() ttlv1alpha1.TTLResource {
+		var current ttlv1alpha1.TTLResource
+		g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &current)).To(Succeed())
+		return current
+	}
+
+	// 실패할 때마다 retryCount가 늘고 재시도 간격이 두 배가 됨
+	g.Expect(reconcileKey(t, r, "default", "ttl-web").RequeueAfter).To(Equal(30 * time.Second))
+	g.Expect(latest().Status.RetryCount).To(Equal(1))
+	g.Expect(reconcileKey(t, r, "default", "ttl-web").RequeueAfter).To(Equal(time.Minute))
+	g.Expect(latest().Status.RetryCount).To(Equal(2))
+
+	// 상한을 넘으면 더 이상 재큐잉하지 않고 Warning 이벤트를 남김
+	g.Expect(reconcileKey(t, r, "default", "ttl-web").RequeueAfter).To(BeZero())
+	exhausted := latest()
+	g.Expect(exhausted.Status.RetryCount).To(Equal(3))
+	condition := meta.FindStatusCondition(exhausted.Status.Conditions, ConditionDeletionFailed)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Reason).To(Equal("RetriesExhausted"))
+	g.Expect(meta.IsStatusConditionTrue(exhausted.Status.Conditions, ConditionDeletionAbandoned)).To(BeTrue())
+	g.Expect(recorder.Events).To(Receive(ContainSubstring("gave up deleting Pod/web")))
+
+	// 다른 이벤트로 reconcile되어도 다시 시도하지 않음
+	failing = false
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+
+	// annotation으로 상한을 올리면 다시 시도하여 성공 후 TTLResource도 삭제
+	exhausted.Annotations = map[string]string{DeletionMaxRetriesAnnotationKey: "5"}
+	g.Expect(r.Update(ctx, &exhausted)).To(Succeed())
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{}))).To(BeTrue())
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &ttlv1alpha1.TTLResource{}))).To(BeTrue())
+}
+
+func TestDeletionMaxRetriesPrecedence(t *testing.T) {
+	g := NewWithT(t)
+	logger := logr.Discard()
+
+	r := &ResourceReconciler{DeletionMaxRetries: DefaultDeletionMaxRetries}
+	ttlResource := &ttlv1alpha1.TTLResource{}
+	g.Expect(r.deletionMaxRetries(ttlResource, logger)).To(Equal(DefaultDeletionMaxRetries))
+
+	// spec.maxDeletionRetries가 전역 설정보다 우선
+	ttlResource.Spec.MaxDeletionRetries = 3
+	g.Expect(r.deletionMaxRetries(ttlResource, logger)).To(Equal(3))
+
+	// 수동 조치용 annotation이 가장 우선하며 0이면 제한 없음
+	ttlResource.Annotations = map[string]string{DeletionMaxRetriesAnnotationKey: "0"}
+	g.Expect(r.deletionMaxRetries(ttlResource, logger)).To(BeZero())
+	ttlResource.Annotations[DeletionMaxRetriesAnnotationKey] = "invalid"
+	g.Expect(r.deletionMaxRetries(ttlResource, logger)).To(Equal(3))
+}
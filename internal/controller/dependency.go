@@ -0,0 +1,256 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+const (
+	// DeleteAfterAnnotationKey는 먼저 삭제되어야 하는 의존 리소스를 "Kind/name" 형식으로 지정하는 annotation 키입니다
+	DeleteAfterAnnotationKey = "ttl.example.com/delete-after"
+	// deleteAfterRequeueInterval은 의존 리소스가 사라지기를 기다리는 동안의 재큐잉 간격입니다
+	deleteAfterRequeueInterval = 5 * time.Second
+	// maxDeleteAfterDepth는 순환 탐지 시 따라가는 delete-after 체인의 최대 길이입니다
+	maxDeleteAfterDepth = 16
+)
+
+// resourceRef는 같은 네임스페이스 안의 리소스를 Kind와 이름으로 가리킵니다.
+type resourceRef struct {
+	Kind string
+	Name string
+}
+
+func (ref resourceRef) String() string {
+	return ref.Kind + "/" + ref.Name
+}
+
+// parseResourceRef는 "Kind/name" 형식의 문자열을 파싱합니다.
+func parseResourceRef(value string) (resourceRef, error) {
+	kind, name, ok := strings.Cut(strings.TrimSpace(value), "/")
+	if !ok || kind == "" || name == "" {
+		return resourceRef{}, fmt.Errorf("expected Kind/name, got %q", value)
+	}
+	if _, ok := supportedKinds[kind]; !ok {
+		return resourceRef{}, fmt.Errorf("unsupported kind %q", kind)
+	}
+	return resourceRef{Kind: kind, Name: name}, nil
+}
+
+// getRef는 resourceRef가 가리키는 리소스를 조회합니다.
+func (r *ResourceReconciler) getRef(ctx context.Context, ref resourceRef, namespace string) (client.Object, error) {
+	gvk := supportedKinds[ref.Kind]
+	return r.getOwnerObject(ctx, metav1.OwnerReference{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Name:       ref.Name,
+	}, namespace)
+}
+
+// deleteAfterOf는 리소스의 delete-after annotation을 파싱합니다. annotation이 없거나 잘못되었으면 false를 반환합니다.
+func deleteAfterOf(obj client.Object) (resourceRef, bool, error) {
+	value, ok := obj.GetAnnotations()[DeleteAfterAnnotationKey]
+	if !ok {
+		return resourceRef{}, false, nil
+	}
+	ref, err := parseResourceRef(value)
+	if err != nil {
+		return resourceRef{}, false, err
+	}
+	return ref, true, nil
+}
+
+// waitForDeleteAfter는 삭제 대상(owners)의 delete-after 의존 리소스가 아직 존재하여 삭제를 미뤄야 하는지 확인하고, 기다리는 의존 리소스를 반환합니다.
+// 같은 배치에서 함께 삭제할 의존 리소스는 orderOwnersByDeleteAfter가 먼저 삭제하므로 기다리지 않습니다.
+// 의존 관계가 순환하면 교착을 피하기 위해 기다리지 않습니다.
+func (r *ResourceReconciler) waitForDeleteAfter(ctx context.Context, owners []metav1.OwnerReference, namespace string, logger logr.Logger) (resourceRef, bool, error) {
+	batch := make(map[resourceRef]bool, len(owners))
+	for _, ownerRef := range owners {
+		batch[resourceRef{Kind: ownerRef.Kind, Name: ownerRef.Name}] = true
+	}
+	for _, ownerRef := range owners {
+		dependency, waiting, err := r.waitForOwnerDeleteAfter(ctx, ownerRef, namespace, batch, logger)
+		if err != nil || waiting {
+			return dependency, waiting, err
+		}
+	}
+	return resourceRef{}, false, nil
+}
+
+// waitForOwnerDeleteAfter는 owner 하나의 delete-after 의존 리소스 중 배치 밖에 아직 남아 있는 리소스를 확인합니다.
+func (r *ResourceReconciler) waitForOwnerDeleteAfter(ctx context.Context, ownerRef metav1.OwnerReference, namespace string,
+	batch map[resourceRef]bool, logger logr.Logger) (resourceRef, bool, error) {
+	owner, err := r.getOwnerObject(ctx, ownerRef, namespace)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return resourceRef{}, false, nil
+		}
+		return resourceRef{}, false, err
+	}
+
+	dependency, ok, err := deleteAfterOf(owner)
+	if err != nil {
+		logger.Info("Invalid delete-after annotation, ignoring", "owner", ownerRef.Name, "error", err.Error())
+		return resourceRef{}, false, nil
+	}
+	if !ok || batch[dependency] {
+		return resourceRef{}, false, nil
+	}
+
+	if _, err := r.getRef(ctx, dependency, namespace); err != nil {
+		if errors.IsNotFound(err) {
+			return resourceRef{}, false, nil
+		}
+		return resourceRef{}, false, err
+	}
+
+	origin := resourceRef{Kind: ownerRef.Kind, Name: ownerRef.Name}
+	if r.hasDeleteAfterCycle(ctx, namespace, origin, dependency) {
+		logger.Info("Cycle detected in delete-after chain, deleting without waiting",
+			"owner", origin.String(), "dependency", dependency.String())
+		return resourceRef{}, false, nil
+	}
+
+	logger.Info("Waiting for delete-after dependency to be deleted",
+		"owner", origin.String(), "dependency", dependency.String())
+	return dependency, true, nil
+}
+
+// hasDeleteAfterCycle은 next부터 delete-after 체인을 따라가 origin으로 되돌아오는지 확인합니다.
+func (r *ResourceReconciler) hasDeleteAfterCycle(ctx context.Context, namespace string, origin, next resourceRef) bool {
+	visited := map[resourceRef]bool{origin: true}
+	current := next
+	for i := 0; i < maxDeleteAfterDepth; i++ {
+		obj, err := r.getRef(ctx, current, namespace)
+		if err != nil {
+			return false
+		}
+		dependency, ok, err := deleteAfterOf(obj)
+		if err != nil || !ok {
+			return false
+		}
+		if dependency == origin {
+			return true
+		}
+		if visited[dependency] {
+			// origin을 포함하지 않는 순환은 해당 리소스들이 각자 끊음
+			return false
+		}
+		visited[dependency] = true
+		current = dependency
+	}
+	// 체인이 지나치게 길면 순환으로 간주
+	return true
+}
+
+// EventReasonDeleteAfterCycle은 배치 삭제 대상 사이의 delete-after 관계가 순환하여 순서 없이 삭제했음을 알리는 Event reason입니다
+const EventReasonDeleteAfterCycle = "DeleteAfterCycle"
+
+// orderOwnersByDeleteAfter는 한 번에 삭제할 owners를 그 사이의 delete-after 관계로 위상 정렬하여 의존 리소스가 먼저 오도록 합니다.
+// 배치 안에서 각 owner가 기다리는 의존 리소스를 함께 반환하며, deleteOwnersInBatch는 의존 리소스 삭제가 받아들여지지 않으면 그 owner를 삭제하지 않습니다.
+// 관계가 순환하면 경고를 남기고 원래 순서로 삭제합니다.
+func (r *ResourceReconciler) orderOwnersByDeleteAfter(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource,
+	owners []metav1.OwnerReference, logger logr.Logger) ([]metav1.OwnerReference, map[resourceRef]resourceRef) {
+	dependencies := map[resourceRef]resourceRef{}
+	for _, ownerRef := range owners {
+		owner, err := r.getOwnerObject(ctx, ownerRef, ttlResource.Namespace)
+		if err != nil {
+			// 조회할 수 없는 owner는 삭제 단계에서 처리
+			continue
+		}
+		if dependency, ok, err := deleteAfterOf(owner); err == nil && ok {
+			dependencies[resourceRef{Kind: ownerRef.Kind, Name: ownerRef.Name}] = dependency
+		}
+	}
+	if len(dependencies) == 0 {
+		return owners, nil
+	}
+
+	ordered, cycle := sortByDeleteAfter(owners, dependencies)
+	if len(cycle) > 0 {
+		names := make([]string, 0, len(cycle))
+		for _, ref := range cycle {
+			names = append(names, ref.String())
+		}
+		message := fmt.Sprintf("delete-after annotations form a cycle among %s; deleting them in arbitrary order", strings.Join(names, ", "))
+		// 경고는 Warning Event로 남기고 로그는 일반 Info로 기록
+		logger.Info("Delete-after annotations form a cycle, deleting in arbitrary order", "name", ttlResource.Name, "cycle", names)
+		r.recordEvent(ttlResource, corev1.EventTypeWarning, EventReasonDeleteAfterCycle, message)
+		return owners, nil
+	}
+	return ordered, dependencies
+}
+
+// sortByDeleteAfter는 의존 리소스가 먼저 오도록 owners를 위상 정렬합니다. 관계가 없는 owner끼리는 원래 순서를 유지합니다.
+// 배치 밖의 의존 리소스와 자기 자신을 가리키는 관계는 무시하며, 순환이 있으면 정렬하지 못한 owner를 반환합니다.
+func sortByDeleteAfter(owners []metav1.OwnerReference, dependencies map[resourceRef]resourceRef) ([]metav1.OwnerReference, []resourceRef) {
+	index := make(map[resourceRef]int, len(owners))
+	for i, ownerRef := range owners {
+		index[resourceRef{Kind: ownerRef.Kind, Name: ownerRef.Name}] = i
+	}
+	waiting := make([]bool, len(owners))
+	dependents := make([][]int, len(owners))
+	for i, ownerRef := range owners {
+		dependency, ok := dependencies[resourceRef{Kind: ownerRef.Kind, Name: ownerRef.Name}]
+		if !ok {
+			continue
+		}
+		if j, inBatch := index[dependency]; inBatch && j != i {
+			waiting[i] = true
+			dependents[j] = append(dependents[j], i)
+		}
+	}
+
+	ordered := make([]metav1.OwnerReference, 0, len(owners))
+	done := make([]bool, len(owners))
+	for len(ordered) < len(owners) {
+		// 기다리는 것이 없는 owner 중 원래 순서가 가장 앞선 것부터
+		next := -1
+		for i := range owners {
+			if !done[i] && !waiting[i] {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			var cycle []resourceRef
+			for i, ownerRef := range owners {
+				if !done[i] {
+					cycle = append(cycle, resourceRef{Kind: ownerRef.Kind, Name: ownerRef.Name})
+				}
+			}
+			return nil, cycle
+		}
+		done[next] = true
+		ordered = append(ordered, owners[next])
+		for _, dependent := range dependents[next] {
+			waiting[dependent] = false
+		}
+	}
+	return ordered, nil
+}
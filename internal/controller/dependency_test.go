@@ -0,0 +1,229 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func podRefs(names ...string) []metav1.OwnerReference {
+	refs := make([]metav1.OwnerReference, 0, len(names))
+	for _, name := range names {
+		refs = append(refs, metav1.OwnerReference{APIVersion: "v1", Kind: "Pod", Name: name})
+	}
+	return refs
+}
+
+func TestSortByDeleteAfter(t *testing.T) {
+	g := NewWithT(t)
+
+	pod := func(name string) resourceRef { return resourceRef{Kind: "Pod", Name: name} }
+
+	// frontend → api → db 순서로 의존하면 db부터, 관계없는 cache는 원래 자리를 지킴
+	ordered, cycle := sortByDeleteAfter(podRefs("frontend", "cache", "api", "db"), map[resourceRef]resourceRef{
+		pod("frontend"): pod("api"),
+		pod("api"):      pod("db"),
+	})
+	g.Expect(cycle).To(BeEmpty())
+	g.Expect(ordered).To(Equal(podRefs("cache", "db", "api", "frontend")))
+
+	// 배치 밖의 의존 리소스와 자기 자신을 가리키는 관계는 무시
+	ordered, cycle = sortByDeleteAfter(podRefs("a", "b"), map[resourceRef]resourceRef{
+		pod("a"): pod("outside"),
+		pod("b"): pod("b"),
+	})
+	g.Expect(cycle).To(BeEmpty())
+	g.Expect(ordered).To(Equal(podRefs("a", "b")))
+
+	// 순환하면 정렬하지 못한 대상을 반환
+	_, cycle = sortByDeleteAfter(podRefs("a", "b", "c"), map[resourceRef]resourceRef{
+		pod("a"): pod("b"),
+		pod("b"): pod("a"),
+	})
+	g.Expect(cycle).To(ConsistOf(pod("a"), pod("b")))
+}
+
+// newDeleteOrderReconciler는 Pod 삭제 순서를 기록하고 failing에 있는 Pod의 삭제는 실패시키는 reconciler를 만듭니다.
+func newDeleteOrderReconciler(t *testing.T, deletedOrder *[]string, failing map[string]bool, objs ...client.Object) *ResourceReconciler {
+	t.Helper()
+	scheme := newTestScheme(t)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&ttlv1alpha1.TTLResource{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				if _, ok := obj.(*corev1.Pod); ok {
+					if failing[obj.GetName()] {
+						return fmt.Errorf("delete of %s failed", obj.GetName())
+					}
+					*deletedOrder = append(*deletedOrder, obj.GetName())
+				}
+				return c.Delete(ctx, obj, opts...)
+			},
+		}).
+		Build()
+	return &ResourceReconciler{Client: c, Scheme: scheme}
+}
+
+func appPods() []client.Object {
+	return []client.Object{
+		labeledPodAfter("frontend", "Pod/api"),
+		labeledPodAfter("api", "Pod/db"),
+		labeledPodAfter("db", ""),
+	}
+}
+
+func labeledPodAfter(name, deleteAfter string) *corev1.Pod {
+	pod := labeledPod(name, map[string]string{"app": "shop"})
+	if deleteAfter != "" {
+		pod.Annotations = map[string]string{DeleteAfterAnnotationKey: deleteAfter}
+	}
+	return pod
+}
+
+func TestBatchDeletesInDeleteAfterOrder(t *testing.T) {
+	g := NewWithT(t)
+
+	ttlResource := expiredSelectorTTLResource(metav1.LabelSelector{MatchLabels: map[string]string{"app": "shop"}})
+	var deletedOrder []string
+	r := newDeleteOrderReconciler(t, &deletedOrder, nil, append(appPods(), ttlResource)...)
+
+	// 같은 배치의 의존 리소스는 기다리지 않고 한 번에 의존 순서대로 삭제
+	reconcileKey(t, r, "default", "preview-cleanup")
+	g.Expect(deletedOrder).To(Equal([]string{"db", "api", "frontend"}))
+	g.Expect(errors.IsNotFound(r.Get(context.Background(), client.ObjectKeyFromObject(ttlResource), &ttlv1alpha1.TTLResource{}))).To(BeTrue())
+}
+
+func TestBatchKeepsDependentsWhenDependencyDeletionFails(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	ttlResource := expiredSelectorTTLResource(metav1.LabelSelector{MatchLabels: map[string]string{"app": "shop"}})
+	var deletedOrder []string
+	r := newDeleteOrderReconciler(t, &deletedOrder, map[string]bool{"db": true}, append(appPods(), ttlResource)...)
+
+	reconcileKey(t, r, "default", "preview-cleanup")
+
+	// db 삭제가 실패하면 이를 기다리는 api와 frontend도 삭제하지 않음
+	g.Expect(deletedOrder).To(BeEmpty())
+	for _, name := range []string{"frontend", "api", "db"} {
+		g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: name}, &corev1.Pod{})).To(Succeed(), name)
+	}
+}
+
+func TestBatchDeleteAfterCycleFallsBackWithWarning(t *testing.T) {
+	g := NewWithT(t)
+
+	ttlResource := expiredSelectorTTLResource(metav1.LabelSelector{MatchLabels: map[string]string{"app": "shop"}})
+	var deletedOrder []string
+	r := newDeleteOrderReconciler(t, &deletedOrder, nil,
+		labeledPodAfter("a", "Pod/b"), labeledPodAfter("b", "Pod/a"), ttlResource)
+	recorder := record.NewFakeRecorder(10)
+	r.Recorder = recorder
+
+	reconcileKey(t, r, "default", "preview-cleanup")
+
+	g.Expect(deletedOrder).To(ConsistOf("a", "b"))
+	g.Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonDeleteAfterCycle)))
+}
+
+func TestDeleteAfterWaitsForDependencyInAnotherTTLResource(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	podA, ttlA := expiredOwner(t, labeledPodAfter("a", "Pod/b"), nil)
+	r := newTestReconciler(t, podA, labeledPodAfter("b", ""), ttlA)
+
+	// b가 남아 있으면 a를 삭제하지 않고 주기적으로 다시 확인
+	result := reconcileKey(t, r, "default", "ttl-a")
+	g.Expect(result.RequeueAfter).To(Equal(deleteAfterRequeueInterval))
+	g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "a"}, &corev1.Pod{})).To(Succeed())
+	var waiting ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "ttl-a"}, &waiting)).To(Succeed())
+	g.Expect(meta.IsStatusConditionTrue(waiting.Status.Conditions, ConditionWaitingForDependency)).To(BeTrue())
+
+	// b가 삭제되면 a도 삭제
+	g.Expect(r.Delete(ctx, labeledPodAfter("b", ""))).To(Succeed())
+	reconcileKey(t, r, "default", "ttl-a")
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "a"}, &corev1.Pod{}))).To(BeTrue())
+}
+
+func TestDeleteAfterCycleAcrossTTLResourcesDeletesBoth(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	// a와 b가 서로를 기다리면 교착을 피하기 위해 기다리지 않고 각각 삭제
+	podA, ttlA := expiredOwner(t, labeledPodAfter("a", "Pod/b"), nil)
+	podB, ttlB := expiredOwner(t, labeledPodAfter("b", "Pod/a"), nil)
+	r := newTestReconciler(t, podA, podB, ttlA, ttlB)
+
+	reconcileKey(t, r, "default", "ttl-a")
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "a"}, &corev1.Pod{}))).To(BeTrue())
+	reconcileKey(t, r, "default", "ttl-b")
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "b"}, &corev1.Pod{}))).To(BeTrue())
+}
+
+func TestDeleteAfterChainLongerThanMaxDepthIsTreatedAsCycle(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	// p0 → p1 → ... → p(maxDeleteAfterDepth+1): 순환은 없지만 따라갈 수 있는 길이를 넘음
+	p0, ttlP0 := expiredOwner(t, labeledPodAfter("p0", "Pod/p1"), nil)
+	objs := []client.Object{p0, ttlP0}
+	for i := 1; i <= maxDeleteAfterDepth+1; i++ {
+		deleteAfter := ""
+		if i <= maxDeleteAfterDepth {
+			deleteAfter = fmt.Sprintf("Pod/p%d", i+1)
+		}
+		objs = append(objs, labeledPodAfter(fmt.Sprintf("p%d", i), deleteAfter))
+	}
+	r := newTestReconciler(t, objs...)
+
+	result := reconcileKey(t, r, "default", "ttl-p0")
+	g.Expect(result.RequeueAfter).NotTo(Equal(deleteAfterRequeueInterval))
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "p0"}, &corev1.Pod{}))).To(BeTrue())
+	// 체인의 나머지 리소스는 건드리지 않음
+	g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "p1"}, &corev1.Pod{})).To(Succeed())
+}
+
+func TestDeleteAfterChainWithinMaxDepthWaits(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	// 길이 제한 안의 체인은 순환이 아니므로 기다림
+	p0, ttlP0 := expiredOwner(t, labeledPodAfter("p0", "Pod/p1"), nil)
+	r := newTestReconciler(t, p0, ttlP0, labeledPodAfter("p1", "Pod/p2"), labeledPodAfter("p2", ""))
+
+	result := reconcileKey(t, r, "default", "ttl-p0")
+	g.Expect(result.RequeueAfter).To(Equal(deleteAfterRequeueInterval))
+	g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "p0"}, &corev1.Pod{})).To(Succeed())
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// operator가 만료 시각보다 오래 내려가 있다가 다시 시작하면 한 번의 reconcile로 삭제되어야 함
+func TestOverdueTTLResourceDeletedInSingleReconcile(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	for _, status := range []ttlv1alpha1.TTLResourceStatus{
+		// TTLResource가 만들어진 직후 operator가 내려가 Status가 비어 있는 경우
+		{},
+		// ExpiredAt까지 기록한 뒤 operator가 내려간 경우
+		{
+			CreatedAt: metav1.NewTime(time.Now().Add(-3 * time.Hour)),
+			ExpiredAt: &metav1.Time{Time: time.Now().Add(-3*time.Hour + time.Minute)},
+		},
+	} {
+		pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+		ttlResource.CreationTimestamp = metav1.NewTime(time.Now().Add(-3 * time.Hour))
+		ttlResource.Status = status
+
+		statusWrites := 0
+		scheme := newTestScheme(t)
+		c := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(pod, ttlResource).
+			WithStatusSubresource(&ttlv1alpha1.TTLResource{}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+					if subResourceName == "status" {
+						statusWrites++
+					}
+					return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+				},
+			}).
+			Build()
+		r := &ResourceReconciler{Client: c, Scheme: scheme}
+
+		result := reconcileKey(t, r, "default", "ttl-web")
+		g.Expect(result.RequeueAfter).To(BeZero())
+		g.Expect(statusWrites).To(Equal(1), "overdue resources should need a single status write")
+
+		err := r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})
+		g.Expect(errors.IsNotFound(err)).To(BeTrue(), "overdue Pod should be deleted in the first reconcile")
+		err = r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &ttlv1alpha1.TTLResource{})
+		g.Expect(errors.IsNotFound(err)).To(BeTrue(), "TTLResource should be deleted in the first reconcile")
+	}
+}
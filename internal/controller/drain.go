@@ -0,0 +1,146 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+const (
+	// DrainSecondsAnnotationKey는 만료된 Service를 삭제하기 전에 endpoint를 비우고 기다릴 시간(초)을 지정하는 annotation 키입니다
+	DrainSecondsAnnotationKey = "ttl.example.com/drain-seconds"
+	// DrainStartedAtAnnotationKey는 Service의 endpoint를 비우기 시작한 시각(RFC 3339)을 기록하는 annotation 키입니다
+	DrainStartedAtAnnotationKey = "ttl.example.com/drain-started-at"
+	// DrainedSelectorAnnotationKey는 drain 전의 Service selector(JSON)를 기록하는 annotation 키입니다.
+	// TTL이 연장되어 더 이상 삭제하지 않게 되면 이 값으로 selector를 되돌립니다
+	DrainedSelectorAnnotationKey = "ttl.example.com/drained-selector"
+	// drainSelectorKey는 drain 중인 Service에 넣는, 어떤 Pod와도 일치하지 않는 selector의 키입니다
+	drainSelectorKey = "ttl.example.com/drained"
+)
+
+// ConditionDraining은 만료된 Service의 endpoint를 비우고 진행 중인 연결이 끝나기를 기다리고 있음을 나타냅니다
+const ConditionDraining = "Draining"
+
+// drainSeconds는 Service의 drain-seconds annotation 값을 반환합니다. 없거나 잘못된 값이면 drain하지 않습니다.
+func drainSeconds(svc *corev1.Service, logger logr.Logger) (time.Duration, bool) {
+	value, ok := svc.Annotations[DrainSecondsAnnotationKey]
+	if !ok {
+		return 0, false
+	}
+	seconds, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || seconds <= 0 {
+		logger.Info("Invalid drain-seconds annotation, deleting without drain", "service", svc.Name, "value", value)
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// drainServices는 drain-seconds annotation이 있는 Service owner의 selector를 어떤 Pod와도 일치하지 않는 값으로 바꿔
+// 새 연결이 들어오지 않게 하고, drain 시간이 끝나지 않은 Service가 있으면 남은 시간과 그 Service 이름을 반환합니다.
+// selector가 없어 endpoint를 직접 관리하는 Service와 ExternalName Service는 drain하지 않고 바로 삭제합니다.
+func (r *ResourceReconciler) drainServices(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource,
+	owners []metav1.OwnerReference, logger logr.Logger) (time.Duration, []string, error) {
+	now := r.now()
+	var wait time.Duration
+	var draining []string
+	for _, ownerRef := range owners {
+		if ownerRef.Kind != "Service" || ownerRef.APIVersion != "v1" {
+			continue
+		}
+		var svc corev1.Service
+		if err := r.Get(ctx, client.ObjectKey{Namespace: ttlResource.Namespace, Name: ownerRef.Name}, &svc); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return 0, nil, err
+		}
+		period, ok := drainSeconds(&svc, logger)
+		if !ok {
+			continue
+		}
+
+		startedAt, err := time.Parse(time.RFC3339, svc.Annotations[DrainStartedAtAnnotationKey])
+		if err != nil {
+			if len(svc.Spec.Selector) == 0 || svc.Spec.Type == corev1.ServiceTypeExternalName {
+				logger.Info("Service has no selector to drain, deleting without drain", "service", svc.Name)
+				continue
+			}
+			startedAt = now.UTC().Truncate(time.Second)
+			if err := r.startDrain(ctx, &svc, startedAt); err != nil {
+				return 0, nil, err
+			}
+			logger.Info("Draining Service before deletion", "service", svc.Name, "drainSeconds", period.Seconds())
+		}
+		if end := startedAt.Add(period); now.Before(end) {
+			wait = max(wait, end.Sub(now))
+			draining = append(draining, svc.Name)
+		}
+	}
+	return wait, draining, nil
+}
+
+// startDrain은 Service의 selector를 drain용 selector로 바꾸고 원래 selector와 시작 시각을 annotation에 기록합니다.
+func (r *ResourceReconciler) startDrain(ctx context.Context, svc *corev1.Service, startedAt time.Time) error {
+	selector, err := json.Marshal(svc.Spec.Selector)
+	if err != nil {
+		return err
+	}
+	patch := client.MergeFrom(svc.DeepCopy())
+	if svc.Annotations == nil {
+		svc.Annotations = map[string]string{}
+	}
+	svc.Annotations[DrainedSelectorAnnotationKey] = string(selector)
+	svc.Annotations[DrainStartedAtAnnotationKey] = startedAt.Format(time.RFC3339)
+	svc.Spec.Selector = map[string]string{drainSelectorKey: "true"}
+	if err := r.Patch(ctx, svc, patch); err != nil {
+		return client.IgnoreNotFound(fmt.Errorf("failed to drain Service %s: %w", svc.Name, err))
+	}
+	return nil
+}
+
+// undoDrain은 drain 중이던 Service의 selector를 원래 값으로 되돌리고 drain annotation을 제거합니다.
+// owner가 drain 중인 Service가 아니면 false를 반환합니다.
+func undoDrain(owner client.Object) bool {
+	svc, ok := owner.(*corev1.Service)
+	if !ok {
+		return false
+	}
+	encoded, ok := svc.Annotations[DrainedSelectorAnnotationKey]
+	if !ok {
+		return false
+	}
+	var selector map[string]string
+	if err := json.Unmarshal([]byte(encoded), &selector); err == nil {
+		svc.Spec.Selector = selector
+	}
+	delete(svc.Annotations, DrainedSelectorAnnotationKey)
+	delete(svc.Annotations, DrainStartedAtAnnotationKey)
+	return true
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestDrainServiceBeforeDeletion(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	svc, ttlResource := expiredOwner(t, &corev1.Service{Spec: corev1.ServiceSpec{Selector: map[string]string{"app": "web"}}},
+		map[string]string{DrainSecondsAnnotationKey: "30"})
+	r := newTestReconciler(t, svc, ttlResource)
+	fakeClock := clocktesting.NewFakeClock(time.Now().Truncate(time.Second))
+	r.Clock = fakeClock
+
+	// 처음에는 selector를 비워 endpoint를 없애고 drain 시간만큼 기다림
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+	var drained corev1.Service
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(svc), &drained)).To(Succeed())
+	g.Expect(drained.Spec.Selector).To(Equal(map[string]string{drainSelectorKey: "true"}))
+	g.Expect(drained.Annotations).To(HaveKeyWithValue(DrainedSelectorAnnotationKey, `{"app":"web"}`))
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(meta.IsStatusConditionTrue(latest.Status.Conditions, ConditionDraining)).To(BeTrue())
+
+	// drain 중에 다시 reconcile되면 남은 시간만 기다림
+	fakeClock.Step(10 * time.Second)
+	g.Expect(reconcileKey(t, r, "default", "ttl-web").RequeueAfter).To(Equal(20 * time.Second))
+
+	// drain 시간이 끝나면 삭제
+	fakeClock.Step(20 * time.Second)
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(svc), &corev1.Service{}))).To(BeTrue())
+}
+
+func TestDrainIsUndoneWhenDeletionIsCancelled(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	svc, _ := expiredOwner(t, &corev1.Service{Spec: corev1.ServiceSpec{Selector: map[string]string{"app": "web"}}},
+		map[string]string{DrainSecondsAnnotationKey: "30"})
+	svc.Annotations[DrainedSelectorAnnotationKey] = `{"app":"web"}`
+	svc.Annotations[DrainStartedAtAnnotationKey] = time.Now().UTC().Format(time.RFC3339)
+	svc.Spec.Selector = map[string]string{drainSelectorKey: "true"}
+	r := newTestReconciler(t, svc)
+
+	// TTL annotation이 없어져 더 이상 삭제하지 않으면 원래 selector로 되돌림
+	g.Expect(r.clearPendingDeletion(ctx, svc, logr.Discard())).To(Succeed())
+	var restored corev1.Service
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(svc), &restored)).To(Succeed())
+	g.Expect(restored.Spec.Selector).To(Equal(map[string]string{"app": "web"}))
+	g.Expect(restored.Annotations).NotTo(HaveKey(DrainedSelectorAnnotationKey))
+	g.Expect(restored.Annotations).NotTo(HaveKey(DrainStartedAtAnnotationKey))
+}
+
+func TestServiceWithoutSelectorIsDeletedWithoutDrain(t *testing.T) {
+	g := NewWithT(t)
+
+	svc, ttlResource := expiredOwner(t, &corev1.Service{Spec: corev1.ServiceSpec{Selector: map[string]string{"app": "web"}}},
+		map[string]string{DrainSecondsAnnotationKey: "30"})
+	svc.Spec.Selector = nil
+	r := newTestReconciler(t, svc, ttlResource)
+
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(errors.IsNotFound(r.Get(context.Background(), client.ObjectKeyFromObject(svc), &corev1.Service{}))).To(BeTrue())
+}
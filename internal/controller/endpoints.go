@@ -0,0 +1,50 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// +kubebuilder:rbac:groups="",resources=endpoints,verbs=get;list;watch;create;patch;delete
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list;watch;create;patch;delete
+
+// endpointKinds는 --enable-endpoint-kinds로 켰을 때만 TTL을 적용하는 Kind입니다.
+// 테스트 환경 등에서 직접 만든 Endpoints/EndpointSlice를 정리하기 위한 것으로, 기본 Kind보다 뒤에 조회합니다.
+var endpointKinds = []string{"Endpoints", "EndpointSlice"}
+
+// watchedKinds는 TTL annotation을 확인할 Kind를 같은 이름일 때의 선택 순서대로 반환합니다.
+func (r *ResourceReconciler) watchedKinds() []string {
+	if !r.EnableEndpointKinds {
+		return kindFallbackOrder
+	}
+	return append(append([]string{}, kindFallbackOrder...), endpointKinds...)
+}
+
+// endpointObjects는 --enable-endpoint-kinds일 때 watch할 빈 객체를 반환합니다.
+func endpointObjects() []client.Object {
+	//nolint:staticcheck // 직접 만든 Endpoints를 정리하기 위해 deprecated API도 지원
+	return []client.Object{&corev1.Endpoints{}, &discoveryv1.EndpointSlice{}}
+}
+
+// endpointLists는 --enable-endpoint-kinds일 때 네임스페이스 기본 TTL 변경 시 조회할 목록 객체를 반환합니다.
+func endpointLists() []client.ObjectList {
+	//nolint:staticcheck // 직접 만든 Endpoints를 정리하기 위해 deprecated API도 지원
+	return []client.ObjectList{&corev1.EndpointsList{}, &discoveryv1.EndpointSliceList{}}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func annotatedEndpointSlice() *discoveryv1.EndpointSlice {
+	return &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "stale-ep",
+			Namespace:   "default",
+			Annotations: map[string]string{TTLAnnotationKey: "60"},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+	}
+}
+
+func TestEndpointKindsAreIgnoredUnlessEnabled(t *testing.T) {
+	g := NewWithT(t)
+
+	r := newTestReconciler(t, annotatedEndpointSlice())
+	reconcileKey(t, r, "default", "stale-ep")
+
+	err := r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-stale-ep"}, &ttlv1alpha1.TTLResource{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestEndpointSliceGetsTTLResourceWhenEnabled(t *testing.T) {
+	g := NewWithT(t)
+
+	r := newTestReconciler(t, annotatedEndpointSlice())
+	r.EnableEndpointKinds = true
+	reconcileKey(t, r, "default", "stale-ep")
+
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-stale-ep"}, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.Spec.TTLSeconds).To(Equal(60))
+	g.Expect(ttlResource.OwnerReferences).To(ConsistOf(HaveField("Kind", "EndpointSlice")))
+	g.Expect(ttlResource.OwnerReferences[0].APIVersion).To(Equal("discovery.k8s.io/v1"))
+}
+
+func TestExpiredEndpointsAreDeleted(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	endpoints := &corev1.Endpoints{ObjectMeta: metav1.ObjectMeta{Name: "stale-ep", Namespace: "default"}} //nolint:staticcheck
+	ttlResource := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "ttl-stale-ep",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+			OwnerReferences:   []metav1.OwnerReference{{APIVersion: "v1", Kind: "Endpoints", Name: "stale-ep"}},
+		},
+		Spec: ttlv1alpha1.TTLResourceSpec{TTLSeconds: 60},
+	}
+	r := newTestReconciler(t, endpoints, ttlResource)
+	r.EnableEndpointKinds = true
+
+	reconcileKey(t, r, "default", "ttl-stale-ep")
+
+	err := r.Get(ctx, client.ObjectKeyFromObject(endpoints), &corev1.Endpoints{}) //nolint:staticcheck
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+	err = r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &ttlv1alpha1.TTLResource{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+}
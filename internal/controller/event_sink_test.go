@@ -0,0 +1,164 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestEventSinkDoesNotBlockReconcile(t *testing.T) {
+	g := NewWithT(t)
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		Namespace:   "default",
+		Annotations: map[string]string{TTLAnnotationKey: "60"},
+	}}
+	r := newTestReconciler(t, pod)
+	// Start를 호출하지 않으므로 버퍼가 비워지지 않음
+	r.Events = NewEventSink(nil, 1)
+
+	reconcileKey(t, r, "default", "web")
+	g.Expect(r.Events.events).To(HaveLen(1))
+	event := <-r.Events.events
+	g.Expect(event.Type).To(Equal(LifecycleEventCreated))
+	g.Expect(event.OwnerKind).To(Equal("Pod"))
+	g.Expect(event.OwnerName).To(Equal("web"))
+
+	// 버퍼가 가득 차면 버림
+	r.Events.Emit(LifecycleEvent{Type: LifecycleEventDeleted})
+	r.Events.Emit(LifecycleEvent{Type: LifecycleEventDeleted})
+	g.Expect(r.Events.events).To(HaveLen(1))
+
+	var nilSink *EventSink
+	nilSink.Emit(LifecycleEvent{Type: LifecycleEventCreated})
+}
+
+func TestLifecycleEventsUseInjectedClock(t *testing.T) {
+	g := NewWithT(t)
+
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		Namespace:   "default",
+		Annotations: map[string]string{TTLAnnotationKey: "60"},
+	}}
+	r := newTestReconciler(t, pod)
+	r.Clock = fakeClock
+	r.Events = NewEventSink(nil, 2)
+	r.Events.Clock = fakeClock
+
+	// reconciler가 만든 이벤트는 reconciler의 시계 시각을 가짐
+	reconcileKey(t, r, "default", "web")
+	event := <-r.Events.events
+	g.Expect(event.Time).To(Equal(fakeClock.Now()))
+
+	// 시각이 없는 이벤트는 sink의 시계 시각으로 채움
+	fakeClock.Step(time.Minute)
+	r.Events.Emit(LifecycleEvent{Type: LifecycleEventDeleted})
+	event = <-r.Events.events
+	g.Expect(event.Time).To(Equal(fakeClock.Now()))
+}
+
+// startFakeNATSServer는 nats.go 클라이언트와 INFO/CONNECT/PING 핸드셰이크를 하고,
+// 받은 PUB의 subject와 payload를 pubs로 보내는 최소한의 NATS 서버를 띄웁니다.
+// reject가 true이면 PUB마다 권한 위반(-ERR)으로 응답합니다.
+func startFakeNATSServer(t *testing.T, reject bool) (string, <-chan string) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	NewWithT(t).Expect(err).NotTo(HaveOccurred())
+	t.Cleanup(func() { _ = listener.Close() })
+
+	pubs := make(chan string, 10)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		_, _ = fmt.Fprint(conn, "INFO {\"server_id\":\"fake\",\"version\":\"2.10.0\",\"proto\":1,\"max_payload\":1048576}\r\n")
+		reader := bufio.NewReader(conn)
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			fields := strings.Fields(line)
+			if len(fields) == 0 {
+				continue
+			}
+			switch fields[0] {
+			case "PING":
+				_, _ = fmt.Fprint(conn, "PONG\r\n")
+			case "PUB":
+				payload, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if reject {
+					_, _ = fmt.Fprintf(conn, "-ERR 'Permissions Violation for Publish to \"%s\"'\r\n", fields[1])
+					continue
+				}
+				pubs <- fields[1] + " " + strings.TrimSpace(payload)
+			}
+		}
+	}()
+	return "nats://" + listener.Addr().String(), pubs
+}
+
+func TestNATSPublisherSendsPub(t *testing.T) {
+	g := NewWithT(t)
+
+	url, pubs := startFakeNATSServer(t, false)
+	publisher := &NATSPublisher{URL: url, Subject: "ttl.events"}
+	defer func() { _ = publisher.Close() }()
+
+	g.Expect(publisher.Publish(context.Background(), LifecycleEvent{
+		Type: LifecycleEventDeleted, Namespace: "default", Name: "ttl-web",
+	})).To(Succeed())
+
+	// Flush가 끝났으므로 서버는 이미 PUB를 받았음
+	g.Expect(pubs).To(HaveLen(1))
+	pub := <-pubs
+	g.Expect(pub).To(HavePrefix("ttl.events "))
+	g.Expect(pub).To(ContainSubstring(`"type":"deleted"`))
+}
+
+func TestNATSPublisherReportsRejectedPub(t *testing.T) {
+	g := NewWithT(t)
+
+	url, pubs := startFakeNATSServer(t, true)
+	publisher := &NATSPublisher{URL: url, Subject: "ttl.events"}
+	defer func() { _ = publisher.Close() }()
+
+	// 서버가 -ERR로 거부한 발행은 성공으로 세지 않음
+	err := publisher.Publish(context.Background(), LifecycleEvent{Type: LifecycleEventDeleted, Name: "ttl-web"})
+	g.Expect(err).To(MatchError(nats.ErrPermissionViolation))
+	g.Expect(pubs).To(BeEmpty())
+}
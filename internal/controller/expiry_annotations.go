@@ -0,0 +1,67 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+const (
+	// ExpireAtAnnotationKey는 TTLResource의 만료 시각(RFC3339)을 나타내는 annotation 키입니다. operator가 status에서 채우는 읽기 전용 값입니다
+	ExpireAtAnnotationKey = "ttl.example.com/expire-at"
+	// EffectiveTTLAnnotationKey는 TTLResource에 적용된 TTL(초)을 나타내는 annotation 키입니다. operator가 채우는 읽기 전용 값입니다
+	EffectiveTTLAnnotationKey = "ttl.example.com/effective-ttl-seconds"
+)
+
+// syncExpiryAnnotations는 TTLResource의 유효 TTL과 status.expiredAt을 annotation으로 옮깁니다.
+// status에서 파생된 값이므로 사용자가 바꾸어도 다음 reconcile에서 다시 덮어씁니다. 값이 같으면 patch하지 않습니다.
+func (r *ResourceReconciler) syncExpiryAnnotations(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource, logger logr.Logger) error {
+	if ttlResource.Status.ExpiredAt == nil {
+		return nil
+	}
+	expireAt := ttlResource.Status.ExpiredAt.UTC().Format(time.RFC3339)
+	ttlSeconds := strconv.Itoa(ttlResource.Spec.TTLSeconds + ttlResource.Status.JitterSeconds)
+
+	annotations := ttlResource.GetAnnotations()
+	if annotations[ExpireAtAnnotationKey] == expireAt && annotations[EffectiveTTLAnnotationKey] == ttlSeconds {
+		return nil
+	}
+
+	patch := client.MergeFrom(ttlResource.DeepCopy())
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[ExpireAtAnnotationKey] = expireAt
+	annotations[EffectiveTTLAnnotationKey] = ttlSeconds
+	ttlResource.SetAnnotations(annotations)
+	if err := r.Patch(ctx, ttlResource, patch); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	logger.V(1).Info("Synced expiry annotations", "name", ttlResource.Name, "expireAt", expireAt)
+	return nil
+}
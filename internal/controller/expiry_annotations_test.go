@@ -0,0 +1,73 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestExpiryAnnotationsFollowStatus(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	ttlResource := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "ttl-web",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Now()),
+			OwnerReferences:   []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: "web"}},
+		},
+		Spec: ttlv1alpha1.TTLResourceSpec{TTLSeconds: 3600},
+	}
+	r := newTestReconciler(t, pod, ttlResource)
+
+	reconcileKey(t, r, "default", "ttl-web")
+
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(latest.Status.ExpiredAt).NotTo(BeNil())
+	g.Expect(latest.Annotations).To(HaveKeyWithValue(EffectiveTTLAnnotationKey, "3600"))
+	g.Expect(latest.Annotations).To(HaveKeyWithValue(ExpireAtAnnotationKey, latest.Status.ExpiredAt.UTC().Format(time.RFC3339)))
+
+	// 사용자가 값을 바꾸어도 status 기준으로 다시 덮어씀
+	latest.Annotations[ExpireAtAnnotationKey] = "2000-01-01T00:00:00Z"
+	g.Expect(r.Update(ctx, &latest)).To(Succeed())
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(latest.Annotations).To(HaveKeyWithValue(ExpireAtAnnotationKey, latest.Status.ExpiredAt.UTC().Format(time.RFC3339)))
+
+	// TTL이 바뀌어 status가 다시 계산되면 annotation도 갱신
+	latest.Spec.TTLSeconds = 7200
+	g.Expect(r.Update(ctx, &latest)).To(Succeed())
+	latest.Status = ttlv1alpha1.TTLResourceStatus{}
+	g.Expect(r.Status().Update(ctx, &latest)).To(Succeed())
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(latest.Annotations).To(HaveKeyWithValue(EffectiveTTLAnnotationKey, "7200"))
+	g.Expect(latest.Status.ExpiredAt.Sub(latest.Status.CreatedAt.Time)).To(Equal(2 * time.Hour))
+	g.Expect(latest.Annotations).To(HaveKeyWithValue(ExpireAtAnnotationKey, latest.Status.ExpiredAt.UTC().Format(time.RFC3339)))
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"github.com/go-logr/logr"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// TTLSourceAnnotationKey는 컨트롤러가 생성한 TTLResource에 TTL을 어디에서 가져왔는지 기록하는 annotation 키입니다
+const TTLSourceAnnotationKey = "ttl.example.com/ttl-source"
+
+// TTL 출처 (TTLSourceAnnotationKey 값)
+const (
+	// TTLSourceAnnotation은 리소스의 ttl-seconds annotation입니다
+	TTLSourceAnnotation = "annotation"
+	// TTLSourceNamespaceDefault는 네임스페이스의 default-ttl-seconds annotation입니다
+	TTLSourceNamespaceDefault = "namespace-default"
+	// TTLSourceOwner는 상위 리소스(Deployment 등)에서 상속한 TTL입니다
+	TTLSourceOwner = "owner"
+	// TTLSourceConflictPolicy는 상위 Deployment의 TTL과 충돌하여 정책에 따라 다시 계산한 TTL입니다
+	TTLSourceConflictPolicy = "conflict-policy"
+	// TTLSourceJob은 Job의 ttlSecondsAfterFinished에서 가져온 TTL입니다
+	TTLSourceJob = "job-ttl"
+	// TTLSourceEphemeralDebug는 ephemeral debug container용 TTL입니다
+	TTLSourceEphemeralDebug = "ephemeral-debug"
+	// TTLSourceManual은 annotation이 없는 TTLResource(직접 작성한 리소스)입니다
+	TTLSourceManual = "manual"
+)
+
+// ttlSourceOf는 TTLResource의 TTL 출처를 반환합니다.
+func ttlSourceOf(ttlResource *ttlv1alpha1.TTLResource) string {
+	if source, ok := ttlResource.Annotations[TTLSourceAnnotationKey]; ok && source != "" {
+		return source
+	}
+	return TTLSourceManual
+}
+
+// logExpiryAudit는 만료로 owner를 처리했을 때 TTL 출처, 계산한 만료 시각, 실제 처리 시각, 수행한 동작을
+// 한 줄의 구조화된 로그로 남깁니다. [StepN] 로그와 달리 만료 결정의 근거를 사후에 확인하기 위한 것입니다.
+func (r *ResourceReconciler) logExpiryAudit(ttlResource *ttlv1alpha1.TTLResource, actions []string, now time.Time, logger logr.Logger) {
+	if !r.ExpiryAuditLog || len(actions) == 0 {
+		return
+	}
+	keysAndValues := []any{
+		"ttlResource", ttlResource.Namespace + "/" + ttlResource.Name,
+		"ttlSource", ttlSourceOf(ttlResource),
+		"ttlSeconds", ttlResource.Spec.TTLSeconds,
+		"jitterSeconds", ttlResource.Status.JitterSeconds,
+		"actualTime", now.UTC().Format(time.RFC3339),
+		"actions", actions,
+	}
+	if expiredAt := ttlResource.Status.ExpiredAt; expiredAt != nil {
+		keysAndValues = append(keysAndValues,
+			"expiredAt", expiredAt.UTC().Format(time.RFC3339),
+			"lateBy", now.Sub(expiredAt.Time).Round(time.Second).String())
+	}
+	logger.Info("Expiry audit", keysAndValues...)
+}
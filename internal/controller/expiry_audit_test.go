@@ -0,0 +1,110 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestTTLSourceAnnotationRecordsWhereTheTTLCameFrom(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:        "team-a",
+		Annotations: map[string]string{NamespaceDefaultTTLAnnotationKey: "300"},
+	}}
+	inherited := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "inherited", Namespace: "team-a"}}
+	explicit := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "explicit",
+		Namespace:   "team-a",
+		Annotations: map[string]string{TTLAnnotationKey: "60"},
+	}}
+	r := newTestReconciler(t, ns, inherited, explicit)
+
+	reconcileKey(t, r, "team-a", "inherited")
+	reconcileKey(t, r, "team-a", "explicit")
+
+	sourceOf := func(name string) string {
+		var ttlResource ttlv1alpha1.TTLResource
+		g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "team-a", Name: "ttl-" + name}, &ttlResource)).To(Succeed())
+		return ttlSourceOf(&ttlResource)
+	}
+	g.Expect(sourceOf("inherited")).To(Equal(TTLSourceNamespaceDefault))
+	g.Expect(sourceOf("explicit")).To(Equal(TTLSourceAnnotation))
+
+	// 같은 TTL이라도 출처가 바뀌면 annotation을 갱신
+	inherited.Annotations = map[string]string{TTLAnnotationKey: "300"}
+	g.Expect(r.Update(ctx, inherited)).To(Succeed())
+	reconcileKey(t, r, "team-a", "inherited")
+	g.Expect(sourceOf("inherited")).To(Equal(TTLSourceAnnotation))
+}
+
+func TestExpiryAuditLogRecordsDecision(t *testing.T) {
+	g := NewWithT(t)
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	ttlResource.Annotations = map[string]string{TTLSourceAnnotationKey: TTLSourceNamespaceDefault}
+	r := newTestReconciler(t, pod, ttlResource)
+	r.ExpiryAuditLog = true
+
+	var lines []string
+	logger := funcr.New(func(_, args string) { lines = append(lines, args) }, funcr.Options{})
+	_, err := r.Reconcile(logr.NewContext(context.Background(), logger), ctrl.Request{
+		NamespacedName: client.ObjectKey{Namespace: "default", Name: "ttl-web"},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	var audit []string
+	for _, line := range lines {
+		if strings.Contains(line, `"msg"="Expiry audit"`) {
+			audit = append(audit, line)
+		}
+	}
+	g.Expect(audit).To(HaveLen(1))
+	g.Expect(audit[0]).To(ContainSubstring(`"ttlSource"="namespace-default"`))
+	g.Expect(audit[0]).To(ContainSubstring(`"ttlSeconds"=60`))
+	g.Expect(audit[0]).To(ContainSubstring(`"expiredAt"=`))
+	g.Expect(audit[0]).To(ContainSubstring(`"Pod/web=delete"`))
+}
+
+func TestExpiryAuditLogCanBeDisabled(t *testing.T) {
+	g := NewWithT(t)
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	r := newTestReconciler(t, pod, ttlResource)
+
+	var lines []string
+	logger := funcr.New(func(_, args string) { lines = append(lines, args) }, funcr.Options{})
+	_, err := r.Reconcile(logr.NewContext(context.Background(), logger), ctrl.Request{
+		NamespacedName: client.ObjectKey{Namespace: "default", Name: "ttl-web"},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(strings.Join(lines, "\n")).NotTo(ContainSubstring("Expiry audit"))
+}
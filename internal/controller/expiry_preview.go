@@ -0,0 +1,64 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// ExpiryPreview는 리소스를 만들기 전에 annotation만으로 계산한 만료 시각입니다.
+type ExpiryPreview struct {
+	// ExpiredAt은 jitter를 더하기 전의 만료 시각입니다 (TTLResource의 status.expiredAt과 같은 규칙으로 계산)
+	ExpiredAt time.Time
+	// MaxJitter는 ttl-jitter-seconds annotation으로 ExpiredAt 뒤에 더해질 수 있는 최대 지연입니다
+	MaxJitter time.Duration
+}
+
+// PreviewExpiry는 리소스의 annotation과 생성 시각으로 TTLResource가 기록할 만료 시각을 미리 계산합니다.
+// reconcile과 같은 파싱 규칙(parseTTL)과 만료 계산(setExpiredAt)을 사용하므로 admission 경고에 보여준 시각과
+// 실제 만료 시각이 어긋나지 않습니다. TTL annotation이 없거나 disabled이면 ok가 false이고, 값이 잘못되었으면 오류를 반환합니다.
+// 네임스페이스 기본 TTL, ttl-from-secret처럼 다른 객체를 읽어야 하는 TTL은 계산하지 않습니다.
+func PreviewExpiry(annotations map[string]string, createdAt time.Time) (ExpiryPreview, bool, error) {
+	value, ok := annotations[TTLAnnotationKey]
+	if !ok || value == TTLDisabledValue {
+		return ExpiryPreview{}, false, nil
+	}
+	ttl, err := parseTTL(value)
+	if err != nil {
+		return ExpiryPreview{}, false, fmt.Errorf("invalid %s annotation %q: %w", TTLAnnotationKey, value, err)
+	}
+
+	ttlResource := &ttlv1alpha1.TTLResource{Spec: ttlv1alpha1.TTLResourceSpec{
+		TTLSeconds: ttlSecondsCeil(ttl),
+		TTL:        preciseTTL(ttl),
+	}}
+	ttlResource.Status.CreatedAt = metav1.NewTime(createdAt)
+	setExpiredAt(ttlResource)
+
+	preview := ExpiryPreview{ExpiredAt: expiryTime(ttlResource)}
+	if jitter, ok := annotations[TTLJitterAnnotationKey]; ok {
+		if seconds, err := parseTTLSeconds(jitter); err == nil {
+			preview.MaxJitter = time.Duration(seconds) * time.Second
+		}
+	}
+	return preview, true, nil
+}
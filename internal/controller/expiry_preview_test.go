@@ -0,0 +1,49 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestPreviewExpiryMatchesReconcile(t *testing.T) {
+	g := NewWithT(t)
+	created := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	preview, ok, err := PreviewExpiry(map[string]string{TTLAnnotationKey: "60"}, created)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+	g.Expect(preview.ExpiredAt).To(Equal(created.Add(time.Minute)))
+	g.Expect(preview.MaxJitter).To(BeZero())
+
+	// 소수 초는 ms 단위까지 반영
+	preview, _, _ = PreviewExpiry(map[string]string{TTLAnnotationKey: "1.25", TTLJitterAnnotationKey: "10"}, created)
+	g.Expect(preview.ExpiredAt).To(Equal(created.Add(1250 * time.Millisecond)))
+	g.Expect(preview.MaxJitter).To(Equal(10 * time.Second))
+
+	_, ok, err = PreviewExpiry(map[string]string{TTLAnnotationKey: TTLDisabledValue}, created)
+	g.Expect(ok).To(BeFalse())
+	g.Expect(err).NotTo(HaveOccurred())
+	_, ok, err = PreviewExpiry(nil, created)
+	g.Expect(ok).To(BeFalse())
+	g.Expect(err).NotTo(HaveOccurred())
+	_, _, err = PreviewExpiry(map[string]string{TTLAnnotationKey: "-5"}, created)
+	g.Expect(err).To(MatchError(ContainSubstring(TTLAnnotationKey)))
+}
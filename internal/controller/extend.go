@@ -0,0 +1,124 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+const (
+	// ExtendAnnotationKey는 만료 시각을 주어진 기간(예: "2h")만큼 한 번 연장하는 일회성 annotation 키입니다
+	ExtendAnnotationKey = "ttl.example.com/extend"
+	// ExtendAppliedAnnotationKey는 마지막으로 반영한 extend 값을 기록하는 annotation 키입니다.
+	// 같은 manifest를 다시 적용해도 중복 연장되지 않도록 사용합니다
+	ExtendAppliedAnnotationKey = "ttl.example.com/extend-applied"
+)
+
+// parseExtendDuration은 extend annotation 값을 파싱합니다. 0 이하의 기간은 허용하지 않습니다.
+func parseExtendDuration(value string) (time.Duration, error) {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("extend duration must be positive, got %q", value)
+	}
+	return d, nil
+}
+
+// consumeExtendAnnotation은 리소스의 extend annotation을 TTL annotation에 반영하고 extend annotation을 제거합니다.
+// 마지막으로 반영한 값과 같은 값이면 연장하지 않고 annotation만 제거합니다.
+// 리소스를 patch했으면 true를 반환하며, 이어지는 reconcile에서 늘어난 TTL이 TTLResource에 반영됩니다.
+func (r *ResourceReconciler) consumeExtendAnnotation(ctx context.Context, obj client.Object, kind string, ttlSeconds int, logger logr.Logger) (bool, error) {
+	annotations := obj.GetAnnotations()
+	value, ok := annotations[ExtendAnnotationKey]
+	if !ok {
+		return false, nil
+	}
+
+	original := obj.DeepCopyObject().(client.Object)
+	updated := make(map[string]string, len(annotations))
+	for k, v := range annotations {
+		updated[k] = v
+	}
+	delete(updated, ExtendAnnotationKey)
+
+	if value == annotations[ExtendAppliedAnnotationKey] {
+		logger.Info("Extend annotation already applied, removing it", "resource", obj.GetName(), "extend", value)
+	} else {
+		d, err := parseExtendDuration(value)
+		if err != nil {
+			logger.Info("Invalid extend annotation value, ignoring", "resource", obj.GetName(), "value", value, "error", err.Error())
+			return false, nil
+		}
+		extended, err := r.guaranteeMinRemaining(ctx, obj, kind, ttlSeconds+int(math.Ceil(d.Seconds())), logger)
+		if err != nil {
+			return false, err
+		}
+		updated[TTLAnnotationKey] = strconv.Itoa(extended)
+		updated[ExtendAppliedAnnotationKey] = value
+		logger.Info("Extending TTL", "resource", obj.GetName(), "extend", value, "ttlSeconds", extended)
+	}
+
+	obj.SetAnnotations(updated)
+	if err := r.Patch(ctx, obj, client.MergeFrom(original)); err != nil {
+		return false, fmt.Errorf("failed to apply extend annotation to %s: %w", obj.GetName(), err)
+	}
+	return true, nil
+}
+
+// guaranteeMinRemaining은 연장한 TTL로 다시 계산한 만료 시각이 지금부터 ExtendMinRemaining보다 가까우면
+// 그만큼 남도록 늘린 TTL을 반환합니다. 오래전에 시작한 TTL을 짧게 연장하면 연장 직후 바로 만료되는 것을 막습니다.
+// 만료 시각은 기존 TTLResource의 status.createdAt과 jitter를 기준으로 하며, TTLResource가 아직 없으면 지금부터 셉니다.
+func (r *ResourceReconciler) guaranteeMinRemaining(ctx context.Context, obj client.Object, kind string, ttlSeconds int, logger logr.Logger) (int, error) {
+	if r.ExtendMinRemaining <= 0 {
+		return ttlSeconds, nil
+	}
+	now := r.now()
+	start, jitter := now, 0
+	var ttlResource ttlv1alpha1.TTLResource
+	ttlResourceName, err := r.TTLResourceNamer.Name(kind, obj)
+	if err != nil {
+		return 0, err
+	}
+	err = r.Get(ctx, client.ObjectKey{Namespace: obj.GetNamespace(), Name: ttlResourceName}, &ttlResource)
+	if err == nil && !ttlResource.Status.CreatedAt.IsZero() {
+		start, jitter = ttlResource.Status.CreatedAt.Time, ttlResource.Status.JitterSeconds
+	} else if err != nil && !errors.IsNotFound(err) {
+		return 0, fmt.Errorf("failed to get TTLResource for %s: %w", obj.GetName(), err)
+	}
+
+	expireTime := start.Add(time.Duration(ttlSeconds+jitter) * time.Second)
+	minExpireTime := now.Add(r.ExtendMinRemaining)
+	if !expireTime.Before(minExpireTime) {
+		return ttlSeconds, nil
+	}
+	raised := int(math.Ceil(minExpireTime.Sub(start).Seconds())) - jitter
+	logger.Info("Extended TTL would expire too soon, raising it to the minimum remaining lifetime",
+		"resource", obj.GetName(), "ttlSeconds", ttlSeconds, "raisedTTLSeconds", raised, "minRemaining", r.ExtendMinRemaining)
+	return raised, nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestExtendAnnotationIsConsumedOnce(t *testing.T) {
+	g := NewWithT(t)
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		Namespace:   "default",
+		Annotations: map[string]string{TTLAnnotationKey: "60", ExtendAnnotationKey: "2h"},
+	}}
+	r := newTestReconciler(t, pod)
+
+	reconcileKey(t, r, "default", "web")
+	reconcileKey(t, r, "default", "web")
+
+	var latest corev1.Pod
+	g.Expect(r.Get(context.Background(), client.ObjectKeyFromObject(pod), &latest)).To(Succeed())
+	g.Expect(latest.Annotations).NotTo(HaveKey(ExtendAnnotationKey))
+	g.Expect(latest.Annotations).To(HaveKeyWithValue(TTLAnnotationKey, "7260"))
+	g.Expect(latest.Annotations).To(HaveKeyWithValue(ExtendAppliedAnnotationKey, "2h"))
+
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.Spec.TTLSeconds).To(Equal(7260))
+
+	// 같은 manifest를 다시 적용하면 extend annotation은 제거되지만 TTL은 그대로
+	latest.Annotations[ExtendAnnotationKey] = "2h"
+	g.Expect(r.Update(context.Background(), &latest)).To(Succeed())
+	reconcileKey(t, r, "default", "web")
+
+	g.Expect(r.Get(context.Background(), client.ObjectKeyFromObject(pod), &latest)).To(Succeed())
+	g.Expect(latest.Annotations).NotTo(HaveKey(ExtendAnnotationKey))
+	g.Expect(latest.Annotations).To(HaveKeyWithValue(TTLAnnotationKey, "7260"))
+}
+
+func TestInvalidExtendAnnotationIsIgnored(t *testing.T) {
+	g := NewWithT(t)
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		Namespace:   "default",
+		Annotations: map[string]string{TTLAnnotationKey: "60", ExtendAnnotationKey: "soon"},
+	}}
+	r := newTestReconciler(t, pod)
+
+	reconcileKey(t, r, "default", "web")
+
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.Spec.TTLSeconds).To(Equal(60))
+}
+
+func TestExtendGuaranteesMinRemainingLifetime(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	extendedTTL := func(minRemaining time.Duration, jitterSeconds int) string {
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:        "web",
+			Namespace:   "default",
+			Annotations: map[string]string{TTLAnnotationKey: "3600", ExtendAnnotationKey: "5m"},
+		}}
+		// 2시간 전에 시작하여 이미 만료 시각이 지난 TTL
+		ttlResource := &ttlv1alpha1.TTLResource{
+			ObjectMeta: metav1.ObjectMeta{Name: "ttl-web", Namespace: "default"},
+			Spec:       ttlv1alpha1.TTLResourceSpec{TTLSeconds: 3600},
+			Status: ttlv1alpha1.TTLResourceStatus{
+				CreatedAt:     metav1.NewTime(fakeClock.Now().Add(-2 * time.Hour)),
+				JitterSeconds: jitterSeconds,
+			},
+		}
+		r := newClockedTestReconciler(t, fakeClock, pod, ttlResource)
+		r.ExtendMinRemaining = minRemaining
+		reconcileKey(t, r, "default", "web")
+
+		var latest corev1.Pod
+		g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &latest)).To(Succeed())
+		g.Expect(latest.Annotations).NotTo(HaveKey(ExtendAnnotationKey))
+		return latest.Annotations[TTLAnnotationKey]
+	}
+
+	// 보장하지 않으면 연장한 만큼만 늘어나 여전히 만료된 상태
+	g.Expect(extendedTTL(0, 0)).To(Equal("3900"))
+	// 연장 후 만료 시각이 10분 뒤가 되도록 TTL을 더 늘림 (2시간 + 10분, jitter만큼 뺌)
+	g.Expect(extendedTTL(10*time.Minute, 0)).To(Equal("7800"))
+	g.Expect(extendedTTL(10*time.Minute, 30)).To(Equal("7770"))
+	g.Expect(extendedTTL(time.Minute, 0)).To(Equal("7260"))
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TerminationGraceAnnotationKey는 TTL 만료로 Pod를 삭제할 때 사용할 종료 유예 시간(초)을 지정하는 annotation 키입니다
+const TerminationGraceAnnotationKey = "ttl.example.com/termination-grace-seconds"
+
+// terminationGracePeriod는 Pod owner의 termination-grace-seconds annotation 값을 반환합니다.
+// Pod가 아니거나 annotation이 없거나 잘못되었으면 nil을 반환하여 리소스에 설정된 유예 시간을 그대로 사용합니다.
+func terminationGracePeriod(owner client.Object, logger logr.Logger) *int64 {
+	if _, ok := owner.(*corev1.Pod); !ok {
+		return nil
+	}
+	value, ok := owner.GetAnnotations()[TerminationGraceAnnotationKey]
+	if !ok {
+		return nil
+	}
+	seconds, err := parseTerminationGrace(value)
+	if err != nil {
+		logger.Info("Invalid termination-grace-seconds annotation, using the Pod's grace period",
+			"owner", owner.GetName(), "value", value)
+		return nil
+	}
+	return &seconds
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// PendingDeletionAtAnnotationKey는 유예 기간 동안 owner에 붙여 삭제 예정 시각(RFC 3339)을 알리는 annotation 키입니다
+const PendingDeletionAtAnnotationKey = "ttl.example.com/pending-deletion-at"
+
+// ConditionInGracePeriod는 TTL이 만료되었지만 --deletion-grace-period가 끝날 때까지 삭제를 미루고 있음을 나타냅니다
+const ConditionInGracePeriod = "InGracePeriod"
+
+// gracePeriodEnd는 만료된 TTLResource의 owner를 실제로 삭제할 시각입니다.
+func (r *ResourceReconciler) gracePeriodEnd(ttlResource *ttlv1alpha1.TTLResource) time.Time {
+	return expiryTime(ttlResource).Add(r.DeletionGracePeriod)
+}
+
+// deferForGracePeriod는 owners에 삭제 예정 시각 annotation을 붙이고 유예 기간이 끝날 때까지 삭제를 미룹니다.
+// 처음 유예 기간에 들어갈 때만 Event를 기록합니다.
+func (r *ResourceReconciler) deferForGracePeriod(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource,
+	owners []metav1.OwnerReference, logger logr.Logger) (ctrl.Result, error) {
+	deleteAt := r.gracePeriodEnd(ttlResource).UTC().Format(time.RFC3339)
+	for _, ownerRef := range owners {
+		owner, err := r.getOwnerObject(ctx, ownerRef, ttlResource.Namespace)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return ctrl.Result{}, err
+		}
+		if err := r.setPendingDeletion(ctx, owner, deleteAt); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	message := "TTL expired; owner will be deleted at " + deleteAt
+	if !meta.IsStatusConditionTrue(ttlResource.Status.Conditions, ConditionInGracePeriod) {
+		logger.Info("TTL expired, waiting for the deletion grace period", "name", ttlResource.Name, "deleteAt", deleteAt)
+		r.recordEvent(ttlResource, corev1.EventTypeNormal, ConditionInGracePeriod, message)
+	}
+	return r.deferDeletion(ctx, ttlResource, metav1.Condition{
+		Type:    ConditionInGracePeriod,
+		Status:  metav1.ConditionTrue,
+		Reason:  "GracePeriod",
+		Message: message,
+	}, r.gracePeriodEnd(ttlResource).Sub(r.now()), logger)
+}
+
+// setPendingDeletion은 owner의 pending-deletion-at annotation을 deleteAt으로 맞춥니다. 이미 같으면 patch하지 않습니다.
+func (r *ResourceReconciler) setPendingDeletion(ctx context.Context, owner client.Object, deleteAt string) error {
+	if owner.GetAnnotations()[PendingDeletionAtAnnotationKey] == deleteAt {
+		return nil
+	}
+	patch := client.MergeFrom(owner.DeepCopyObject().(client.Object))
+	annotations := owner.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[PendingDeletionAtAnnotationKey] = deleteAt
+	owner.SetAnnotations(annotations)
+	return client.IgnoreNotFound(r.Patch(ctx, owner, patch))
+}
+
+// pendingDeletionMarked는 owner에 삭제 예정 표시(pending-deletion-at 또는 drain한 Service selector)가 있는지 확인합니다.
+func pendingDeletionMarked(owner client.Object) bool {
+	_, pending := owner.GetAnnotations()[PendingDeletionAtAnnotationKey]
+	_, drained := owner.GetAnnotations()[DrainedSelectorAnnotationKey]
+	return pending || drained
+}
+
+// clearPendingDeletion은 TTL이 바뀌었거나 reconcile이 다시 활성화되어 더 이상 삭제 예정이 아닌 owner에서
+// pending-deletion-at annotation을 제거하고, drain 중인 Service면 selector를 되돌립니다.
+func (r *ResourceReconciler) clearPendingDeletion(ctx context.Context, owner client.Object, logger logr.Logger) error {
+	if !pendingDeletionMarked(owner) {
+		return nil
+	}
+	_, drained := owner.GetAnnotations()[DrainedSelectorAnnotationKey]
+	patch := client.MergeFrom(owner.DeepCopyObject().(client.Object))
+	undoDrain(owner)
+	annotations := owner.GetAnnotations()
+	delete(annotations, PendingDeletionAtAnnotationKey)
+	owner.SetAnnotations(annotations)
+	if err := r.Patch(ctx, owner, patch); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	logger.Info("Removed pending deletion annotation", "resource", owner.GetName(), "undrained", drained)
+	return nil
+}
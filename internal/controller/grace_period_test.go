@@ -0,0 +1,104 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestGracePeriodMarksOwnerBeforeDeletion(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	pod := annotatedPod("uid-1")
+	r := newClockedTestReconciler(t, fakeClock, pod)
+	r.DeletionGracePeriod = time.Minute
+	reconcileKey(t, r, "default", "web")
+	reconcileKey(t, r, "default", "ttl-web")
+
+	// 만료되면 owner에 삭제 예정 시각을 붙이고 유예 기간이 끝날 때 다시 확인
+	fakeClock.Step(time.Minute)
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(Equal(time.Minute))
+	var current corev1.Pod
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &current)).To(Succeed())
+	g.Expect(current.Annotations).To(HaveKeyWithValue(PendingDeletionAtAnnotationKey, "2025-06-01T10:02:00Z"))
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &ttlResource)).To(Succeed())
+	g.Expect(meta.IsStatusConditionTrue(ttlResource.Status.Conditions, ConditionInGracePeriod)).To(BeTrue())
+
+	// 유예 기간이 끝나면 삭제
+	fakeClock.Step(time.Minute)
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{}))).To(BeTrue())
+}
+
+func TestPendingDeletionIsClearedWhenTTLChangesOrReconcileDisabled(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	pod := annotatedPod("uid-1")
+	r := newClockedTestReconciler(t, fakeClock, pod)
+	r.DeletionGracePeriod = time.Minute
+	reconcileKey(t, r, "default", "web")
+	reconcileKey(t, r, "default", "ttl-web")
+	fakeClock.Step(time.Minute)
+	reconcileKey(t, r, "default", "ttl-web")
+
+	latestPod := func() *corev1.Pod {
+		var current corev1.Pod
+		g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &current)).To(Succeed())
+		return &current
+	}
+	g.Expect(latestPod().Annotations).To(HaveKey(PendingDeletionAtAnnotationKey))
+
+	// TTL을 연장하면 삭제 예정 표시를 제거
+	extended := latestPod()
+	extended.Annotations[TTLAnnotationKey] = "600"
+	g.Expect(r.Update(ctx, extended)).To(Succeed())
+	reconcileKey(t, r, "default", "web")
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(latestPod().Annotations).NotTo(HaveKey(PendingDeletionAtAnnotationKey))
+
+	// reconcile이 비활성화된 동안에는 리소스를 건드리지 않으므로 그대로 둠
+	marked := latestPod()
+	marked.Annotations[PendingDeletionAtAnnotationKey] = "2025-06-01T10:02:00Z"
+	marked.Annotations[ReconcileAnnotationKey] = ReconcileDisabledValue
+	g.Expect(r.Update(ctx, marked)).To(Succeed())
+	reconcileKey(t, r, "default", "web")
+	g.Expect(latestPod().Annotations).To(HaveKeyWithValue(PendingDeletionAtAnnotationKey, "2025-06-01T10:02:00Z"))
+
+	// 다시 활성화하면 만료 전인 TTLResource의 남은 삭제 예정 표시를 제거
+	enabled := latestPod()
+	delete(enabled.Annotations, ReconcileAnnotationKey)
+	g.Expect(r.Update(ctx, enabled)).To(Succeed())
+	reconcileKey(t, r, "default", "web")
+	g.Expect(latestPod().Annotations).NotTo(HaveKey(PendingDeletionAtAnnotationKey))
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// reconcileExpiredPodCapturingGrace는 만료된 Pod를 삭제하고 Pod 삭제 요청의 GracePeriodSeconds를 반환합니다.
+func reconcileExpiredPodCapturingGrace(t *testing.T, annotations map[string]string) *int64 {
+	t.Helper()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	pod.Annotations = annotations
+
+	var gracePeriod *int64
+	scheme := newTestScheme(t)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(pod, ttlResource).
+		WithStatusSubresource(&ttlv1alpha1.TTLResource{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				if _, ok := obj.(*corev1.Pod); ok {
+					gracePeriod = (&client.DeleteOptions{}).ApplyOptions(opts).GracePeriodSeconds
+				}
+				return c.Delete(ctx, obj, opts...)
+			},
+		}).
+		Build()
+	r := &ResourceReconciler{Client: c, Scheme: scheme}
+
+	reconcileKey(t, r, "default", "ttl-web")
+	return gracePeriod
+}
+
+func TestTerminationGraceAnnotation(t *testing.T) {
+	g := NewWithT(t)
+
+	gracePeriod := reconcileExpiredPodCapturingGrace(t, map[string]string{TerminationGraceAnnotationKey: "120"})
+	g.Expect(gracePeriod).NotTo(BeNil())
+	g.Expect(*gracePeriod).To(Equal(int64(120)))
+
+	// annotation이 없거나 잘못되면 Pod의 기본 유예 시간 사용
+	g.Expect(reconcileExpiredPodCapturingGrace(t, nil)).To(BeNil())
+	g.Expect(reconcileExpiredPodCapturingGrace(t, map[string]string{TerminationGraceAnnotationKey: "-1"})).To(BeNil())
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"maps"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// expiredOwner는 owner에 annotations를 더하고, owner를 가리키는 이미 만료된 TTLResource "ttl-<owner 이름>"과 함께 반환합니다.
+// TTLResource는 2분 전에 생성되었고 TTL은 60초입니다. owner의 이름과 네임스페이스가 비어 있으면 web, default를 사용합니다.
+func expiredOwner[T client.Object](t *testing.T, owner T, annotations map[string]string) (T, *ttlv1alpha1.TTLResource) {
+	t.Helper()
+	if owner.GetName() == "" {
+		owner.SetName("web")
+	}
+	if owner.GetNamespace() == "" {
+		owner.SetNamespace("default")
+	}
+	if len(annotations) > 0 {
+		merged := maps.Clone(owner.GetAnnotations())
+		if merged == nil {
+			merged = map[string]string{}
+		}
+		maps.Copy(merged, annotations)
+		owner.SetAnnotations(merged)
+	}
+
+	gvk, err := apiutil.GVKForObject(owner, clientgoscheme.Scheme)
+	NewWithT(t).Expect(err).NotTo(HaveOccurred())
+	apiVersion, kind := gvk.ToAPIVersionAndKind()
+	ttlResource := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "ttl-" + owner.GetName(),
+			Namespace:         owner.GetNamespace(),
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+			OwnerReferences:   []metav1.OwnerReference{{APIVersion: apiVersion, Kind: kind, Name: owner.GetName()}},
+		},
+		Spec: ttlv1alpha1.TTLResourceSpec{TTLSeconds: 60},
+	}
+	return owner, ttlResource
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ErrDeletionBlocked를 감싼 오류를 BeforeDelete가 반환하면 owner 삭제를 미룹니다.
+// 그 밖의 오류는 로그만 남기고 삭제를 계속합니다.
+var ErrDeletionBlocked = stderrors.New("deletion blocked by hook")
+
+// ConditionBlockedByHook은 DeletionHook의 BeforeDelete가 owner 삭제를 막고 있음을 나타냅니다
+const ConditionBlockedByHook = "BlockedByHook"
+
+// hookRequeueInterval은 hook에 막힌 삭제를 다시 시도하는 간격입니다
+const hookRequeueInterval = 30 * time.Second
+
+// DeletionHook은 만료된 owner를 삭제하기 전후에 실행되는 확장 지점입니다.
+// DNS 레코드 정리처럼 operator에 함께 컴파일되는 통합 기능을 fork 없이 추가할 때 사용합니다.
+type DeletionHook interface {
+	// BeforeDelete는 owner를 삭제하기 직전에 호출됩니다. ErrDeletionBlocked를 감싼 오류를 반환하면 삭제를 미룹니다
+	BeforeDelete(ctx context.Context, owner client.Object) error
+	// AfterDelete는 owner 삭제 요청이 성공한 뒤 호출됩니다. 오류는 로그로만 남깁니다
+	AfterDelete(ctx context.Context, owner client.Object) error
+}
+
+// runBeforeDeleteHooks는 등록된 hook의 BeforeDelete를 순서대로 실행하고, 삭제를 막은 hook이 있으면 그 오류를 반환합니다.
+func (r *ResourceReconciler) runBeforeDeleteHooks(ctx context.Context, owner client.Object, logger logr.Logger) error {
+	for _, hook := range r.DeletionHooks {
+		if err := hook.BeforeDelete(ctx, owner); err != nil {
+			if stderrors.Is(err, ErrDeletionBlocked) {
+				return err
+			}
+			logger.Error(err, "BeforeDelete hook failed, continuing deletion", "owner", owner.GetName())
+		}
+	}
+	return nil
+}
+
+// runAfterDeleteHooks는 등록된 hook의 AfterDelete를 순서대로 실행합니다.
+func (r *ResourceReconciler) runAfterDeleteHooks(ctx context.Context, owner client.Object, logger logr.Logger) {
+	for _, hook := range r.DeletionHooks {
+		if err := hook.AfterDelete(ctx, owner); err != nil {
+			logger.Error(err, "AfterDelete hook failed", "owner", owner.GetName())
+		}
+	}
+}
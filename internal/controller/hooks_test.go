@@ -0,0 +1,88 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// recordingHook은 호출된 순서를 기록하고 BeforeDelete에서 beforeErr를 반환합니다.
+type recordingHook struct {
+	calls     []string
+	beforeErr error
+}
+
+func (h *recordingHook) BeforeDelete(_ context.Context, owner client.Object) error {
+	h.calls = append(h.calls, "before:"+owner.GetName())
+	return h.beforeErr
+}
+
+func (h *recordingHook) AfterDelete(_ context.Context, owner client.Object) error {
+	h.calls = append(h.calls, "after:"+owner.GetName())
+	return nil
+}
+
+func TestDeletionHooksRunAroundOwnerDeletion(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	for _, beforeErr := range []error{nil, stderrors.New("dns api unavailable")} {
+		pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+		hook := &recordingHook{beforeErr: beforeErr}
+		r := newTestReconciler(t, pod, ttlResource)
+		r.DeletionHooks = []DeletionHook{hook}
+
+		reconcileKey(t, r, "default", "ttl-web")
+
+		// ErrDeletionBlocked가 아닌 오류는 삭제를 막지 않음
+		g.Expect(hook.calls).To(Equal([]string{"before:web", "after:web"}))
+		err := r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})
+		g.Expect(errors.IsNotFound(err)).To(BeTrue())
+	}
+}
+
+func TestDeletionHookCanBlockDeletion(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	hook := &recordingHook{beforeErr: fmt.Errorf("record still in use: %w", ErrDeletionBlocked)}
+	r := newTestReconciler(t, pod, ttlResource)
+	r.DeletionHooks = []DeletionHook{hook}
+
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(Equal(hookRequeueInterval))
+	g.Expect(hook.calls).To(Equal([]string{"before:web"}))
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	condition := meta.FindStatusCondition(latest.Status.Conditions, ConditionBlockedByHook)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Message).To(ContainSubstring("record still in use"))
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DeleteHPAAnnotationKey는 Deployment를 TTL로 삭제할 때 그 Deployment를 대상으로 하는 HorizontalPodAutoscaler도 삭제할지 지정하는 annotation 키입니다
+const DeleteHPAAnnotationKey = "ttl.example.com/delete-hpa"
+
+// deleteAssociatedHPAs는 delete-hpa: "true" annotation이 있는 Deployment를 scaleTargetRef로 가리키는
+// HorizontalPodAutoscaler를 삭제합니다. 대상이 사라진 HPA가 남아 이벤트를 계속 만드는 것을 막기 위해 사용합니다.
+func (r *ResourceReconciler) deleteAssociatedHPAs(ctx context.Context, owner client.Object, logger logr.Logger) error {
+	deploy, ok := owner.(*appsv1.Deployment)
+	if !ok || deploy.Annotations[DeleteHPAAnnotationKey] != "true" {
+		return nil
+	}
+
+	var hpas autoscalingv2.HorizontalPodAutoscalerList
+	if err := r.List(ctx, &hpas, client.InNamespace(deploy.Namespace)); err != nil {
+		return fmt.Errorf("failed to list HorizontalPodAutoscalers: %w", err)
+	}
+	for i := range hpas.Items {
+		hpa := &hpas.Items[i]
+		target := hpa.Spec.ScaleTargetRef
+		if target.Kind != "Deployment" || target.Name != deploy.Name {
+			continue
+		}
+		if err := r.DeletionLimiter.Delete(ctx, r.Client, hpa); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete HorizontalPodAutoscaler %s/%s: %w", hpa.Namespace, hpa.Name, err)
+		}
+		logger.Info("Deleted HorizontalPodAutoscaler of expired Deployment", "hpa", hpa.Name, "deployment", deploy.Name)
+	}
+	return nil
+}
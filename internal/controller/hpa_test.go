@@ -0,0 +1,78 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func hpaFor(name, deployment string) *autoscalingv2.HorizontalPodAutoscaler {
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: deployment},
+			MaxReplicas:    3,
+		},
+	}
+}
+
+func TestDeleteHPAAnnotationRemovesTargetingHPAs(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	for _, optIn := range []bool{true, false} {
+		deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"}}
+		if optIn {
+			deploy.Annotations = map[string]string{DeleteHPAAnnotationKey: "true"}
+		}
+		ttlResource := &ttlv1alpha1.TTLResource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "ttl-api",
+				Namespace:         "default",
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+				OwnerReferences:   []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "api"}},
+			},
+			Spec: ttlv1alpha1.TTLResourceSpec{TTLSeconds: 60},
+		}
+		target := hpaFor("api", "api")
+		other := hpaFor("worker", "worker")
+		r := newTestReconciler(t, deploy, ttlResource, target, other)
+
+		reconcileKey(t, r, "default", "ttl-api")
+
+		err := r.Get(ctx, client.ObjectKeyFromObject(deploy), &appsv1.Deployment{})
+		g.Expect(errors.IsNotFound(err)).To(BeTrue(), "expired Deployment should be deleted")
+		err = r.Get(ctx, client.ObjectKeyFromObject(target), &autoscalingv2.HorizontalPodAutoscaler{})
+		if optIn {
+			g.Expect(errors.IsNotFound(err)).To(BeTrue(), "HPA targeting the Deployment should be deleted")
+		} else {
+			g.Expect(err).NotTo(HaveOccurred(), "HPA should be kept without the annotation")
+		}
+		g.Expect(r.Get(ctx, client.ObjectKeyFromObject(other), &autoscalingv2.HorizontalPodAutoscaler{})).To(Succeed())
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+const (
+	// TTLPolicyPodWins는 Pod 자신의 TTL을 사용합니다 (기본값)
+	TTLPolicyPodWins = "pod-wins"
+	// TTLPolicyOwnerWins는 상위 Deployment의 만료 시각을 사용합니다
+	TTLPolicyOwnerWins = "owner-wins"
+	// TTLPolicyMin은 두 만료 시각 중 빠른 쪽을 사용합니다
+	TTLPolicyMin = "min"
+	// TTLPolicyMax는 두 만료 시각 중 늦은 쪽을 사용합니다
+	TTLPolicyMax = "max"
+)
+
+// ValidateTTLConflictPolicy는 TTL 충돌 정책 값이 올바른지 확인합니다.
+func ValidateTTLConflictPolicy(policy string) error {
+	switch policy {
+	case "", TTLPolicyPodWins, TTLPolicyOwnerWins, TTLPolicyMin, TTLPolicyMax:
+		return nil
+	default:
+		return fmt.Errorf("unknown TTL conflict policy %q (expected %s, %s, %s or %s)",
+			policy, TTLPolicyPodWins, TTLPolicyOwnerWins, TTLPolicyMin, TTLPolicyMax)
+	}
+}
+
+// resolveConflictingTTL은 Pod와 그 Pod를 관리하는 Deployment에 모두 TTL annotation이 있을 때
+// TTLConflictPolicy에 따라 유효 만료 시각을 계산하고, Pod 생성 시각 기준의 spec으로 변환합니다.
+// 정책이 pod-wins이거나 상위 Deployment에 TTL이 없으면 Pod 자신의 TTL을 그대로 사용합니다.
+func (r *ResourceReconciler) resolveConflictingTTL(ctx context.Context, pod *corev1.Pod, podTTL int, logger logr.Logger) (ttlv1alpha1.TTLResourceSpec, error) {
+	spec := ttlv1alpha1.TTLResourceSpec{TTLSeconds: podTTL}
+	if r.TTLConflictPolicy == "" || r.TTLConflictPolicy == TTLPolicyPodWins {
+		return spec, nil
+	}
+
+	deploy, err := r.controllingDeployment(ctx, pod)
+	if err != nil || deploy == nil {
+		return spec, err
+	}
+	ownerTTL, err := parseTTLAnnotationSeconds(deploy.GetAnnotations()[TTLAnnotationKey])
+	if err != nil {
+		return spec, nil
+	}
+
+	podStart := pod.CreationTimestamp.Time
+	podExpire := podStart.Add(time.Duration(podTTL) * time.Second)
+	ownerExpire := deploy.CreationTimestamp.Add(time.Duration(ownerTTL) * time.Second)
+
+	expire := podExpire
+	switch r.TTLConflictPolicy {
+	case TTLPolicyOwnerWins:
+		expire = ownerExpire
+	case TTLPolicyMin:
+		if ownerExpire.Before(podExpire) {
+			expire = ownerExpire
+		}
+	case TTLPolicyMax:
+		if ownerExpire.After(podExpire) {
+			expire = ownerExpire
+		}
+	}
+
+	// 만료 시각을 Pod 생성 시각 기준의 TTL로 변환 (이미 지났으면 즉시 만료되도록 최소 1초)
+	ttlSeconds := int(math.Ceil(expire.Sub(podStart).Seconds()))
+	if ttlSeconds < 1 {
+		ttlSeconds = 1
+	}
+	startTime := metav1.NewTime(podStart)
+	logger.V(1).Info("Resolved conflicting TTLs",
+		"pod", pod.Name, "deployment", deploy.Name, "policy", r.TTLConflictPolicy,
+		"podTTL", podTTL, "ownerTTL", ownerTTL, "effectiveTTL", ttlSeconds)
+	return ttlv1alpha1.TTLResourceSpec{TTLSeconds: ttlSeconds, StartTime: &startTime}, nil
+}
+
+// controllingDeployment는 Pod → ReplicaSet → Deployment 순으로 controller를 따라가 Deployment를 찾습니다.
+// Deployment가 관리하지 않는 Pod이면 nil을 반환합니다.
+func (r *ResourceReconciler) controllingDeployment(ctx context.Context, pod *corev1.Pod) (*appsv1.Deployment, error) {
+	rsRef := metav1.GetControllerOf(pod)
+	if rsRef == nil || rsRef.Kind != "ReplicaSet" {
+		return nil, nil
+	}
+	rs := &appsv1.ReplicaSet{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: pod.Namespace, Name: rsRef.Name}, rs); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	deployRef := metav1.GetControllerOf(rs)
+	if deployRef == nil || deployRef.Kind != "Deployment" {
+		return nil, nil
+	}
+	deploy := &appsv1.Deployment{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: pod.Namespace, Name: deployRef.Name}, deploy); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return deploy, nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// newDeploymentPodTree는 TTL annotation이 있는 Deployment → ReplicaSet → Pod 트리를 만듭니다.
+func newDeploymentPodTree(deployAge time.Duration, deployTTL, podTTL string) []client.Object {
+	now := time.Now()
+	deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+		Name:              "web",
+		Namespace:         "default",
+		UID:               "deploy-uid",
+		CreationTimestamp: metav1.NewTime(now.Add(-deployAge)),
+		Annotations:       map[string]string{TTLAnnotationKey: deployTTL},
+	}}
+	rs := &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+		Name:      "web-abc",
+		Namespace: "default",
+		UID:       "rs-uid",
+		OwnerReferences: []metav1.OwnerReference{{
+			APIVersion: "apps/v1", Kind: "Deployment", Name: "web", UID: "deploy-uid", Controller: ptr.To(true),
+		}},
+	}}
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:              "web-abc-xyz",
+		Namespace:         "default",
+		CreationTimestamp: metav1.NewTime(now),
+		Annotations:       map[string]string{TTLAnnotationKey: podTTL},
+		OwnerReferences: []metav1.OwnerReference{{
+			APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "web-abc", UID: "rs-uid", Controller: ptr.To(true),
+		}},
+	}}
+	return []client.Object{deploy, rs, pod}
+}
+
+func TestConflictingTTLPolicies(t *testing.T) {
+	// Deployment는 5분 뒤 만료, Pod는 1시간 뒤 만료
+	cases := map[string]struct {
+		policy      string
+		expectedTTL int
+		startTime   bool
+	}{
+		"pod-wins":   {policy: TTLPolicyPodWins, expectedTTL: 3600},
+		"owner-wins": {policy: TTLPolicyOwnerWins, expectedTTL: 300, startTime: true},
+		"min":        {policy: TTLPolicyMin, expectedTTL: 300, startTime: true},
+		"max":        {policy: TTLPolicyMax, expectedTTL: 3600, startTime: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			r := newTestReconciler(t, newDeploymentPodTree(time.Hour, "3900", "3600")...)
+			r.TTLConflictPolicy = tc.policy
+
+			reconcileKey(t, r, "default", "web-abc-xyz")
+
+			var ttlResource ttlv1alpha1.TTLResource
+			g.Expect(r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-web-abc-xyz"}, &ttlResource)).To(Succeed())
+			g.Expect(ttlResource.Spec.TTLSeconds).To(BeNumerically("~", tc.expectedTTL, 1))
+			g.Expect(ttlResource.Spec.StartTime != nil).To(Equal(tc.startTime))
+		})
+	}
+}
+
+func TestValidateTTLConflictPolicy(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(ValidateTTLConflictPolicy("")).To(Succeed())
+	g.Expect(ValidateTTLConflictPolicy(TTLPolicyMin)).To(Succeed())
+	g.Expect(ValidateTTLConflictPolicy("newest")).NotTo(Succeed())
+}
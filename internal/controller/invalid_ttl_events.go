@@ -0,0 +1,46 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EventReasonInvalidTTLAnnotation은 TTL annotation 값이 잘못되어 TTL을 적용하지 않았음을 owner에 알리는 Event reason입니다
+const EventReasonInvalidTTLAnnotation = "InvalidTTLAnnotation"
+
+// reportInvalidTTL은 잘못된 TTL annotation 때문에 TTL을 적용하지 않았음을 owner의 Warning Event로 알립니다.
+// 같은 리소스의 같은 값은 한 번만 알리며, 값이 바뀌면 다시 알립니다.
+func (r *ResourceReconciler) reportInvalidTTL(obj client.Object, annotation, value string, err error) {
+	if r.Recorder == nil {
+		return
+	}
+	reported := annotation + "=" + value
+	if previous, ok := r.invalidTTLReported.Swap(client.ObjectKeyFromObject(obj).String(), reported); ok && previous == reported {
+		return
+	}
+	r.Recorder.Event(obj, corev1.EventTypeWarning, EventReasonInvalidTTLAnnotation,
+		fmt.Sprintf("TTL not applied: %s %q is invalid: %v", annotation, value, err))
+}
+
+// forgetInvalidTTL은 TTL이 올바르게 바뀌었거나 리소스가 사라졌을 때 알린 기록을 지워, 이후 다시 잘못되면 새로 알리도록 합니다.
+func (r *ResourceReconciler) forgetInvalidTTL(key client.ObjectKey) {
+	r.invalidTTLReported.Delete(key.String())
+}
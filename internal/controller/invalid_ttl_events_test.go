@@ -0,0 +1,82 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestInvalidTTLAnnotationEmitsDeduplicatedWarningEvent(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		Namespace:   "default",
+		Annotations: map[string]string{TTLAnnotationKey: "1x"},
+	}}
+	r := newTestReconciler(t, pod)
+	recorder := record.NewFakeRecorder(10)
+	r.Recorder = recorder
+
+	reconcileKey(t, r, "default", "web")
+	g.Expect(recorder.Events).To(Receive(And(
+		ContainSubstring("Warning "+EventReasonInvalidTTLAnnotation),
+		ContainSubstring(`ttl.example.com/ttl-seconds "1x" is invalid`),
+	)))
+
+	// 같은 값으로 다시 reconcile되어도 Event를 반복하지 않음
+	reconcileKey(t, r, "default", "web")
+	g.Expect(recorder.Events).NotTo(Receive())
+
+	// 값이 바뀌면 다시 알림
+	pod.Annotations[TTLAnnotationKey] = "-5"
+	g.Expect(r.Update(ctx, pod)).To(Succeed())
+	reconcileKey(t, r, "default", "web")
+	g.Expect(recorder.Events).To(Receive(ContainSubstring(`"-5"`)))
+
+	// 올바르게 고친 뒤 다시 같은 잘못된 값이 되면 새로 알림
+	pod.Annotations[TTLAnnotationKey] = "60"
+	g.Expect(r.Update(ctx, pod)).To(Succeed())
+	reconcileKey(t, r, "default", "web")
+	pod.Annotations[TTLAnnotationKey] = "-5"
+	g.Expect(r.Update(ctx, pod)).To(Succeed())
+	reconcileKey(t, r, "default", "web")
+	g.Expect(recorder.Events).To(Receive(ContainSubstring(`"-5"`)))
+}
+
+func TestInvalidNamespaceDefaultTTLIsReportedOnTheResource(t *testing.T) {
+	g := NewWithT(t)
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:        "team-a",
+		Annotations: map[string]string{NamespaceDefaultTTLAnnotationKey: "0"},
+	}}
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "team-a"}}
+	r := newTestReconciler(t, ns, pod)
+	recorder := record.NewFakeRecorder(10)
+	r.Recorder = recorder
+
+	reconcileKey(t, r, "team-a", "web")
+	g.Expect(recorder.Events).To(Receive(ContainSubstring("namespace " + NamespaceDefaultTTLAnnotationKey)))
+}
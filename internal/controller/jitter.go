@@ -0,0 +1,66 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"hash/fnv"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// TTLJitterAnnotationKey는 만료 시각에 리소스마다 다른 무작위 지연(0 ~ 지정한 초)을 더하는 annotation 키입니다.
+// 한꺼번에 만든 리소스가 같은 시각에 삭제되지 않도록 분산할 때 사용합니다
+const TTLJitterAnnotationKey = "ttl.example.com/ttl-jitter-seconds"
+
+// ttlJitterSeconds는 리소스의 ttl-jitter-seconds annotation 값을 반환합니다. 없거나 잘못되었으면 0입니다.
+func ttlJitterSeconds(obj client.Object, logger logr.Logger) int {
+	value, ok := obj.GetAnnotations()[TTLJitterAnnotationKey]
+	if !ok {
+		return 0
+	}
+	seconds, err := parseTTLSeconds(value)
+	if err != nil {
+		logger.Info("Invalid ttl-jitter-seconds annotation value, ignoring", "value", value, "resource", obj.GetName(), "error", err.Error())
+		return 0
+	}
+	return seconds
+}
+
+// jitterOffset은 TTLResource의 만료 시각에 더할 지연(0 ~ Spec.JitterSeconds초)을 계산합니다.
+// owner의 UID(없으면 TTLResource의 UID)로 정해지므로 reconcile을 반복하거나 status가 초기화되어도 같은 값이 나옵니다.
+func jitterOffset(ttlResource *ttlv1alpha1.TTLResource) int {
+	if ttlResource.Spec.JitterSeconds <= 0 {
+		return 0
+	}
+	seed := string(ttlResource.UID)
+	if owners := ownersOf(ttlResource); len(owners) > 0 && owners[0].UID != "" {
+		seed = string(owners[0].UID)
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(seed))
+	return int(h.Sum64() % uint64(ttlResource.Spec.JitterSeconds+1))
+}
+
+// setExpiredAt은 status.createdAt에 TTL과 jitter를 더한 만료 시각과, 더한 jitter를 status에 기록합니다.
+func setExpiredAt(ttlResource *ttlv1alpha1.TTLResource) {
+	ttlResource.Status.JitterSeconds = jitterOffset(ttlResource)
+	ttlResource.Status.ExpiredAt = &metav1.Time{Time: expiryTime(ttlResource)}
+}
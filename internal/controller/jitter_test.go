@@ -0,0 +1,90 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestJitterOffsetIsStablePerUID(t *testing.T) {
+	g := NewWithT(t)
+
+	offsets := map[int]bool{}
+	for i := 0; i < 20; i++ {
+		ttlResource := &ttlv1alpha1.TTLResource{
+			ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "v1", Kind: "Pod", Name: "web", UID: types.UID(fmt.Sprintf("uid-%d", i))},
+			}},
+			Spec: ttlv1alpha1.TTLResourceSpec{TTLSeconds: 60, JitterSeconds: 600},
+		}
+		offset := jitterOffset(ttlResource)
+		g.Expect(offset).To(BeNumerically(">=", 0))
+		g.Expect(offset).To(BeNumerically("<=", 600))
+		g.Expect(jitterOffset(ttlResource)).To(Equal(offset), "offset should be stable across calls")
+		offsets[offset] = true
+	}
+	g.Expect(len(offsets)).To(BeNumerically(">", 1), "different UIDs should spread out")
+}
+
+func TestTTLJitterAnnotationDelaysExpiry(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name: "web", Namespace: "default", UID: "pod-uid",
+		Annotations: map[string]string{TTLAnnotationKey: "3600", TTLJitterAnnotationKey: "300"},
+	}}
+	r := newTestReconciler(t, pod)
+
+	reconcileKey(t, r, "default", "web")
+	var created ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &created)).To(Succeed())
+	g.Expect(created.Spec.TTLSeconds).To(Equal(3600))
+	g.Expect(created.Spec.JitterSeconds).To(Equal(300))
+
+	// fake client는 creationTimestamp를 채우지 않으므로 생성 시각을 지정한 TTLResource로 만료 시각을 확인
+	ttlResource := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "ttl-web",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Now()),
+			OwnerReferences:   created.OwnerReferences,
+		},
+		Spec: created.Spec,
+	}
+	r = newTestReconciler(t, pod, ttlResource)
+	reconcileKey(t, r, "default", "ttl-web")
+
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(latest.Status.JitterSeconds).To(Equal(jitterOffset(&latest)))
+	g.Expect(latest.Status.ExpiredAt).NotTo(BeNil())
+	expected := latest.Status.CreatedAt.Add(time.Duration(3600+latest.Status.JitterSeconds) * time.Second)
+	g.Expect(latest.Status.ExpiredAt.Time).To(BeTemporally("~", expected, time.Second))
+	g.Expect(latest.Annotations).To(HaveKeyWithValue(EffectiveTTLAnnotationKey, fmt.Sprint(3600+latest.Status.JitterSeconds)))
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// mirroredJobTTL은 Job의 spec.ttlSecondsAfterFinished를 완료 시각부터 세는 TTLResource spec으로 바꿉니다.
+// 내장 TTL-after-finished 컨트롤러와 같은 시각에 만료되므로 두 컨트롤러가 모두 동작해도 삭제 시점이 어긋나지 않습니다.
+// Job이 아직 끝나지 않았으면 false를 반환합니다.
+func mirroredJobTTL(job *batchv1.Job) (ttlv1alpha1.TTLResourceSpec, bool) {
+	finishedAt, ok := jobFinishedAt(job)
+	if !ok {
+		return ttlv1alpha1.TTLResourceSpec{}, false
+	}
+	// TTLSeconds 0은 삭제하지 않음을 뜻하므로, 완료 즉시 삭제(0)는 1초로 바꿈
+	ttlSeconds := max(int(*job.Spec.TTLSecondsAfterFinished), 1)
+	return ttlv1alpha1.TTLResourceSpec{TTLSeconds: ttlSeconds, StartTime: &finishedAt}, true
+}
+
+// jobFinishedAt은 Job이 Complete 또는 Failed 상태가 된 시각을 반환합니다.
+func jobFinishedAt(job *batchv1.Job) (metav1.Time, bool) {
+	for _, c := range job.Status.Conditions {
+		if (c.Type == batchv1.JobComplete || c.Type == batchv1.JobFailed) && c.Status == corev1.ConditionTrue {
+			return c.LastTransitionTime, true
+		}
+	}
+	return metav1.Time{}, false
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func newJob(ttlAfterFinished int32, finishedAgo time.Duration) *batchv1.Job {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "report", Namespace: "default"},
+		Spec:       batchv1.JobSpec{TTLSecondsAfterFinished: ptr.To(ttlAfterFinished)},
+	}
+	if finishedAgo > 0 {
+		job.Status.Conditions = []batchv1.JobCondition{{
+			Type:               batchv1.JobComplete,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: metav1.NewTime(time.Now().Add(-finishedAgo)),
+		}}
+	}
+	return job
+}
+
+func TestImportJobTTLMirrorsTTLSecondsAfterFinished(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	job := newJob(300, 10*time.Minute)
+	r := newTestReconciler(t, job)
+	r.ImportJobTTL = true
+
+	reconcileKey(t, r, "default", "report")
+
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "ttl-report"}, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.Spec.TTLSeconds).To(Equal(300))
+	// 내장 컨트롤러와 같은 시각에 만료되도록 완료 시각을 기준으로 함
+	g.Expect(ttlResource.Spec.StartTime).NotTo(BeNil())
+	g.Expect(ttlResource.Spec.StartTime.Unix()).To(Equal(job.Status.Conditions[0].LastTransitionTime.Unix()))
+	g.Expect(ttlResource.OwnerReferences[0].Kind).To(Equal("Job"))
+
+	// 완료 후 5분이 지났으므로 Job 삭제
+	reconcileKey(t, r, "default", "ttl-report")
+	err := r.Get(ctx, client.ObjectKeyFromObject(job), &batchv1.Job{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue(), "Job should be deleted after ttlSecondsAfterFinished")
+}
+
+func TestImportJobTTLWaitsForCompletion(t *testing.T) {
+	g := NewWithT(t)
+
+	for _, tc := range []struct {
+		name   string
+		job    *batchv1.Job
+		enable bool
+	}{
+		{name: "running", job: newJob(300, 0), enable: true},
+		{name: "disabled", job: newJob(300, 10*time.Minute), enable: false},
+	} {
+		r := newTestReconciler(t, tc.job)
+		r.ImportJobTTL = tc.enable
+
+		reconcileKey(t, r, "default", "report")
+
+		err := r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-report"}, &ttlv1alpha1.TTLResource{})
+		g.Expect(errors.IsNotFound(err)).To(BeTrue(), "%s Job should not get a TTLResource", tc.name)
+	}
+}
+
+func TestMirroredJobTTLImmediateDeletion(t *testing.T) {
+	g := NewWithT(t)
+
+	// ttlSecondsAfterFinished: 0은 TTLSeconds 0(삭제하지 않음)이 아니라 바로 만료되어야 함
+	spec, ok := mirroredJobTTL(newJob(0, time.Minute))
+	g.Expect(ok).To(BeTrue())
+	g.Expect(spec.TTLSeconds).To(Equal(1))
+}
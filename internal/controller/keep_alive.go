@@ -0,0 +1,70 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+const (
+	// KeepAliveValueAnnotationKey는 --keep-alive-label로 지정한 owner label의 마지막으로 본 값을 TTLResource에 기록하는 annotation 키입니다
+	KeepAliveValueAnnotationKey = "ttl.example.com/keep-alive-value"
+	// KeptAliveAtAnnotationKey는 keep-alive label이 마지막으로 바뀌어 TTL 카운트다운을 다시 시작한 시각(RFC 3339)을 기록하는 annotation 키입니다
+	KeptAliveAtAnnotationKey = "ttl.example.com/kept-alive-at"
+)
+
+// applyKeepAlive는 owner의 keep-alive label 값이 TTLResource에 기록된 값과 다르면 지금을 TTL 카운트다운의 새 기준 시각으로 기록하고,
+// 기록된 기준 시각을 desiredSpec.StartTime에 반영합니다. "아직 필요함"을 label을 바꿔 알리는 워크플로를 위한 것입니다.
+// 처음 보는 값(TTLResource 생성 직후나 플래그를 켠 직후)은 기록만 하고 카운트다운을 다시 시작하지 않습니다.
+// label이 없어지면 마지막 값을 그대로 둡니다. TTLResource의 annotation을 바꿨으면 true를 반환합니다.
+func (r *ResourceReconciler) applyKeepAlive(ttlResource *ttlv1alpha1.TTLResource, obj client.Object,
+	desiredSpec *ttlv1alpha1.TTLResourceSpec, logger logr.Logger) bool {
+	if r.KeepAliveLabel == "" {
+		return false
+	}
+
+	changed := false
+	value, hasLabel := obj.GetLabels()[r.KeepAliveLabel]
+	recorded, hasRecorded := ttlResource.Annotations[KeepAliveValueAnnotationKey]
+	if hasLabel && (!hasRecorded || value != recorded) {
+		if ttlResource.Annotations == nil {
+			ttlResource.Annotations = map[string]string{}
+		}
+		ttlResource.Annotations[KeepAliveValueAnnotationKey] = value
+		if hasRecorded {
+			keptAliveAt := r.now().UTC().Truncate(time.Second)
+			ttlResource.Annotations[KeptAliveAtAnnotationKey] = keptAliveAt.Format(time.RFC3339)
+			logger.Info("Keep-alive label changed, restarting TTL countdown", "name", ttlResource.Name,
+				"label", r.KeepAliveLabel, "value", value, "startTime", keptAliveAt)
+		}
+		changed = true
+	}
+
+	// 다른 방식으로 정한 기준 시각(Job 완료 시각 등)이 더 늦으면 그쪽을 따름
+	if keptAliveAt, err := time.Parse(time.RFC3339, ttlResource.Annotations[KeptAliveAtAnnotationKey]); err == nil {
+		if desiredSpec.StartTime == nil || desiredSpec.StartTime.Before(&metav1.Time{Time: keptAliveAt}) {
+			desiredSpec.StartTime = &metav1.Time{Time: keptAliveAt}
+		}
+	}
+	return changed
+}
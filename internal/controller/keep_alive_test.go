@@ -0,0 +1,92 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestKeepAliveLabelChangeRestartsCountdown(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		Namespace:   "default",
+		Labels:      map[string]string{"example.com/heartbeat": "1"},
+		Annotations: map[string]string{TTLAnnotationKey: "3600"},
+	}}
+	r := newTestReconciler(t, pod)
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	r.Clock = fakeClock
+	r.KeepAliveLabel = "example.com/heartbeat"
+
+	// 처음 본 값은 기록만 하고 카운트다운은 TTLResource 생성 시각부터
+	reconcileKey(t, r, "default", "web")
+	var ttlResource ttlv1alpha1.TTLResource
+	key := client.ObjectKey{Namespace: "default", Name: "ttl-web"}
+	g.Expect(r.Get(ctx, key, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.Annotations).To(HaveKeyWithValue(KeepAliveValueAnnotationKey, "1"))
+	g.Expect(ttlResource.Spec.StartTime).To(BeNil())
+
+	// label이 바뀌면 그 시각부터 TTL을 다시 셈
+	fakeClock.Step(50 * time.Minute)
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+	pod.Labels["example.com/heartbeat"] = "2"
+	g.Expect(r.Update(ctx, pod)).To(Succeed())
+	reconcileKey(t, r, "default", "web")
+
+	g.Expect(r.Get(ctx, key, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.Annotations).To(HaveKeyWithValue(KeepAliveValueAnnotationKey, "2"))
+	g.Expect(ttlResource.Spec.StartTime).NotTo(BeNil())
+	g.Expect(ttlResource.Spec.StartTime.Time).To(BeTemporally("==", fakeClock.Now()))
+
+	// 값이 그대로면 다시 reconcile되어도 기준 시각이 바뀌지 않음
+	fakeClock.Step(time.Minute)
+	reconcileKey(t, r, "default", "web")
+	var unchanged ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, key, &unchanged)).To(Succeed())
+	g.Expect(unchanged.Spec.StartTime.Time).To(BeTemporally("==", ttlResource.Spec.StartTime.Time))
+	g.Expect(unchanged.ResourceVersion).To(Equal(ttlResource.ResourceVersion))
+}
+
+func TestKeepAliveLabelDisabledByDefault(t *testing.T) {
+	g := NewWithT(t)
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		Namespace:   "default",
+		Labels:      map[string]string{"example.com/heartbeat": "1"},
+		Annotations: map[string]string{TTLAnnotationKey: "3600"},
+	}}
+	r := newTestReconciler(t, pod)
+
+	reconcileKey(t, r, "default", "web")
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.Annotations).NotTo(HaveKey(KeepAliveValueAnnotationKey))
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// WaitForLeaseAnnotationKey는 만료된 owner를 같은 네임스페이스의 Lease가 풀릴 때까지 삭제하지 않도록 Lease 이름을 지정하는 annotation 키입니다.
+// 외부 프로세스가 중요한 작업 중에 Lease를 잡아 두면 그동안 삭제를 막을 수 있습니다
+const WaitForLeaseAnnotationKey = "ttl.example.com/wait-for-lease"
+
+// ConditionWaitingForLease는 wait-for-lease로 지정한 Lease가 아직 잡혀 있어 삭제를 미루고 있음을 나타냅니다
+const ConditionWaitingForLease = "WaitingForLease"
+
+// leaseRequeueInterval은 Lease가 풀렸는지 다시 확인하는 최대 간격입니다.
+// Lease 변경은 TTLResource reconcile을 일으키지 않고 holder가 만료 전에 Lease를 놓을 수도 있으므로 이 간격으로 확인합니다
+const leaseRequeueInterval = 10 * time.Second
+
+// waitForLease는 owner의 wait-for-lease annotation이 가리키는 Lease가 아직 잡혀 있어 삭제를 미뤄야 하는지 확인합니다.
+// 미뤄야 하면 TTLResource에 기록할 condition과 다시 확인할 간격을 반환합니다.
+// owner나 annotation이 없거나, Lease가 없거나 holder가 없거나 갱신 기한이 지났으면 풀린 것으로 보고 미루지 않습니다.
+func (r *ResourceReconciler) waitForLease(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource,
+	ownerRef metav1.OwnerReference, logger logr.Logger) (metav1.Condition, time.Duration, bool, error) {
+	owner, err := r.getOwnerObject(ctx, ownerRef, ttlResource.Namespace)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return metav1.Condition{}, 0, false, nil
+		}
+		return metav1.Condition{}, 0, false, err
+	}
+	leaseName := owner.GetAnnotations()[WaitForLeaseAnnotationKey]
+	if leaseName == "" {
+		return metav1.Condition{}, 0, false, nil
+	}
+
+	var lease coordinationv1.Lease
+	if err := r.Get(ctx, client.ObjectKey{Namespace: ttlResource.Namespace, Name: leaseName}, &lease); err != nil {
+		if errors.IsNotFound(err) {
+			return metav1.Condition{}, 0, false, nil
+		}
+		return metav1.Condition{}, 0, false, err
+	}
+	holder, expiresAt, held := leaseHeld(&lease, r.now())
+	if !held {
+		return metav1.Condition{}, 0, false, nil
+	}
+
+	requeueAfter := leaseRequeueInterval
+	message := fmt.Sprintf("Lease %s is held by %q", leaseName, holder)
+	if !expiresAt.IsZero() {
+		requeueAfter = min(expiresAt.Sub(r.now()), leaseRequeueInterval)
+		message += fmt.Sprintf(" until %s", expiresAt.UTC().Format(time.RFC3339))
+	}
+	logger.Info("Lease is held, deferring deletion", "owner", ownerRef.Name, "lease", leaseName, "holder", holder)
+	return metav1.Condition{
+		Type:    ConditionWaitingForLease,
+		Status:  metav1.ConditionTrue,
+		Reason:  "LeaseHeld",
+		Message: message,
+	}, requeueAfter, true, nil
+}
+
+// leaseHeld는 Lease를 누가 언제까지 잡고 있는지 반환합니다. holder가 없거나 renewTime + leaseDurationSeconds가 지났으면 풀린 것입니다.
+// holder는 있는데 갱신 시각이나 기간이 없으면 만료 시각을 알 수 없으므로 holder가 놓을 때까지 잡힌 것으로 봅니다 (expiresAt은 zero).
+func leaseHeld(lease *coordinationv1.Lease, now time.Time) (string, time.Time, bool) {
+	if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity == "" {
+		return "", time.Time{}, false
+	}
+	holder := *lease.Spec.HolderIdentity
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return holder, time.Time{}, true
+	}
+	expiresAt := lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+	if !now.Before(expiresAt) {
+		return "", time.Time{}, false
+	}
+	return holder, expiresAt, true
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestWaitForLeaseDefersUntilLeaseIsReleased(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	pod.Annotations = map[string]string{WaitForLeaseAnnotationKey: "migration"}
+	lease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Name: "migration", Namespace: "default"},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       ptr.To("migrator-0"),
+			RenewTime:            &metav1.MicroTime{Time: time.Now()},
+			LeaseDurationSeconds: ptr.To[int32](60),
+		},
+	}
+	r := newTestReconciler(t, pod, ttlResource, lease)
+
+	// Lease가 잡혀 있으면 삭제하지 않고 WaitingForLease condition을 기록
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(Equal(leaseRequeueInterval))
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	condition := meta.FindStatusCondition(latest.Status.Conditions, ConditionWaitingForLease)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Message).To(ContainSubstring(`"migrator-0"`))
+
+	// holder가 Lease를 놓으면 다음 확인에서 삭제
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(lease), lease)).To(Succeed())
+	lease.Spec.HolderIdentity = nil
+	g.Expect(r.Update(ctx, lease)).To(Succeed())
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{}))).To(BeTrue())
+}
+
+func TestWaitForLeaseTreatsMissingLeaseAsFree(t *testing.T) {
+	g := NewWithT(t)
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	pod.Annotations = map[string]string{WaitForLeaseAnnotationKey: "missing"}
+	r := newTestReconciler(t, pod, ttlResource)
+
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(errors.IsNotFound(r.Get(context.Background(), client.ObjectKeyFromObject(pod), &corev1.Pod{}))).To(BeTrue())
+}
+
+func TestLeaseHeld(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	lease := func(holder string, renewedAgo time.Duration, duration int32) *coordinationv1.Lease {
+		return &coordinationv1.Lease{Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       ptr.To(holder),
+			RenewTime:            &metav1.MicroTime{Time: now.Add(-renewedAgo)},
+			LeaseDurationSeconds: ptr.To(duration),
+		}}
+	}
+
+	holder, expiresAt, held := leaseHeld(lease("a", 10*time.Second, 30), now)
+	g.Expect(held).To(BeTrue())
+	g.Expect(holder).To(Equal("a"))
+	g.Expect(expiresAt).To(Equal(now.Add(20 * time.Second)))
+
+	// 갱신 기한이 지났거나 holder가 없으면 풀린 것
+	_, _, held = leaseHeld(lease("a", time.Minute, 30), now)
+	g.Expect(held).To(BeFalse())
+	_, _, held = leaseHeld(lease("", 0, 30), now)
+	g.Expect(held).To(BeFalse())
+
+	// holder만 있고 기한을 알 수 없으면 놓을 때까지 잡힌 것
+	_, expiresAt, held = leaseHeld(&coordinationv1.Lease{Spec: coordinationv1.LeaseSpec{HolderIdentity: ptr.To("a")}}, now)
+	g.Expect(held).To(BeTrue())
+	g.Expect(expiresAt.IsZero()).To(BeTrue())
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// loadBalancerCleanupFinalizer는 service controller가 클라우드 load balancer를 정리하는 동안 Service에 남겨두는 finalizer입니다
+const loadBalancerCleanupFinalizer = "service.kubernetes.io/load-balancer-cleanup"
+
+// ConditionWaitingForLBCleanup은 삭제한 LoadBalancer Service의 클라우드 load balancer 정리가 끝나기를 기다리고 있음을 나타냅니다
+const ConditionWaitingForLBCleanup = "WaitingForLBCleanup"
+
+// lbCleanupRequeueInterval은 load balancer 정리가 끝났는지 다시 확인하는 간격입니다
+const lbCleanupRequeueInterval = 15 * time.Second
+
+// waitingForLBCleanup은 owner가 삭제 중인 LoadBalancer Service이고 load balancer 정리 finalizer가 아직 남아 있는지 확인합니다.
+// 정리가 끝나기 전에 TTLResource를 삭제하면 진행 중인 클라우드 리소스 정리를 추적할 수 없게 됩니다.
+func waitingForLBCleanup(owner client.Object) bool {
+	svc, ok := owner.(*corev1.Service)
+	if !ok || svc.Spec.Type != corev1.ServiceTypeLoadBalancer || svc.DeletionTimestamp == nil {
+		return false
+	}
+	return slices.Contains(svc.Finalizers, loadBalancerCleanupFinalizer)
+}
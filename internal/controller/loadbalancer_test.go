@@ -0,0 +1,95 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestLoadBalancerServiceWaitsForCleanupFinalizer(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "lb",
+			Namespace:  "default",
+			Finalizers: []string{loadBalancerCleanupFinalizer},
+		},
+		Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+	}
+	ttlResource := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "ttl-lb",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+			OwnerReferences:   []metav1.OwnerReference{{APIVersion: "v1", Kind: "Service", Name: "lb"}},
+		},
+		Spec: ttlv1alpha1.TTLResourceSpec{TTLSeconds: 60},
+	}
+	r := newTestReconciler(t, svc, ttlResource)
+
+	result := reconcileKey(t, r, "default", "ttl-lb")
+	g.Expect(result.RequeueAfter).To(Equal(lbCleanupRequeueInterval))
+
+	var latestSvc corev1.Service
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(svc), &latestSvc)).To(Succeed())
+	g.Expect(latestSvc.DeletionTimestamp).NotTo(BeNil())
+
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(meta.IsStatusConditionTrue(latest.Status.Conditions, ConditionWaitingForLBCleanup)).To(BeTrue())
+
+	// finalizer가 남아 있는 동안에는 계속 기다림
+	result = reconcileKey(t, r, "default", "ttl-lb")
+	g.Expect(result.RequeueAfter).To(Equal(lbCleanupRequeueInterval))
+
+	// service controller가 load balancer 정리를 끝내고 finalizer를 제거
+	latestSvc.Finalizers = nil
+	g.Expect(r.Update(ctx, &latestSvc)).To(Succeed())
+
+	reconcileKey(t, r, "default", "ttl-lb")
+	err := r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &ttlv1alpha1.TTLResource{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue(), "TTLResource should be deleted after load balancer cleanup")
+}
+
+func TestClusterIPServiceDoesNotWaitForCleanup(t *testing.T) {
+	g := NewWithT(t)
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP},
+	}
+	now := metav1.Now()
+	svc.DeletionTimestamp = &now
+	svc.Finalizers = []string{loadBalancerCleanupFinalizer}
+	g.Expect(waitingForLBCleanup(svc)).To(BeFalse())
+
+	svc.Spec.Type = corev1.ServiceTypeLoadBalancer
+	g.Expect(waitingForLBCleanup(svc)).To(BeTrue())
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/utils/clock"
+)
+
+// LogSampler는 자주 반복되는 로그를 구간(Interval)마다 Burst개까지만 출력하고,
+// 나머지는 생략한 뒤 구간이 끝나면 생략된 개수를 요약해서 출력합니다.
+// 충돌(conflict)이 몰릴 때 로그가 폭증하는 것을 막기 위해 사용합니다.
+// 충돌이 멈춰도 요약이 출력되도록 manager.Runnable로 등록해야 합니다.
+// nil LogSampler는 모든 로그를 그대로 출력합니다.
+type LogSampler struct {
+	mu          sync.Mutex
+	interval    time.Duration
+	burst       int
+	windowStart time.Time
+	count       int
+	suppressed  int
+	// logger는 요약을 출력할 logger로, 마지막으로 생략된 로그의 logger입니다
+	logger logr.Logger
+
+	// Clock은 구간을 나누는 시계입니다. nil이면 실제 시계를 사용합니다
+	Clock clock.Clock
+}
+
+// NewLogSampler는 interval마다 burst개까지 로그를 출력하는 LogSampler를 생성합니다.
+// burst가 0 이하이면 nil을 반환하여 샘플링을 끕니다.
+func NewLogSampler(interval time.Duration, burst int) *LogSampler {
+	if burst <= 0 || interval <= 0 {
+		return nil
+	}
+	return &LogSampler{
+		interval: interval,
+		burst:    burst,
+	}
+}
+
+// Info는 현재 구간에서 허용된 개수 안이면 로그를 출력하고, 아니면 생략합니다.
+func (s *LogSampler) Info(logger logr.Logger, msg string, keysAndValues ...any) {
+	if s == nil {
+		logger.Info(msg, keysAndValues...)
+		return
+	}
+
+	s.mu.Lock()
+	s.rollWindow(clockOrReal(s.Clock).Now())
+	s.count++
+	allowed := s.count <= s.burst
+	if !allowed {
+		s.suppressed++
+		s.logger = logger
+	}
+	s.mu.Unlock()
+
+	if allowed {
+		logger.Info(msg, keysAndValues...)
+	}
+}
+
+// rollWindow는 now에 현재 구간이 끝났으면 생략된 로그를 요약하고 새 구간을 시작합니다. s.mu를 잡고 호출해야 합니다.
+func (s *LogSampler) rollWindow(now time.Time) {
+	if now.Sub(s.windowStart) < s.interval {
+		return
+	}
+	if s.suppressed > 0 {
+		// 이전 구간에서 생략된 로그 요약
+		s.logger.Info("Suppressed repetitive log lines", "suppressed", s.suppressed, "interval", s.interval)
+	}
+	s.windowStart = now
+	s.count = 0
+	s.suppressed = 0
+}
+
+// Start는 ctx가 끝날 때까지 interval마다 끝난 구간의 생략된 로그를 요약합니다.
+// 다음 로그가 오지 않아도 생략된 개수가 출력되도록 합니다.
+func (s *LogSampler) Start(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			s.mu.Lock()
+			s.rollWindow(clockOrReal(s.Clock).Now())
+			s.mu.Unlock()
+		}
+	}
+}
+
+// NeedLeaderElection은 리더가 아니어도 충돌 로그를 요약하도록 false를 반환합니다.
+func (s *LogSampler) NeedLeaderElection() bool {
+	return false
+}
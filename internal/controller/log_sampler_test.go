@@ -0,0 +1,101 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/funcr"
+	. "github.com/onsi/gomega"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestLogSamplerSuppressesAndSummarizes(t *testing.T) {
+	g := NewWithT(t)
+
+	var lines []string
+	logger := funcr.New(func(_, args string) { lines = append(lines, args) }, funcr.Options{})
+
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	sampler := NewLogSampler(time.Minute, 2)
+	sampler.Clock = fakeClock
+
+	for i := 0; i < 5; i++ {
+		sampler.Info(logger, "Conflict updating TTLResource status, will retry")
+	}
+	g.Expect(lines).To(HaveLen(2))
+
+	// 다음 구간의 첫 로그에서 생략된 개수를 요약
+	fakeClock.Step(time.Minute)
+	sampler.Info(logger, "Conflict updating TTLResource status, will retry")
+	g.Expect(lines).To(HaveLen(4))
+	g.Expect(lines[2]).To(ContainSubstring(`"suppressed"=3`))
+}
+
+func TestLogSamplerSummarizesWhenConflictsStop(t *testing.T) {
+	g := NewWithT(t)
+
+	var mu sync.Mutex
+	var lines []string
+	logger := funcr.New(func(_, args string) {
+		mu.Lock()
+		defer mu.Unlock()
+		lines = append(lines, args)
+	}, funcr.Options{})
+	logged := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), lines...)
+	}
+
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	sampler := NewLogSampler(20*time.Millisecond, 1)
+	sampler.Clock = fakeClock
+	for i := 0; i < 4; i++ {
+		sampler.Info(logger, "Conflict updating TTLResource status, will retry")
+	}
+	g.Expect(logged()).To(HaveLen(1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = sampler.Start(ctx) }()
+
+	// 구간이 끝나기 전에는 요약하지 않음
+	g.Consistently(logged, 60*time.Millisecond).Should(HaveLen(1))
+
+	// 다음 충돌이 없어도 구간이 끝나면 생략된 개수를 요약
+	fakeClock.Step(20 * time.Millisecond)
+	g.Eventually(logged).Should(HaveLen(2))
+	g.Expect(logged()[1]).To(ContainSubstring(`"suppressed"=3`))
+}
+
+func TestNilLogSamplerLogsEverything(t *testing.T) {
+	g := NewWithT(t)
+
+	count := 0
+	logger := funcr.New(func(_, _ string) { count++ }, funcr.Options{})
+
+	var sampler *LogSampler
+	for i := 0; i < 5; i++ {
+		sampler.Info(logger, "Conflict")
+	}
+	g.Expect(count).To(Equal(5))
+	g.Expect(NewLogSampler(time.Minute, 0)).To(BeNil())
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// selfTestRunsTotal는 자가 진단 실행 횟수를 결과(success/failure)별로 집계합니다
+	selfTestRunsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ttl_selftest_runs_total",
+			Help: "Number of self-test runs, partitioned by result.",
+		},
+		[]string{"result"},
+	)
+	// selfTestLastSuccess는 마지막 자가 진단이 성공했으면 1, 실패했으면 0입니다
+	selfTestLastSuccess = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "ttl_selftest_last_success",
+			Help: "Whether the last self-test run succeeded (1) or failed (0).",
+		},
+	)
+	// selfTestLastDuration는 마지막 자가 진단에서 카나리가 삭제되기까지 걸린 시간입니다
+	selfTestLastDuration = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "ttl_selftest_last_duration_seconds",
+			Help: "Time from canary creation to its deletion in the last self-test run.",
+		},
+	)
+	// lifecycleEventsTotal는 외부 브로커로 보낸 수명 주기 이벤트 수를 종류와 결과(success/failure/dropped)별로 집계합니다
+	lifecycleEventsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ttl_lifecycle_events_total",
+			Help: "Number of TTL lifecycle events sent to the event sink, partitioned by type and result.",
+		},
+		[]string{"type", "result"},
+	)
+	// deletionLogRecordsTotal는 삭제 기록 ConfigMap(--deletion-log-configmap)에 쓴 기록 수를 결과(success/failure/dropped)별로 집계합니다
+	deletionLogRecordsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ttl_deletion_log_records_total",
+			Help: "Number of deletion records written to the deletion log ConfigMap, partitioned by result.",
+		},
+		[]string{"result"},
+	)
+	// ttlResourcesOverdue는 만료 시각이 지났는데 owner가 아직 남아 있는 TTLResource 수입니다
+	ttlResourcesOverdue = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "ttl_resources_overdue",
+			Help: "Number of TTLResources past their expiry whose owner still exists.",
+		},
+	)
+	// deletionsInFlight는 DeletionLimiter를 거쳐 지금 진행 중인 삭제 API 호출 수입니다
+	deletionsInFlight = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "ttl_deletions_in_flight",
+			Help: "Number of deletion API calls currently in flight through the global deletion limiter.",
+		},
+	)
+	// apiThrottledTotal는 API 서버가 429(Too Many Requests)나 Retry-After로 늦추라고 응답한 요청 수를 작업(delete/reconcile)별로 집계합니다
+	apiThrottledTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ttl_api_throttled_requests_total",
+			Help: "Number of API requests the API server throttled with 429 or Retry-After, partitioned by operation.",
+		},
+		[]string{"operation"},
+	)
+	// deletionsThrottledTotal는 전역 삭제 한도(--max-deletions-per-window)에 걸려 미룬 owner 삭제 수입니다
+	deletionsThrottledTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "ttl_deletions_throttled_total",
+			Help: "Number of owner deletions deferred because the global deletion budget per window was exhausted.",
+		},
+	)
+	// ownerDeletionsTotal는 만료로 owner를 삭제한 결과(deleted/forbidden/failed/blocked)를 owner Kind별로 집계합니다
+	ownerDeletionsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ttl_owner_deletions_total",
+			Help: "Number of expired owner deletions attempted, partitioned by owner kind and result.",
+		},
+		[]string{"kind", "result"},
+	)
+	// expiredResourcesTotal는 owner 삭제까지 끝나 처리가 완료된 만료 TTLResource 수를 owner Kind별로 집계합니다
+	expiredResourcesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ttl_expired_resources_total",
+			Help: "Number of expired TTLResources whose cleanup completed, partitioned by owner kind.",
+		},
+		[]string{"kind"},
+	)
+	// kindDeletePermitted는 Kind별로 operator에 삭제 권한이 있는지(1) 없는지(0)를 나타냅니다 (--rbac-check-interval)
+	kindDeletePermitted = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ttl_kind_delete_permitted",
+			Help: "Whether the operator is allowed to delete resources of the kind (1) or not (0), as of the last RBAC check.",
+		},
+		[]string{"kind"},
+	)
+)
+
+// metricKind는 metric의 kind label 값을 반환합니다.
+// cardinality를 제한하기 위해 지원하는 Kind가 아니면 "other", owner가 없으면 "none"을 사용합니다.
+func metricKind(kind string) string {
+	if kind == "" {
+		return "none"
+	}
+	if _, ok := supportedKinds[kind]; !ok {
+		return "other"
+	}
+	return kind
+}
+
+// ownersMetricKind는 삭제 대상 owner 목록의 대표 Kind(첫 번째 owner)를 metric label 값으로 반환합니다.
+func ownersMetricKind(owners []metav1.OwnerReference) string {
+	if len(owners) == 0 {
+		return metricKind("")
+	}
+	return metricKind(owners[0].Kind)
+}
+
+func init() {
+	metrics.Registry.MustRegister(
+		selfTestRunsTotal,
+		selfTestLastSuccess,
+		selfTestLastDuration,
+		lifecycleEventsTotal,
+		deletionLogRecordsTotal,
+		ttlResourcesOverdue,
+		deletionsInFlight,
+		apiThrottledTotal,
+		deletionsThrottledTotal,
+		ownerDeletionsTotal,
+		expiredResourcesTotal,
+		kindDeletePermitted,
+	)
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMetricKindIsBoundedToSupportedKinds(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(metricKind("Deployment")).To(Equal("Deployment"))
+	g.Expect(metricKind("CronJob")).To(Equal("other"))
+	g.Expect(metricKind("")).To(Equal("none"))
+	g.Expect(ownersMetricKind(nil)).To(Equal("none"))
+	g.Expect(ownersMetricKind([]metav1.OwnerReference{{Kind: "Pod"}, {Kind: "Service"}})).To(Equal("Pod"))
+}
+
+func TestExpiryMetricsAreLabeledWithOwnerKind(t *testing.T) {
+	g := NewWithT(t)
+
+	deletedBefore := testutil.ToFloat64(ownerDeletionsTotal.WithLabelValues("Pod", "deleted"))
+	expiredBefore := testutil.ToFloat64(expiredResourcesTotal.WithLabelValues("Pod"))
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	r := newTestReconciler(t, pod, ttlResource)
+	reconcileKey(t, r, "default", "ttl-web")
+
+	g.Expect(testutil.ToFloat64(ownerDeletionsTotal.WithLabelValues("Pod", "deleted"))).To(Equal(deletedBefore + 1))
+	g.Expect(testutil.ToFloat64(expiredResourcesTotal.WithLabelValues("Pod"))).To(Equal(expiredBefore + 1))
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// legacyTTLResource는 startTime, keepAfterExpiry, targetRef, jitterSeconds, conditions, deferReason 등
+// 이후에 추가된 필드가 없던 시절의 v1alpha1 TTLResource를 JSON에서 읽어옵니다.
+func legacyTTLResource(t *testing.T, created time.Time, status string) *ttlv1alpha1.TTLResource {
+	t.Helper()
+	raw := fmt.Sprintf(`{
+		"apiVersion": "ttl.example.com/v1alpha1",
+		"kind": "TTLResource",
+		"metadata": {
+			"name": "ttl-web",
+			"namespace": "default",
+			"creationTimestamp": %q,
+			"ownerReferences": [{"apiVersion": "v1", "kind": "Pod", "name": "web", "uid": ""}]
+		},
+		"spec": {"ttlSeconds": 60},
+		"status": %s
+	}`, created.UTC().Format(time.RFC3339), status)
+	var ttlResource ttlv1alpha1.TTLResource
+	NewWithT(t).Expect(json.Unmarshal([]byte(raw), &ttlResource)).To(Succeed())
+	return &ttlResource
+}
+
+func TestLegacyTTLResourceIsBackfilled(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	created := time.Now().Add(-10 * time.Second)
+	// 예전 버전은 status를 비워 두었거나 expired만 기록함
+	for _, status := range []string{`{}`, `{"expired": false}`} {
+		ttlResource := legacyTTLResource(t, created, status)
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+		r := newTestReconciler(t, pod, ttlResource)
+
+		result := reconcileKey(t, r, "default", "ttl-web")
+		g.Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+		var latest ttlv1alpha1.TTLResource
+		g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+		g.Expect(latest.Status.CreatedAt.Unix()).To(Equal(created.Unix()))
+		g.Expect(latest.Status.ExpiredAt).NotTo(BeNil())
+		g.Expect(latest.Status.ExpiredAt.Unix()).To(Equal(created.Add(60 * time.Second).Unix()))
+		g.Expect(latest.Status.Conditions).To(BeEmpty())
+		g.Expect(latest.Annotations).To(HaveKey(ExpireAtAnnotationKey))
+		g.Expect(latest.Annotations).To(HaveKey(EffectiveTTLAnnotationKey))
+	}
+}
+
+func TestLegacyExpiredTTLResourceIsDeleted(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	// expiredAt 필드가 생기기 전에 만료로 기록된 TTLResource
+	ttlResource := legacyTTLResource(t, time.Now().Add(-2*time.Minute), `{"expired": true}`)
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	r := newTestReconciler(t, pod, ttlResource)
+
+	reconcileKey(t, r, "default", "ttl-web")
+	reconcileKey(t, r, "default", "ttl-web")
+
+	err := r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue(), "expired owner should be deleted")
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// managedTTLResourceDeleted는 resource 컨트롤러가 만든 TTLResource가 만료 전에 삭제된 경우에만 이벤트를 통과시킵니다.
+// 만료된 TTLResource는 operator가 owner를 삭제한 뒤 직접 지우는 것이므로 owner를 다시 reconcile하지 않습니다.
+var managedTTLResourceDeleted = predicate.Funcs{
+	CreateFunc: func(event.CreateEvent) bool {
+		return false
+	},
+	UpdateFunc: func(event.UpdateEvent) bool {
+		return false
+	},
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		ttlResource, ok := e.Object.(*ttlv1alpha1.TTLResource)
+		if !ok || ttlResource.Labels[TTLResourceLabelKey] != TTLResourceLabelValue {
+			return false
+		}
+		return !ttlResource.Status.Expired
+	},
+	GenericFunc: func(event.GenericEvent) bool {
+		return false
+	},
+}
+
+// requestsForDeletedTTLResource는 삭제된 TTLResource("ttl-<name>" 또는 이름 템플릿으로 만든 이름)의 owner를 다시 reconcile하도록 요청을 만듭니다.
+// owner에 아직 TTL annotation이 있으면 owner가 바뀌기를 기다리지 않고 바로 TTLResource를 다시 만들고,
+// owner가 없거나 삭제 중이면 owner reconcile이 아무 것도 만들지 않습니다.
+func (r *ResourceReconciler) requestsForDeletedTTLResource(_ context.Context, obj client.Object) []reconcile.Request {
+	ttlResource, ok := obj.(*ttlv1alpha1.TTLResource)
+	if !ok {
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, name := range r.TTLResourceNamer.managedOwnerNames(ttlResource) {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKey{Namespace: obj.GetNamespace(), Name: name}})
+	}
+	return requests
+}
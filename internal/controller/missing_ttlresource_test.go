@@ -0,0 +1,73 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestDeletedTTLResourceIsRecreatedForAnnotatedOwner(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		Namespace:   "default",
+		Annotations: map[string]string{TTLAnnotationKey: "60"},
+	}}
+	r := newTestReconciler(t, pod)
+	key := client.ObjectKey{Namespace: "default", Name: "ttl-web"}
+	reconcileKey(t, r, "default", "web")
+
+	// 사용자가 TTLResource를 직접 삭제
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, key, &ttlResource)).To(Succeed())
+	g.Expect(r.Delete(ctx, &ttlResource)).To(Succeed())
+
+	// 삭제 이벤트로 owner가 다시 reconcile되어 TTLResource가 다시 생성됨
+	g.Expect(managedTTLResourceDeleted.Delete(event.DeleteEvent{Object: &ttlResource})).To(BeTrue())
+	requests := r.requestsForDeletedTTLResource(ctx, &ttlResource)
+	g.Expect(requests).To(HaveLen(1))
+	g.Expect(requests[0].NamespacedName).To(Equal(client.ObjectKey{Namespace: "default", Name: "web"}))
+
+	reconcileKey(t, r, requests[0].Namespace, requests[0].Name)
+	var recreated ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, key, &recreated)).To(Succeed())
+	g.Expect(recreated.Spec.TTLSeconds).To(Equal(60))
+	g.Expect(recreated.OwnerReferences).To(ConsistOf(HaveField("Name", "web")))
+}
+
+func TestManagedTTLResourceDeletedIgnoresExpiredAndUnlabeled(t *testing.T) {
+	g := NewWithT(t)
+
+	_, unlabeled := expiredOwner(t, &corev1.Pod{}, nil)
+	g.Expect(managedTTLResourceDeleted.Delete(event.DeleteEvent{Object: unlabeled})).To(BeFalse())
+
+	expired := unlabeled.DeepCopy()
+	expired.Labels = map[string]string{TTLResourceLabelKey: TTLResourceLabelValue}
+	expired.Status.Expired = true
+	g.Expect(managedTTLResourceDeleted.Delete(event.DeleteEvent{Object: expired})).To(BeFalse())
+}
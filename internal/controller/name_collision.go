@@ -0,0 +1,91 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// EventReasonTTLResourceNameCollision은 TTLResource 이름이 같은 이름의 다른 Kind 리소스와 겹쳐 TTL을 적용하지 않았음을 알리는 Event reason입니다
+const EventReasonTTLResourceNameCollision = "TTLResourceNameCollision"
+
+// ttlResourceNameCollision은 컨트롤러가 만든 TTLResource가 obj가 아닌 다른 Kind의 리소스를 위해 만들어졌는지 확인합니다.
+// 예를 들어 같은 이름의 Pod와 Service는 기본 이름 "ttl-<name>"이 같으므로, Pod의 TTLResource를 Service가 이어 쓰면
+// 만료 시 Pod까지 삭제됩니다. 다른 리소스가 있으면 그 OwnerReference를 반환하며, 그 리소스가 아직 있으면 collided가 true입니다.
+// 이미 사라졌으면 collided가 false이며 호출자는 TTLResource를 새로 만들 수 있습니다.
+func (r *ResourceReconciler) ttlResourceNameCollision(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource,
+	obj client.Object, kind string) (*metav1.OwnerReference, bool, error) {
+	if ttlResource.Labels[TTLResourceLabelKey] != TTLResourceLabelValue {
+		return nil, false, nil
+	}
+	var other *metav1.OwnerReference
+	for _, owner := range ownersOf(ttlResource) {
+		if _, supported := supportedKinds[owner.Kind]; !supported || !r.TTLResourceNamer.ownsTTLResource(ttlResource, owner) {
+			continue
+		}
+		if owner.Kind == kind && owner.Name == obj.GetName() {
+			// obj의 OwnerReference가 있으면 obj의 TTLResource (UID가 다른 경우는 ownedByPreviousIncarnation에서 처리)
+			return nil, false, nil
+		}
+		if other == nil {
+			other = &owner
+		}
+	}
+	if other == nil {
+		return nil, false, nil
+	}
+
+	current, err := r.getOwnerObject(ctx, *other, ttlResource.Namespace)
+	if errors.IsNotFound(err) {
+		return other, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if uidMismatch(current.GetUID(), other.UID) {
+		// 같은 이름으로 다시 만들어진 다른 리소스는 이 TTLResource의 owner가 아님
+		return other, false, nil
+	}
+	return other, true, nil
+}
+
+// reportNameCollision은 TTLResource 이름 충돌로 obj에 TTL을 적용하지 않았음을 오류 로그와 obj의 Warning Event로 알립니다.
+// 같은 리소스의 같은 상대는 한 번만 Event로 알립니다.
+func (r *ResourceReconciler) reportNameCollision(ttlResource *ttlv1alpha1.TTLResource, obj client.Object, kind string,
+	other *metav1.OwnerReference, logger logr.Logger) {
+	err := fmt.Errorf("TTLResource %s already belongs to %s/%s", ttlResource.Name, other.Kind, other.Name)
+	logger.Error(err, "TTLResource name collision, not applying TTL; set ttl.example.com/target-kind or --ttlresource-name-template to tell them apart",
+		"resource", client.ObjectKeyFromObject(obj), "kind", kind, "ownerKind", other.Kind, "ownerUID", other.UID)
+	if r.Recorder == nil {
+		return
+	}
+	reported := other.Kind + "/" + string(other.UID)
+	if previous, ok := r.nameCollisionReported.Swap(client.ObjectKeyFromObject(obj).String(), reported); ok && previous == reported {
+		return
+	}
+	r.Recorder.Event(obj, corev1.EventTypeWarning, EventReasonTTLResourceNameCollision,
+		fmt.Sprintf("TTL not applied: TTLResource %s already belongs to %s/%s with the same name", ttlResource.Name, other.Kind, other.Name))
+}
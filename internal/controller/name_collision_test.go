@@ -0,0 +1,97 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// sameNameService는 annotatedPod와 같은 이름으로 Service를 대상으로 지정한 Service입니다.
+func sameNameService() *corev1.Service {
+	return &corev1.Service{ObjectMeta: metav1.ObjectMeta{
+		Name:      "web",
+		Namespace: "default",
+		UID:       "svc-uid",
+		Annotations: map[string]string{
+			TTLAnnotationKey:        "3600",
+			TargetKindAnnotationKey: "Service",
+		},
+	}}
+}
+
+func TestSameNameServiceDoesNotTakeOverPodTTLResource(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod := annotatedPod("pod-uid")
+	r := newTestReconciler(t, pod)
+	recorder := record.NewFakeRecorder(10)
+	r.Recorder = recorder
+	key := client.ObjectKey{Namespace: "default", Name: "ttl-web"}
+
+	reconcileKey(t, r, "default", "web")
+	var before ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, key, &before)).To(Succeed())
+	g.Expect(before.OwnerReferences).To(ConsistOf(HaveField("Kind", "Pod")))
+
+	// 같은 이름의 Service가 대상이 되어도 Pod의 TTLResource를 이어 쓰지 않음
+	g.Expect(r.Create(ctx, sameNameService())).To(Succeed())
+	reconcileKey(t, r, "default", "web")
+	reconcileKey(t, r, "default", "web")
+
+	var after ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, key, &after)).To(Succeed())
+	g.Expect(after.OwnerReferences).To(ConsistOf(HaveField("Kind", "Pod")))
+	g.Expect(after.Spec.TTLSeconds).To(Equal(60))
+	g.Expect(recorder.Events).To(HaveLen(1))
+	g.Expect(<-recorder.Events).To(ContainSubstring(EventReasonTTLResourceNameCollision))
+
+	// 만료되어도 삭제 대상은 Pod뿐
+	targets, err := r.deletionTargets(&after)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(targets).To(ConsistOf(HaveField("Kind", "Pod")))
+}
+
+func TestTTLResourceOfDeletedSameNameOwnerIsReplaced(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod := annotatedPod("pod-uid")
+	r := newTestReconciler(t, pod)
+	key := client.ObjectKey{Namespace: "default", Name: "ttl-web"}
+	reconcileKey(t, r, "default", "web")
+
+	// Pod가 사라진 뒤 같은 이름의 Service는 새 TTLResource를 받음
+	g.Expect(r.Delete(ctx, pod)).To(Succeed())
+	g.Expect(r.Create(ctx, sameNameService())).To(Succeed())
+	g.Expect(reconcileKey(t, r, "default", "web").RequeueAfter).To(Equal(staleTTLResourceRequeueInterval))
+	reconcileKey(t, r, "default", "web")
+
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, key, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.OwnerReferences).To(ConsistOf(HaveField("Kind", "Service")))
+	g.Expect(ttlResource.Spec.TTLSeconds).To(Equal(3600))
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NamespaceDefaultTTLAnnotationKey는 네임스페이스 안의 리소스가 TTL annotation이 없을 때 상속하는 기본 TTL(초) annotation 키입니다
+const NamespaceDefaultTTLAnnotationKey = "ttl.example.com/default-ttl-seconds"
+
+// namespaceDefaultTTL은 네임스페이스의 default-ttl-seconds annotation 값을 반환합니다.
+func (r *ResourceReconciler) namespaceDefaultTTL(ctx context.Context, namespace string) (string, bool, error) {
+	var ns corev1.Namespace
+	if err := r.Get(ctx, client.ObjectKey{Name: namespace}, &ns); err != nil {
+		if errors.IsNotFound(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	value, ok := ns.Annotations[NamespaceDefaultTTLAnnotationKey]
+	return value, ok, nil
+}
+
+// namespaceDefaultChanged는 네임스페이스의 기본 TTL annotation이 바뀐 경우에만 이벤트를 통과시킵니다.
+var namespaceDefaultChanged = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		_, ok := e.Object.GetAnnotations()[NamespaceDefaultTTLAnnotationKey]
+		return ok
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldValue, oldOK := e.ObjectOld.GetAnnotations()[NamespaceDefaultTTLAnnotationKey]
+		newValue, newOK := e.ObjectNew.GetAnnotations()[NamespaceDefaultTTLAnnotationKey]
+		return oldOK != newOK || oldValue != newValue
+	},
+	DeleteFunc: func(event.DeleteEvent) bool {
+		return false
+	},
+}
+
+// requestsForNamespaceDefault는 네임스페이스의 기본 TTL이 바뀌었을 때 기본값을 상속하는 리소스,
+// 즉 TTL annotation이 없는 리소스를 다시 reconcile하도록 요청을 만듭니다.
+func (r *ResourceReconciler) requestsForNamespaceDefault(ctx context.Context, obj client.Object) []reconcile.Request {
+	logger := logf.FromContext(ctx)
+
+	seen := map[string]bool{}
+	var requests []reconcile.Request
+	for _, list := range r.watchedLists() {
+		if err := r.List(ctx, list, client.InNamespace(obj.GetName())); err != nil {
+			logger.Error(err, "Failed to list resources for namespace default TTL", "namespace", obj.GetName())
+			continue
+		}
+		_ = meta.EachListItem(list, func(item runtime.Object) error {
+			o, ok := item.(client.Object)
+			if !ok {
+				return nil
+			}
+			if _, explicit := o.GetAnnotations()[TTLAnnotationKey]; explicit || seen[o.GetName()] {
+				return nil
+			}
+			seen[o.GetName()] = true
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(o)})
+			return nil
+		})
+	}
+	return requests
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestNamespaceDefaultTTLIsInheritedAndRecomputed(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:        "team-a",
+		Annotations: map[string]string{NamespaceDefaultTTLAnnotationKey: "300"},
+	}}
+	inherited := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "inherited", Namespace: "team-a"}}
+	explicit := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "explicit",
+		Namespace:   "team-a",
+		Annotations: map[string]string{TTLAnnotationKey: "60"},
+	}}
+	r := newTestReconciler(t, ns, inherited, explicit)
+
+	reconcileKey(t, r, "team-a", "inherited")
+	reconcileKey(t, r, "team-a", "explicit")
+
+	ttlSecondsOf := func(name string) int {
+		var ttlResource ttlv1alpha1.TTLResource
+		g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "team-a", Name: "ttl-" + name}, &ttlResource)).To(Succeed())
+		return ttlResource.Spec.TTLSeconds
+	}
+	g.Expect(ttlSecondsOf("inherited")).To(Equal(300))
+	g.Expect(ttlSecondsOf("explicit")).To(Equal(60))
+
+	// 기본값은 reconcile에서만 적용하며 리소스 자체에는 annotation을 쓰지 않음 (mutating webhook 불필요)
+	var unmodified corev1.Pod
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(inherited), &unmodified)).To(Succeed())
+	g.Expect(unmodified.Annotations).To(BeEmpty())
+	g.Expect(unmodified.ResourceVersion).To(Equal(inherited.ResourceVersion))
+
+	// 기본값이 바뀌면 annotation이 없는 리소스만 다시 reconcile
+	ns.Annotations[NamespaceDefaultTTLAnnotationKey] = "600"
+	g.Expect(r.Update(ctx, ns)).To(Succeed())
+	requests := r.requestsForNamespaceDefault(ctx, ns)
+	g.Expect(requests).To(HaveLen(1))
+	g.Expect(requests[0].Name).To(Equal("inherited"))
+
+	reconcileKey(t, r, "team-a", "inherited")
+	g.Expect(ttlSecondsOf("inherited")).To(Equal(600))
+	g.Expect(ttlSecondsOf("explicit")).To(Equal(60))
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// NamespaceSuspendAnnotationKey는 네임스페이스 안의 모든 TTL 삭제를 멈추는 Namespace annotation입니다 ("true"일 때)
+const NamespaceSuspendAnnotationKey = "ttl.example.com/suspend"
+
+// IgnoreSuspendAnnotationKey는 --allow-ignore-suspend가 켜져 있을 때 네임스페이스가 suspend되어 있어도
+// 만료된 리소스를 삭제하도록 owner(또는 TTLResource)에 지정하는 annotation입니다 ("true"일 때)
+const IgnoreSuspendAnnotationKey = "ttl.example.com/ignore-suspend"
+
+// ConditionNamespaceSuspended는 네임스페이스의 suspend annotation 때문에 삭제를 미루고 있음을 나타냅니다
+const ConditionNamespaceSuspended = "NamespaceSuspended"
+
+// namespaceSuspendRequeueInterval은 suspend된 네임스페이스의 TTLResource를 다시 확인하는 간격입니다.
+// annotation이 바뀌면 Namespace watch로 바로 reconcile되므로 놓친 이벤트에 대비한 값입니다
+const namespaceSuspendRequeueInterval = 5 * time.Minute
+
+// namespaceSuspended는 네임스페이스에 suspend: "true" annotation이 있는지 확인합니다.
+func (r *ResourceReconciler) namespaceSuspended(ctx context.Context, namespace string) (bool, error) {
+	var ns corev1.Namespace
+	if err := r.Get(ctx, client.ObjectKey{Name: namespace}, &ns); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return ns.Annotations[NamespaceSuspendAnnotationKey] == "true", nil
+}
+
+// ignoresSuspend는 --allow-ignore-suspend가 켜져 있고 TTLResource 또는 삭제할 owner 모두에 ignore-suspend: "true"가 있어
+// suspend 중에도 삭제해야 하는지 확인합니다. 장애 대응 중에도 반드시 지워야 하는 리소스를 위한 예외입니다.
+// owner가 여러 개면 하나라도 annotation이 없으면 예외로 보지 않습니다. 이미 없는 owner는 확인하지 않습니다.
+func (r *ResourceReconciler) ignoresSuspend(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource) (bool, error) {
+	if !r.AllowIgnoreSuspend {
+		return false, nil
+	}
+	if ttlResource.Annotations[IgnoreSuspendAnnotationKey] == "true" {
+		return true, nil
+	}
+	found := false
+	for _, ownerRef := range ownersOf(ttlResource) {
+		owner, err := r.getOwnerObject(ctx, ownerRef, ttlResource.Namespace)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return false, err
+		}
+		if owner.GetAnnotations()[IgnoreSuspendAnnotationKey] != "true" {
+			return false, nil
+		}
+		found = true
+	}
+	return found, nil
+}
+
+// deferForSuspendedNamespace는 TTLResource를 NamespaceSuspended 상태로 표시하고 suspend가 풀릴 때까지 삭제를 미룹니다.
+// 처음 대기 상태가 될 때만 Event를 기록합니다.
+func (r *ResourceReconciler) deferForSuspendedNamespace(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource, logger logr.Logger) (ctrl.Result, error) {
+	if !meta.IsStatusConditionTrue(ttlResource.Status.Conditions, ConditionNamespaceSuspended) {
+		logger.Info("Namespace is suspended, deferring deletion", "name", ttlResource.Name, "namespace", ttlResource.Namespace)
+		r.recordEvent(ttlResource, corev1.EventTypeNormal, ConditionNamespaceSuspended,
+			"TTL expired; deletion is deferred while namespace annotation "+NamespaceSuspendAnnotationKey+"=\"true\" is set")
+	}
+	return r.deferDeletion(ctx, ttlResource, metav1.Condition{
+		Type:    ConditionNamespaceSuspended,
+		Status:  metav1.ConditionTrue,
+		Reason:  "SuspendAnnotation",
+		Message: "namespace " + ttlResource.Namespace + " has annotation " + NamespaceSuspendAnnotationKey + "=\"true\"",
+	}, namespaceSuspendRequeueInterval, logger)
+}
+
+// namespaceSuspendChanged는 네임스페이스의 suspend annotation이 바뀐 경우에만 이벤트를 통과시킵니다.
+var namespaceSuspendChanged = predicate.Funcs{
+	CreateFunc: func(event.CreateEvent) bool {
+		return false
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return e.ObjectOld.GetAnnotations()[NamespaceSuspendAnnotationKey] != e.ObjectNew.GetAnnotations()[NamespaceSuspendAnnotationKey]
+	},
+	DeleteFunc: func(event.DeleteEvent) bool {
+		return false
+	},
+}
+
+// requestsForSuspendedNamespace는 suspend annotation이 바뀐 네임스페이스의 만료된 TTLResource를 다시 reconcile하도록
+// 요청을 만듭니다. suspend가 풀리면 다음 재확인을 기다리지 않고 바로 삭제를 이어갑니다.
+func (r *ResourceReconciler) requestsForSuspendedNamespace(ctx context.Context, obj client.Object) []reconcile.Request {
+	var list ttlv1alpha1.TTLResourceList
+	if err := r.List(ctx, &list, client.InNamespace(obj.GetName())); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list TTLResources for namespace suspend", "namespace", obj.GetName())
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range list.Items {
+		if list.Items[i].Status.Expired {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&list.Items[i])})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestSuspendedNamespaceDefersDeletionUntilResumed(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:        "default",
+		Annotations: map[string]string{NamespaceSuspendAnnotationKey: "true"},
+	}}
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	r := newTestReconciler(t, ns, pod, ttlResource)
+
+	// suspend된 동안에는 삭제하지 않고 NamespaceSuspended condition을 기록
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(Equal(namespaceSuspendRequeueInterval))
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	condition := meta.FindStatusCondition(latest.Status.Conditions, ConditionNamespaceSuspended)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Reason).To(Equal("SuspendAnnotation"))
+
+	// suspend를 풀면 만료된 TTLResource가 다시 reconcile되어 삭제됨
+	resumed := ns.DeepCopy()
+	resumed.Annotations = nil
+	g.Expect(r.Update(ctx, resumed)).To(Succeed())
+	g.Expect(namespaceSuspendChanged.Update(event.UpdateEvent{ObjectOld: ns, ObjectNew: resumed})).To(BeTrue())
+	requests := r.requestsForSuspendedNamespace(ctx, resumed)
+	g.Expect(requests).To(HaveLen(1))
+	g.Expect(requests[0].Name).To(Equal("ttl-web"))
+
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{}))).To(BeTrue())
+}
+
+func TestNamespaceSuspendChangedIgnoresOtherAnnotations(t *testing.T) {
+	g := NewWithT(t)
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+	labeled := ns.DeepCopy()
+	labeled.Annotations = map[string]string{"team": "a"}
+	g.Expect(namespaceSuspendChanged.Update(event.UpdateEvent{ObjectOld: ns, ObjectNew: labeled})).To(BeFalse())
+}
+
+func TestIgnoreSuspendDeletesDuringNamespaceSuspend(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:        "default",
+		Annotations: map[string]string{NamespaceSuspendAnnotationKey: "true"},
+	}}
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	pod.Annotations = map[string]string{IgnoreSuspendAnnotationKey: "true"}
+
+	// 플래그가 꺼져 있으면 annotation이 있어도 suspend를 따름
+	r := newTestReconciler(t, ns, pod, ttlResource)
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(Equal(namespaceSuspendRequeueInterval))
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+
+	// 플래그가 켜져 있으면 suspend 중에도 삭제
+	r.AllowIgnoreSuspend = true
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{}))).To(BeTrue())
+}
+
+func TestIgnoreSuspendRequiresAnnotationOnEveryOwner(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	web := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default",
+		Annotations: map[string]string{IgnoreSuspendAnnotationKey: "true"}}}
+	db := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"}}
+	_, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	ttlResource.OwnerReferences = append(ttlResource.OwnerReferences, metav1.OwnerReference{APIVersion: "v1", Kind: "Pod", Name: "db"})
+	r := newTestReconciler(t, web, db, ttlResource)
+	r.AllowIgnoreSuspend = true
+
+	bypass, err := r.ignoresSuspend(ctx, ttlResource)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(bypass).To(BeFalse())
+
+	// TTLResource 자체에 annotation이 있으면 모든 owner에 적용
+	ttlResource.Annotations = map[string]string{IgnoreSuspendAnnotationKey: "true"}
+	bypass, err = r.ignoresSuspend(ctx, ttlResource)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(bypass).To(BeTrue())
+}
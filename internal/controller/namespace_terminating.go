@@ -0,0 +1,39 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// namespaceTerminating은 네임스페이스가 삭제 중(Terminating)인지 확인합니다.
+// 삭제 중인 네임스페이스에서는 새 객체를 만들 수 없고 남은 리소스도 Kubernetes가 모두 삭제하므로,
+// TTLResource를 만들거나 만료된 리소스를 지우려다 오류를 반복하지 않도록 reconcile을 건너뜁니다.
+func (r *ResourceReconciler) namespaceTerminating(ctx context.Context, namespace string) (bool, error) {
+	var ns corev1.Namespace
+	if err := r.Get(ctx, client.ObjectKey{Name: namespace}, &ns); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return ns.DeletionTimestamp != nil || ns.Status.Phase == corev1.NamespaceTerminating, nil
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func terminatingNamespace() *corev1.Namespace {
+	now := metav1.Now()
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "default",
+			DeletionTimestamp: &now,
+			Finalizers:        []string{"kubernetes"},
+		},
+		Status: corev1.NamespaceStatus{Phase: corev1.NamespaceTerminating},
+	}
+}
+
+func TestTerminatingNamespaceSkipsTTLResourceCreation(t *testing.T) {
+	g := NewWithT(t)
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		Namespace:   "default",
+		Annotations: map[string]string{TTLAnnotationKey: "60"},
+	}}
+	r := newTestReconciler(t, terminatingNamespace(), pod)
+
+	result := reconcileKey(t, r, "default", "web")
+	g.Expect(result.RequeueAfter).To(BeZero())
+	err := r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &ttlv1alpha1.TTLResource{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestTerminatingNamespaceSkipsExpiredDeletion(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	r := newTestReconciler(t, terminatingNamespace(), pod, ttlResource)
+
+	// 네임스페이스 삭제가 리소스를 정리하므로 삭제나 상태 갱신을 시도하지 않음
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(BeZero())
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(latest.Status.Expired).To(BeFalse())
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DeleteNetpolSelectorAnnotationKey는 owner를 TTL로 삭제할 때 함께 삭제할 NetworkPolicy의 label selector(예: app=web)를 지정하는 annotation 키입니다
+const DeleteNetpolSelectorAnnotationKey = "ttl.example.com/delete-netpol-selector"
+
+// deleteAssociatedNetworkPolicies는 delete-netpol-selector annotation이 있는 owner를 삭제한 뒤 같은 네임스페이스에서
+// label이 selector와 일치하는 NetworkPolicy를 삭제합니다. 앱을 지운 뒤 NetworkPolicy만 남는 것을 막기 위해 사용합니다.
+// 빈 selector는 네임스페이스의 모든 NetworkPolicy와 일치하므로 잘못된 값으로 취급합니다.
+func (r *ResourceReconciler) deleteAssociatedNetworkPolicies(ctx context.Context, owner client.Object, logger logr.Logger) error {
+	value, ok := owner.GetAnnotations()[DeleteNetpolSelectorAnnotationKey]
+	if !ok {
+		return nil
+	}
+	selector, err := labels.Parse(value)
+	if err != nil {
+		return fmt.Errorf("invalid %s annotation %q: %w", DeleteNetpolSelectorAnnotationKey, value, err)
+	}
+	if selector.Empty() {
+		return fmt.Errorf("%s annotation must not be empty", DeleteNetpolSelectorAnnotationKey)
+	}
+
+	var policies networkingv1.NetworkPolicyList
+	if err := r.List(ctx, &policies, client.InNamespace(owner.GetNamespace()), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return fmt.Errorf("failed to list NetworkPolicies: %w", err)
+	}
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		if err := r.DeletionLimiter.Delete(ctx, r.Client, policy); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete NetworkPolicy %s/%s: %w", policy.Namespace, policy.Name, err)
+		}
+		logger.Info("Deleted NetworkPolicy of expired resource", "networkPolicy", policy.Name, "owner", owner.GetName())
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func networkPolicy(name, namespace string, labels map[string]string) *networkingv1.NetworkPolicy {
+	return &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels}}
+}
+
+func TestDeleteNetpolSelectorRemovesMatchingNetworkPolicies(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	deploy, ttlResource := expiredOwner(t, &appsv1.Deployment{}, map[string]string{DeleteNetpolSelectorAnnotationKey: "app=web"})
+	matching := networkPolicy("web-ingress", "default", map[string]string{"app": "web"})
+	other := networkPolicy("api-ingress", "default", map[string]string{"app": "api"})
+	otherNamespace := networkPolicy("web-ingress", "other", map[string]string{"app": "web"})
+	r := newTestReconciler(t, deploy, ttlResource, matching, other, otherNamespace)
+
+	reconcileKey(t, r, "default", "ttl-web")
+
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(deploy), &appsv1.Deployment{}))).To(BeTrue())
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(matching), &networkingv1.NetworkPolicy{}))).To(BeTrue())
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(other), &networkingv1.NetworkPolicy{})).To(Succeed())
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(otherNamespace), &networkingv1.NetworkPolicy{})).To(Succeed())
+}
+
+func TestDeleteNetpolSelectorKeepsPoliciesForInvalidOrEmptySelector(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	for _, selector := range []string{"", "app in (web"} {
+		deploy, ttlResource := expiredOwner(t, &appsv1.Deployment{}, map[string]string{DeleteNetpolSelectorAnnotationKey: selector})
+		policy := networkPolicy("web-ingress", "default", map[string]string{"app": "web"})
+		r := newTestReconciler(t, deploy, ttlResource, policy)
+
+		// selector가 잘못되어도 owner 삭제는 그대로 진행
+		reconcileKey(t, r, "default", "ttl-web")
+
+		g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(deploy), &appsv1.Deployment{}))).To(BeTrue())
+		g.Expect(r.Get(ctx, client.ObjectKeyFromObject(policy), &networkingv1.NetworkPolicy{})).To(Succeed(), "selector %q", selector)
+	}
+}
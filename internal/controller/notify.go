@@ -0,0 +1,187 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NotifyAnnotationKey는 리소스별 알림 대상을 지정하는 annotation입니다 (쉼표로 구분한 URL 목록)
+const NotifyAnnotationKey = "ttl.example.com/notify"
+
+// slackWebhookHost는 Slack incoming webhook의 호스트입니다. 이 호스트로는 Slack 메시지 형식으로 보냅니다
+const slackWebhookHost = "hooks.slack.com"
+
+// ParseNotifyTargets는 notify annotation 값을 알림 대상 목록으로 파싱합니다.
+// 각 대상은 http(s) webhook URL이거나 "nats://host:4222/subject" 형식이어야 하고,
+// Operator가 임의의 내부 주소로 요청을 보내지 않도록 호스트가 allowedHosts에 있어야 합니다.
+func ParseNotifyTargets(value string, allowedHosts []string) ([]string, error) {
+	var targets []string
+	for _, target := range strings.Split(value, ",") {
+		target = strings.TrimSpace(target)
+		if target == "" {
+			continue
+		}
+		if err := validateNotifyTarget(target, allowedHosts); err != nil {
+			return nil, err
+		}
+		targets = append(targets, target)
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("no notification target in %q", value)
+	}
+	return targets, nil
+}
+
+// validateNotifyTarget은 알림 대상 하나의 형식과 호스트를 확인합니다.
+func validateNotifyTarget(target string, allowedHosts []string) error {
+	u, err := url.Parse(target)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("invalid notification target %q: expected an absolute URL", target)
+	}
+	switch u.Scheme {
+	case "http", "https":
+	case "nats":
+		if strings.Trim(u.Path, "/") == "" {
+			return fmt.Errorf("invalid notification target %q: expected nats://host:port/subject", target)
+		}
+	default:
+		return fmt.Errorf("invalid notification target %q: scheme must be http, https or nats", target)
+	}
+	if !notifyHostAllowed(u, allowedHosts) {
+		return fmt.Errorf("notification target host %q is not in --notify-allowed-hosts", u.Host)
+	}
+	return nil
+}
+
+// notifyHostAllowed는 URL의 호스트가 allowedHosts에 있는지 확인합니다.
+// 항목은 "host"(모든 포트) 또는 "host:port" 형식이며 대소문자를 구분하지 않습니다.
+func notifyHostAllowed(u *url.URL, allowedHosts []string) bool {
+	for _, allowed := range allowedHosts {
+		if strings.EqualFold(allowed, u.Host) || strings.EqualFold(allowed, u.Hostname()) {
+			return true
+		}
+	}
+	return false
+}
+
+// notifyTargets는 리소스의 notify annotation에서 알림 대상을 읽습니다.
+// annotation이 없거나 잘못되었으면 nil을 반환하여 전역 event sink로 보내도록 합니다.
+func (r *ResourceReconciler) notifyTargets(obj client.Object, logger logr.Logger) []string {
+	value, ok := obj.GetAnnotations()[NotifyAnnotationKey]
+	if !ok {
+		return nil
+	}
+	targets, err := ParseNotifyTargets(value, r.NotifyAllowedHosts)
+	if err != nil {
+		logger.Info("Invalid notify annotation, falling back to the global event sink", "value", value, "error", err.Error())
+		if r.Recorder != nil {
+			r.Recorder.Event(obj, corev1.EventTypeWarning, "InvalidNotifyTargets", err.Error())
+		}
+		return nil
+	}
+	return targets
+}
+
+// newTargetPublisher는 알림 대상 URL에 맞는 Publisher를 만듭니다.
+// spec.notifyTargets는 annotation을 거치지 않고 직접 쓸 수 있으므로 발행 직전에 allowedHosts를 다시 확인합니다.
+func newTargetPublisher(target string, allowedHosts []string) (Publisher, error) {
+	if err := validateNotifyTarget(target, allowedHosts); err != nil {
+		return nil, err
+	}
+	u, _ := url.Parse(target)
+	if u.Scheme == "nats" {
+		return &NATSPublisher{URL: "nats://" + u.Host, Subject: strings.Trim(u.Path, "/")}, nil
+	}
+	return &WebhookPublisher{URL: target, AllowedHosts: allowedHosts}, nil
+}
+
+// WebhookPublisher는 이벤트를 HTTP POST로 보냅니다. Slack incoming webhook에는 text 메시지로,
+// 그 밖의 URL에는 LifecycleEvent JSON으로 보냅니다.
+type WebhookPublisher struct {
+	// URL은 이벤트를 보낼 webhook 주소입니다
+	URL string
+	// AllowedHosts는 요청과 redirect를 보낼 수 있는 호스트입니다. URL의 호스트도 여기에 있어야 합니다
+	AllowedHosts []string
+	// Client가 nil이면 eventSinkDialTimeout을 timeout으로 쓰는 기본 클라이언트를 사용합니다
+	Client *http.Client
+}
+
+// Publish는 이벤트를 URL로 POST합니다. 2xx가 아닌 응답은 오류로 반환합니다.
+// URL이나 redirect 대상의 호스트가 AllowedHosts에 없으면 보내지 않습니다.
+func (p *WebhookPublisher) Publish(ctx context.Context, event LifecycleEvent) error {
+	u, err := url.Parse(p.URL)
+	if err != nil {
+		return fmt.Errorf("invalid webhook URL: %w", err)
+	}
+	if !notifyHostAllowed(u, p.AllowedHosts) {
+		return fmt.Errorf("webhook host %q is not in --notify-allowed-hosts", u.Host)
+	}
+	var payload any = event
+	if u.Host == slackWebhookHost {
+		payload = map[string]string{"text": lifecycleEventText(event)}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	httpClient := &http.Client{Timeout: eventSinkDialTimeout}
+	if p.Client != nil {
+		copied := *p.Client
+		httpClient = &copied
+	}
+	httpClient.CheckRedirect = func(req *http.Request, _ []*http.Request) error {
+		if !notifyHostAllowed(req.URL, p.AllowedHosts) {
+			return fmt.Errorf("webhook redirect host %q is not in --notify-allowed-hosts", req.URL.Host)
+		}
+		return nil
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook notification: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook notification returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// lifecycleEventText는 사람이 읽는 알림 메시지를 만듭니다.
+func lifecycleEventText(event LifecycleEvent) string {
+	text := fmt.Sprintf("TTLResource %s/%s %s", event.Namespace, event.Name, event.Type)
+	if event.OwnerKind != "" {
+		text += fmt.Sprintf(" (%s/%s)", event.OwnerKind, event.OwnerName)
+	}
+	return text
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestParseNotifyTargets(t *testing.T) {
+	g := NewWithT(t)
+
+	allowedHosts := []string{"hooks.slack.com", "nats:4222"}
+	targets, err := ParseNotifyTargets(" https://hooks.slack.com/services/T0/B0/x, nats://nats:4222/team-a ", allowedHosts)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(targets).To(Equal([]string{"https://hooks.slack.com/services/T0/B0/x", "nats://nats:4222/team-a"}))
+
+	for _, value := range []string{"", "#team-a", "ftp://example.com/x", "nats://nats:4222", "https://"} {
+		_, err := ParseNotifyTargets(value, allowedHosts)
+		g.Expect(err).To(HaveOccurred(), value)
+	}
+
+	// 허용 목록에 없는 호스트(클러스터 내부 주소, 메타데이터 엔드포인트 등)는 거부
+	for _, value := range []string{
+		"http://169.254.169.254/latest/meta-data",
+		"https://kubernetes.default.svc/api",
+		"nats://nats:4333/team-a",
+	} {
+		_, err := ParseNotifyTargets(value, allowedHosts)
+		g.Expect(err).To(MatchError(ContainSubstring("--notify-allowed-hosts")), value)
+	}
+	_, err = ParseNotifyTargets("https://hooks.slack.com/services/T0/B0/x", nil)
+	g.Expect(err).To(HaveOccurred(), "no target is allowed without --notify-allowed-hosts")
+}
+
+func TestNotifyAnnotationSetsTTLResourceTargets(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:      "web",
+		Namespace: "default",
+		Annotations: map[string]string{
+			TTLAnnotationKey:    "60",
+			NotifyAnnotationKey: "https://example.com/team-a",
+		},
+	}}
+	r := newTestReconciler(t, pod)
+	r.NotifyAllowedHosts = []string{"example.com"}
+	key := client.ObjectKey{Namespace: "default", Name: "ttl-web"}
+	reconcileKey(t, r, "default", "web")
+
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, key, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.Spec.NotifyTargets).To(Equal([]string{"https://example.com/team-a"}))
+
+	// 잘못된 값으로 바꾸면 전역 event sink로 되돌아감
+	pod.Annotations[NotifyAnnotationKey] = "#team-a"
+	g.Expect(r.Update(ctx, pod)).To(Succeed())
+	reconcileKey(t, r, "default", "web")
+	g.Expect(r.Get(ctx, key, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.Spec.NotifyTargets).To(BeEmpty())
+	g.Expect(ttlResource.Spec.TTLSeconds).To(Equal(60))
+}
+
+func TestEventSinkSendsToResourceTargets(t *testing.T) {
+	g := NewWithT(t)
+
+	bodies := make(chan []byte, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		bodies <- body
+	}))
+	defer server.Close()
+
+	global := &recordingPublisher{}
+	sink := NewEventSink(global, 1)
+	sink.AllowedHosts = []string{strings.TrimPrefix(server.URL, "http://")}
+	logger := logf.Log
+	ttlResource := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{Name: "ttl-web", Namespace: "default"},
+		Spec:       ttlv1alpha1.TTLResourceSpec{TTLSeconds: 60, NotifyTargets: []string{server.URL + "/team-a"}},
+	}
+
+	// 알림 대상이 있으면 전역 sink 대신 그 대상으로 보냄
+	sink.publish(context.Background(), newLifecycleEvent(LifecycleEventDeleted, ttlResource, time.Now()), logger)
+	var event LifecycleEvent
+	g.Expect(json.Unmarshal(<-bodies, &event)).To(Succeed())
+	g.Expect(event.Type).To(Equal(LifecycleEventDeleted))
+	g.Expect(event.Name).To(Equal("ttl-web"))
+	g.Expect(global.events).To(BeEmpty())
+
+	// 알림 대상이 없으면 전역 sink로 보냄
+	ttlResource.Spec.NotifyTargets = nil
+	sink.publish(context.Background(), newLifecycleEvent(LifecycleEventDeleted, ttlResource, time.Now()), logger)
+	g.Expect(global.events).To(HaveLen(1))
+}
+
+func TestEventSinkSkipsTargetsOutsideAllowedHosts(t *testing.T) {
+	g := NewWithT(t)
+
+	requests := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		requests <- struct{}{}
+	}))
+	defer server.Close()
+
+	// spec.notifyTargets를 직접 쓴 경우에도 허용 목록에 없는 대상으로는 보내지 않음
+	sink := NewEventSink(nil, 1)
+	sink.AllowedHosts = []string{"hooks.slack.com"}
+	event := LifecycleEvent{Type: LifecycleEventDeleted, Name: "ttl-web", Targets: []string{server.URL + "/team-a"}}
+	sink.publish(context.Background(), event, logf.Log)
+	g.Expect(requests).To(BeEmpty())
+	g.Expect(sink.targets).To(BeEmpty())
+}
+
+func TestWebhookPublisherRejectsHostsOutsideAllowedHosts(t *testing.T) {
+	g := NewWithT(t)
+
+	internal := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		t.Error("request should not reach a host outside the allowed hosts")
+	}))
+	defer internal.Close()
+	redirecting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		http.Redirect(w, req, strings.Replace(internal.URL, "127.0.0.1", "localhost", 1), http.StatusTemporaryRedirect)
+	}))
+	defer redirecting.Close()
+
+	event := LifecycleEvent{Type: LifecycleEventDeleted, Name: "ttl-web"}
+	publisher := &WebhookPublisher{URL: internal.URL}
+	g.Expect(publisher.Publish(context.Background(), event)).To(MatchError(ContainSubstring("--notify-allowed-hosts")))
+
+	// 허용된 호스트가 허용 목록에 없는 호스트로 redirect해도 따라가지 않음
+	publisher = &WebhookPublisher{URL: redirecting.URL, AllowedHosts: []string{"127.0.0.1"}}
+	g.Expect(publisher.Publish(context.Background(), event)).To(MatchError(ContainSubstring("redirect")))
+}
+
+func TestEventSinkClosesLeastRecentlyUsedTargets(t *testing.T) {
+	g := NewWithT(t)
+
+	sink := NewEventSink(nil, 1)
+	sink.AllowedHosts = []string{"nats"}
+	oldest := &closingPublisher{}
+	sink.targets["nats://nats:4222/oldest"] = sink.targetOrder.PushFront(&targetEntry{target: "nats://nats:4222/oldest", publisher: oldest})
+	for i := range maxTargetPublishers {
+		_, err := sink.targetPublisher(fmt.Sprintf("nats://nats:4222/team-%d", i))
+		g.Expect(err).NotTo(HaveOccurred())
+	}
+	g.Expect(sink.targets).To(HaveLen(maxTargetPublishers))
+	g.Expect(sink.targets).NotTo(HaveKey("nats://nats:4222/oldest"))
+	g.Expect(oldest.closed).To(BeTrue(), "evicted publisher should be closed")
+}
+
+func TestLifecycleEventText(t *testing.T) {
+	g := NewWithT(t)
+
+	text := lifecycleEventText(LifecycleEvent{
+		Type: LifecycleEventExpiring, Namespace: "default", Name: "ttl-web", OwnerKind: "Pod", OwnerName: "web",
+	})
+	g.Expect(text).To(Equal("TTLResource default/ttl-web expiring (Pod/web)"))
+}
+
+type closingPublisher struct {
+	recordingPublisher
+	closed bool
+}
+
+func (p *closingPublisher) Close() error {
+	p.closed = true
+	return nil
+}
+
+type recordingPublisher struct {
+	events []LifecycleEvent
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, event LifecycleEvent) error {
+	p.events = append(p.events, event)
+	return nil
+}
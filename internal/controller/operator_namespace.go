@@ -0,0 +1,62 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"os"
+	"strings"
+
+	"github.com/go-logr/logr"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	// OperatorNamespaceEnv는 downward API로 operator Pod의 네임스페이스를 전달하는 환경 변수입니다
+	OperatorNamespaceEnv = "POD_NAMESPACE"
+	// serviceAccountNamespaceFile은 환경 변수가 없을 때 네임스페이스를 읽는 ServiceAccount 파일입니다
+	serviceAccountNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+)
+
+// DetectOperatorNamespace는 operator가 실행 중인 네임스페이스를 반환합니다.
+// POD_NAMESPACE 환경 변수를 먼저 보고, 없으면 ServiceAccount의 namespace 파일을 읽습니다.
+// 클러스터 밖에서 실행하는 등 알 수 없으면 빈 문자열을 반환합니다.
+func DetectOperatorNamespace() string {
+	if namespace := strings.TrimSpace(os.Getenv(OperatorNamespaceEnv)); namespace != "" {
+		return namespace
+	}
+	data, err := os.ReadFile(serviceAccountNamespaceFile)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// skipOperatorNamespace는 요청이 operator 자신의 네임스페이스에 있어 처리하지 않아야 하는지 확인합니다.
+// 잘못 붙은 TTL annotation으로 operator가 자신을 삭제하지 않도록 OperatorNamespace는 항상 제외됩니다.
+// 리소스마다 처음 한 번은 Info로, 이후에는 V(1)로 로그를 남깁니다.
+func (r *ResourceReconciler) skipOperatorNamespace(req ctrl.Request, logger logr.Logger) bool {
+	if r.OperatorNamespace == "" || req.Namespace != r.OperatorNamespace {
+		return false
+	}
+	if _, reported := r.operatorNamespaceReported.LoadOrStore(req.NamespacedName.String(), struct{}{}); reported {
+		logger.V(1).Info("Skipping resource in the operator's own namespace", "resource", req.NamespacedName)
+	} else {
+		logger.Info("Skipping resource in the operator's own namespace, TTLs are never applied there "+
+			"(use --allow-operator-namespace to override)", "resource", req.NamespacedName)
+	}
+	return true
+}
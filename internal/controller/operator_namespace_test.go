@@ -0,0 +1,68 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestDetectOperatorNamespaceFromEnv(t *testing.T) {
+	t.Setenv(OperatorNamespaceEnv, " ttl-operator-system\n")
+	NewWithT(t).Expect(DetectOperatorNamespace()).To(Equal("ttl-operator-system"))
+}
+
+func TestSkipOperatorNamespace(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	r := newTestReconciler(t, annotatedPod("uid-1"))
+	r.OperatorNamespace = "default"
+
+	// TTL annotation이 있어도 operator 네임스페이스의 리소스는 TTLResource를 만들지 않음
+	reconcileKey(t, r, "default", "web")
+	var ttlResource ttlv1alpha1.TTLResource
+	err := r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &ttlResource)
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+	// 두 번째부터는 다시 알리지 않음
+	reconcileKey(t, r, "default", "web")
+
+	// --allow-operator-namespace이면 (OperatorNamespace 없음) 다른 네임스페이스와 같이 처리
+	r.OperatorNamespace = ""
+	reconcileKey(t, r, "default", "web")
+	g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &ttlResource)).To(Succeed())
+}
+
+func TestSkipOperatorNamespaceKeepsExpiredOwner(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	r := newTestReconciler(t, ttlResource, pod)
+	r.OperatorNamespace = ttlResource.Namespace
+
+	// 이미 만료된 TTLResource가 있어도 operator 네임스페이스의 owner는 삭제하지 않음
+	reconcileKey(t, r, ttlResource.Namespace, ttlResource.Name)
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+}
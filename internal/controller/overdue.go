@@ -0,0 +1,115 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// OverdueMonitor는 만료 시각이 지났는데 owner가 아직 남아 있는 TTLResource를 주기적으로 세어
+// ttl_resources_overdue 메트릭으로 내보냅니다. 삭제 오류 등으로 멈춘 삭제를 알림으로 잡기 위해 사용합니다.
+type OverdueMonitor struct {
+	// Client는 TTLResource 목록과 owner 조회에 사용합니다
+	Client client.Client
+	// Interval은 집계 주기입니다
+	Interval time.Duration
+	// Clock은 만료 시각과 비교할 현재 시각을 정하는 시계입니다. nil이면 실제 시계를 사용합니다
+	Clock clock.Clock
+}
+
+// Start는 ctx가 끝날 때까지 Interval마다 overdue TTLResource 수를 갱신합니다.
+func (m *OverdueMonitor) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("overdue-monitor")
+
+	ticker := time.NewTicker(m.Interval)
+	defer ticker.Stop()
+	for {
+		overdue, err := m.Count(ctx, clockOrReal(m.Clock).Now())
+		if err != nil {
+			logger.Error(err, "Failed to count overdue TTLResources")
+		} else {
+			ttlResourcesOverdue.Set(float64(overdue))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection은 삭제를 수행하는 리더만 메트릭을 집계하도록 합니다.
+func (m *OverdueMonitor) NeedLeaderElection() bool {
+	return true
+}
+
+// Count는 now 기준으로 만료 시각이 지났지만 owner가 남아 있는 TTLResource 수를 반환합니다.
+// 보존 모드에서 삭제가 끝났거나 삭제 승인을 기다리는 TTLResource는 의도적으로 남아 있으므로 세지 않습니다.
+func (m *OverdueMonitor) Count(ctx context.Context, now time.Time) (int, error) {
+	var list ttlv1alpha1.TTLResourceList
+	if err := m.Client.List(ctx, &list); err != nil {
+		return 0, fmt.Errorf("failed to list TTLResources: %w", err)
+	}
+
+	overdue := 0
+	for i := range list.Items {
+		ttlResource := &list.Items[i]
+		if ttlResource.Status.ExpiredAt == nil || !now.After(ttlResource.Status.ExpiredAt.Time) || retained(ttlResource) {
+			continue
+		}
+		if meta.IsStatusConditionTrue(ttlResource.Status.Conditions, ConditionAwaitingConfirmation) {
+			continue
+		}
+		exists, err := m.ownerExists(ctx, ttlResource)
+		if err != nil {
+			return 0, err
+		}
+		if exists {
+			overdue++
+		}
+	}
+	return overdue, nil
+}
+
+// ownerExists는 TTLResource의 owner 중 하나라도 남아 있는지 확인합니다.
+func (m *OverdueMonitor) ownerExists(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource) (bool, error) {
+	for _, ownerRef := range ownersOf(ttlResource) {
+		owner, _, err := newOwnerObject(ownerRef, ttlResource.Namespace)
+		if err != nil {
+			continue
+		}
+		err = m.Client.Get(ctx, client.ObjectKeyFromObject(owner), owner)
+		if err == nil && !uidMismatch(owner.GetUID(), ownerRef.UID) {
+			return true, nil
+		}
+		if err != nil && !errors.IsNotFound(err) {
+			return false, err
+		}
+	}
+	return false, nil
+}
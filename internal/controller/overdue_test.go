@@ -0,0 +1,113 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func overdueTTLResource(name, owner string, expiredAt time.Time) *ttlv1alpha1.TTLResource {
+	return &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       "default",
+			OwnerReferences: []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: owner}},
+		},
+		Spec:   ttlv1alpha1.TTLResourceSpec{TTLSeconds: 60},
+		Status: ttlv1alpha1.TTLResourceStatus{ExpiredAt: &metav1.Time{Time: expiredAt}},
+	}
+}
+
+func TestOverdueMonitorCount(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Now()
+
+	awaiting := overdueTTLResource("ttl-awaiting", "awaiting", now.Add(-time.Hour))
+	meta.SetStatusCondition(&awaiting.Status.Conditions, metav1.Condition{
+		Type: ConditionAwaitingConfirmation, Status: metav1.ConditionTrue, Reason: "ConfirmationRequired",
+	})
+	deletedAt := metav1.NewTime(now.Add(-time.Minute))
+	retainedResource := overdueTTLResource("ttl-retained", "retained", now.Add(-time.Hour))
+	retainedResource.Status.DeletedAt = &deletedAt
+
+	objs := []client.Object{
+		// 만료되었는데 owner가 남아 있음
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "stuck", Namespace: "default"}},
+		overdueTTLResource("ttl-stuck", "stuck", now.Add(-time.Hour)),
+		// 아직 만료 전
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "fresh", Namespace: "default"}},
+		overdueTTLResource("ttl-fresh", "fresh", now.Add(time.Hour)),
+		// owner가 이미 사라짐
+		overdueTTLResource("ttl-gone", "gone", now.Add(-time.Hour)),
+		// 삭제 승인 대기와 보존 중인 TTLResource는 의도적으로 남아 있음
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "awaiting", Namespace: "default"}},
+		awaiting,
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "retained", Namespace: "default"}},
+		retainedResource,
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(objs...).Build()
+
+	overdue, err := (&OverdueMonitor{Client: c}).Count(context.Background(), now)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(overdue).To(Equal(1))
+}
+
+func TestDeletionFailureKeepsTTLResourceAndIsOverdue(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	scheme := newTestScheme(t)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(pod, ttlResource).
+		WithStatusSubresource(&ttlv1alpha1.TTLResource{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				if _, ok := obj.(*corev1.Pod); ok {
+					return stderrors.New("connection reset by peer")
+				}
+				return c.Delete(ctx, obj, opts...)
+			},
+		}).
+		Build()
+	r := &ResourceReconciler{Client: c, Scheme: scheme}
+
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(Equal(deletionFailedRequeueInterval))
+
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(meta.IsStatusConditionTrue(latest.Status.Conditions, ConditionDeletionFailed)).To(BeTrue())
+
+	overdue, err := (&OverdueMonitor{Client: c}).Count(ctx, time.Now())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(overdue).To(Equal(1))
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+const (
+	// OwnerConditionExpiring은 --owner-conditions가 켜져 있을 때 owner의 status.conditions에 기록하는, TTL 만료가 예정되어 있음을 나타내는 condition입니다
+	OwnerConditionExpiring = "TTLExpiring"
+	// OwnerConditionExpired는 --owner-conditions가 켜져 있을 때 owner의 status.conditions에 기록하는, TTL이 만료되었음을 나타내는 condition입니다
+	OwnerConditionExpired = "TTLExpired"
+)
+
+// ownerConditions는 TTLResource의 만료 상태를 owner에 기록할 TTLExpiring, TTLExpired condition으로 바꿉니다.
+func ownerConditions(ttlResource *ttlv1alpha1.TTLResource) []metav1.Condition {
+	expireAt := expiryTime(ttlResource).UTC().Format(time.RFC3339)
+	if ttlResource.Status.Expired {
+		return []metav1.Condition{
+			{Type: OwnerConditionExpiring, Status: metav1.ConditionFalse, Reason: "Expired", Message: "TTL expired at " + expireAt},
+			{Type: OwnerConditionExpired, Status: metav1.ConditionTrue, Reason: "Expired", Message: "TTL expired at " + expireAt},
+		}
+	}
+	return []metav1.Condition{
+		{Type: OwnerConditionExpiring, Status: metav1.ConditionTrue, Reason: "TTLScheduled", Message: "TTL expires at " + expireAt},
+		{Type: OwnerConditionExpired, Status: metav1.ConditionFalse, Reason: "TTLScheduled", Message: "TTL expires at " + expireAt},
+	}
+}
+
+// syncOwnerConditions는 --owner-conditions가 켜져 있으면 TTLResource의 만료 상태를 삭제 대상 owner의
+// status.conditions에 TTLExpiring/TTLExpired condition으로 기록하여, owner를 watch하는 도구가 반응할 수 있게 합니다.
+// Kind와 관계없이 unstructured로 patch하며, status.conditions 배열이 없는 owner는 건너뜁니다.
+// 연동을 위한 부가 기능이므로 실패해도 로그만 남기고 만료 처리는 계속합니다.
+func (r *ResourceReconciler) syncOwnerConditions(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource, logger logr.Logger) {
+	if !r.OwnerConditions || ttlResource.Status.ExpiredAt == nil {
+		return
+	}
+	owners, err := r.deletionTargets(ttlResource)
+	if err != nil {
+		return
+	}
+	conditions := ownerConditions(ttlResource)
+	for _, ownerRef := range owners {
+		if err := r.setOwnerConditions(ctx, ownerRef, ttlResource.Namespace, conditions); err != nil {
+			logger.Info("Failed to write TTL conditions to owner status", "kind", ownerRef.Kind, "name", ownerRef.Name,
+				"error", err.Error())
+		}
+	}
+}
+
+// setOwnerConditions는 owner의 status.conditions에 conditions를 반영합니다.
+// 같은 type의 condition이 이미 같은 status, reason, message이면 patch하지 않으며, status가 바뀔 때만 lastTransitionTime을 갱신합니다.
+func (r *ResourceReconciler) setOwnerConditions(ctx context.Context, ownerRef metav1.OwnerReference, namespace string,
+	conditions []metav1.Condition) error {
+	gv, err := schema.ParseGroupVersion(ownerRef.APIVersion)
+	if err != nil {
+		return err
+	}
+	owner := &unstructured.Unstructured{}
+	owner.SetGroupVersionKind(gv.WithKind(ownerRef.Kind))
+	if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ownerRef.Name}, owner); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	existing, found, err := unstructured.NestedSlice(owner.Object, "status", "conditions")
+	if err != nil || !found {
+		// status.conditions 배열이 없는 Kind는 condition을 지원하지 않는 것으로 봄
+		return nil
+	}
+
+	patch := client.MergeFromWithOptions(owner.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	changed := false
+	now := r.now().UTC().Format(time.RFC3339)
+	for _, condition := range conditions {
+		index := -1
+		for i, item := range existing {
+			if c, ok := item.(map[string]any); ok && c["type"] == condition.Type {
+				index = i
+				break
+			}
+		}
+		desired := map[string]any{
+			"type":               condition.Type,
+			"status":             string(condition.Status),
+			"reason":             condition.Reason,
+			"message":            condition.Message,
+			"lastTransitionTime": now,
+		}
+		if index < 0 {
+			existing = append(existing, desired)
+			changed = true
+			continue
+		}
+		current := existing[index].(map[string]any)
+		if current["status"] == desired["status"] && current["reason"] == desired["reason"] && current["message"] == desired["message"] {
+			continue
+		}
+		if current["status"] == desired["status"] && current["lastTransitionTime"] != nil {
+			desired["lastTransitionTime"] = current["lastTransitionTime"]
+		}
+		existing[index] = desired
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	if err := unstructured.SetNestedSlice(owner.Object, existing, "status", "conditions"); err != nil {
+		return err
+	}
+	if err := r.Status().Patch(ctx, owner, patch); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+var widgetGVK = schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}
+
+func newWidget(conditions []any) *unstructured.Unstructured {
+	widget := &unstructured.Unstructured{Object: map[string]any{}}
+	widget.SetGroupVersionKind(widgetGVK)
+	widget.SetName("web")
+	widget.SetNamespace("default")
+	if conditions != nil {
+		widget.Object["status"] = map[string]any{"conditions": conditions}
+	}
+	return widget
+}
+
+func ownerConditionTestReconciler(t *testing.T, objs ...client.Object) *ResourceReconciler {
+	scheme := newTestScheme(t)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&ttlv1alpha1.TTLResource{}, newWidget(nil)).
+		Build()
+	return &ResourceReconciler{Client: c, Scheme: scheme, OwnerConditions: true}
+}
+
+func widgetConditions(t *testing.T, r *ResourceReconciler) map[string]map[string]any {
+	widget := newWidget(nil)
+	NewWithT(t).Expect(r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "web"}, widget)).To(Succeed())
+	items, _, _ := unstructured.NestedSlice(widget.Object, "status", "conditions")
+	conditions := map[string]map[string]any{}
+	for _, item := range items {
+		c := item.(map[string]any)
+		conditions[c["type"].(string)] = c
+	}
+	return conditions
+}
+
+func TestOwnerConditionsAreWrittenToOwnerStatus(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	ttlResource := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "ttl-web",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Minute)),
+		},
+		Spec: ttlv1alpha1.TTLResourceSpec{
+			TTLSeconds: 3600,
+			TargetRef:  &ttlv1alpha1.TargetReference{APIVersion: "example.com/v1", Kind: "Widget", Name: "web"},
+		},
+	}
+	existing := map[string]any{"type": "Ready", "status": "True", "reason": "Running"}
+	r := ownerConditionTestReconciler(t, ttlResource, newWidget([]any{existing}))
+
+	// 만료 전에는 TTLExpiring=True, 기존 condition은 그대로
+	reconcileKey(t, r, "default", "ttl-web")
+	conditions := widgetConditions(t, r)
+	g.Expect(conditions).To(HaveKey("Ready"))
+	g.Expect(conditions[OwnerConditionExpiring]).To(HaveKeyWithValue("status", "True"))
+	g.Expect(conditions[OwnerConditionExpired]).To(HaveKeyWithValue("status", "False"))
+
+	// 만료 시각이 지나면 TTLExpired=True
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	latest.Status.Expired = true
+	latest.Status.ExpiredAt = &metav1.Time{Time: time.Now().Add(-time.Second).Truncate(time.Second)}
+	r.syncOwnerConditions(ctx, &latest, logr.Discard())
+	conditions = widgetConditions(t, r)
+	g.Expect(conditions[OwnerConditionExpiring]).To(HaveKeyWithValue("status", "False"))
+	g.Expect(conditions[OwnerConditionExpired]).To(HaveKeyWithValue("status", "True"))
+	g.Expect(conditions[OwnerConditionExpired]).To(HaveKeyWithValue("reason", "Expired"))
+}
+
+func TestOwnerConditionsSkipOwnersWithoutConditions(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	r := ownerConditionTestReconciler(t, configMap, newWidget(nil))
+
+	for _, ownerRef := range []metav1.OwnerReference{
+		{APIVersion: "v1", Kind: "ConfigMap", Name: "web"},
+		{APIVersion: "example.com/v1", Kind: "Widget", Name: "web"},
+	} {
+		g.Expect(r.setOwnerConditions(ctx, ownerRef, "default", []metav1.Condition{
+			{Type: OwnerConditionExpiring, Status: metav1.ConditionTrue, Reason: "TTLScheduled"},
+		})).To(Succeed())
+	}
+	g.Expect(widgetConditions(t, r)).To(BeEmpty())
+}
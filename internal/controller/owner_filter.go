@@ -0,0 +1,78 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// skippedController는 Pod의 controller owner가 SkipPodsControlledBy에 있는 Kind이면 그 Kind를 반환합니다.
+// 컨트롤러가 수명 주기를 관리하는 Pod를 TTL로 중복 관리하지 않기 위해 사용합니다.
+// Pod가 아니거나 controller owner가 없으면(bare Pod) 빈 문자열을 반환합니다.
+func (r *ResourceReconciler) skippedController(obj client.Object) string {
+	if _, ok := obj.(*corev1.Pod); !ok || len(r.SkipPodsControlledBy) == 0 {
+		return ""
+	}
+	ref := metav1.GetControllerOf(obj)
+	if ref == nil || !slices.Contains(r.SkipPodsControlledBy, ref.Kind) {
+		return ""
+	}
+	return ref.Kind
+}
+
+// namespaceExcluded는 네임스페이스가 ExcludedNamespaces에 있어 TTL 삭제가 꺼져 있는지 확인합니다.
+func (r *ResourceReconciler) namespaceExcluded(namespace string) bool {
+	return slices.Contains(r.ExcludedNamespaces, namespace)
+}
+
+// ParseExcludeAnnotations는 --exclude-annotations 항목("key" 또는 "key=value")을 파싱합니다.
+// 값이 빈 문자열이면 annotation이 있기만 하면 일치합니다.
+func ParseExcludeAnnotations(items []string) (map[string]string, error) {
+	matchers := map[string]string{}
+	for _, item := range items {
+		key, value, _ := strings.Cut(item, "=")
+		key = strings.TrimSpace(key)
+		if key == "" {
+			return nil, fmt.Errorf("invalid annotation matcher %q: expected key or key=value", item)
+		}
+		matchers[key] = strings.TrimSpace(value)
+	}
+	return matchers, nil
+}
+
+// excludedResource는 리소스가 ExcludeSelector나 ExcludeAnnotations에 일치하여 TTL 대상에서 제외되는지 확인하고,
+// 일치한 조건을 반환합니다. 일치하지 않으면 빈 문자열을 반환합니다.
+func (r *ResourceReconciler) excludedResource(obj client.Object) string {
+	if r.ExcludeSelector != nil && !r.ExcludeSelector.Empty() && r.ExcludeSelector.Matches(labels.Set(obj.GetLabels())) {
+		return "labels match " + r.ExcludeSelector.String()
+	}
+	annotations := obj.GetAnnotations()
+	for key, value := range r.ExcludeAnnotations {
+		actual, ok := annotations[key]
+		if ok && (value == "" || actual == value) {
+			return "annotation " + key + " matches"
+		}
+	}
+	return ""
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestSkipPodsControlledBy(t *testing.T) {
+	g := NewWithT(t)
+
+	for _, tc := range []struct {
+		name    string
+		owners  []metav1.OwnerReference
+		managed bool
+	}{
+		{name: "bare", managed: true},
+		{name: "from-replicaset", owners: []metav1.OwnerReference{
+			{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "web-abc", Controller: ptr.To(true)},
+		}},
+		{name: "from-statefulset", managed: true, owners: []metav1.OwnerReference{
+			{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "db", Controller: ptr.To(true)},
+		}},
+		// controller가 아닌 owner는 수명 주기를 관리하지 않으므로 제외하지 않음
+		{name: "non-controller-owner", managed: true, owners: []metav1.OwnerReference{
+			{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "web-abc"},
+		}},
+	} {
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:            tc.name,
+			Namespace:       "default",
+			Annotations:     map[string]string{TTLAnnotationKey: "3600"},
+			OwnerReferences: tc.owners,
+		}}
+		r := newTestReconciler(t, pod)
+		r.SkipPodsControlledBy = []string{"ReplicaSet", "Job"}
+
+		reconcileKey(t, r, "default", tc.name)
+
+		err := r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-" + tc.name}, &ttlv1alpha1.TTLResource{})
+		if tc.managed {
+			g.Expect(err).NotTo(HaveOccurred(), "%s should get a TTLResource", tc.name)
+		} else {
+			g.Expect(errors.IsNotFound(err)).To(BeTrue(), "%s should be skipped", tc.name)
+		}
+	}
+}
+
+func TestExcludedNamespacesAreIgnored(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		Namespace:   "default",
+		Annotations: map[string]string{TTLAnnotationKey: "3600"},
+	}}
+	r := newTestReconciler(t, pod)
+	r.ExcludedNamespaces = []string{"default"}
+
+	reconcileKey(t, r, "default", "web")
+	err := r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &ttlv1alpha1.TTLResource{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue(), "no TTLResource should be created in an excluded namespace")
+
+	// 이미 만료된 TTLResource가 있어도 owner를 삭제하지 않음
+	expiredPod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	r = newTestReconciler(t, expiredPod, ttlResource)
+	r.ExcludedNamespaces = []string{"default"}
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(expiredPod), &corev1.Pod{})).To(Succeed())
+}
+
+func TestExcludedResourcesGetNoTTLResource(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	platform := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "ingress",
+		Namespace:   "team-a",
+		Labels:      map[string]string{"app.kubernetes.io/part-of": "platform"},
+		Annotations: map[string]string{TTLAnnotationKey: "60"},
+	}}
+	protected := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "db",
+		Namespace:   "team-b",
+		Annotations: map[string]string{TTLAnnotationKey: "60", "example.com/critical": "yes"},
+	}}
+	app := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		Namespace:   "team-a",
+		Labels:      map[string]string{"app.kubernetes.io/part-of": "shop"},
+		Annotations: map[string]string{TTLAnnotationKey: "60"},
+	}}
+	existing := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{Name: "ttl-ingress", Namespace: "team-a", Labels: map[string]string{TTLResourceLabelKey: TTLResourceLabelValue}},
+		Spec:       ttlv1alpha1.TTLResourceSpec{TTLSeconds: 60},
+	}
+	r := newTestReconciler(t, platform, protected, app, existing)
+	selector, err := labels.Parse("app.kubernetes.io/part-of=platform")
+	g.Expect(err).NotTo(HaveOccurred())
+	r.ExcludeSelector = selector
+	r.ExcludeAnnotations, err = ParseExcludeAnnotations([]string{"example.com/critical"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	reconcileKey(t, r, "team-a", "ingress")
+	reconcileKey(t, r, "team-b", "db")
+	reconcileKey(t, r, "team-a", "web")
+
+	// 제외된 리소스는 TTLResource가 없고 이미 있던 것도 정리됨
+	err = r.Get(ctx, client.ObjectKey{Namespace: "team-a", Name: "ttl-ingress"}, &ttlv1alpha1.TTLResource{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+	err = r.Get(ctx, client.ObjectKey{Namespace: "team-b", Name: "ttl-db"}, &ttlv1alpha1.TTLResource{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+	g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "team-a", Name: "ttl-web"}, &ttlv1alpha1.TTLResource{})).To(Succeed())
+}
+
+func TestParseExcludeAnnotations(t *testing.T) {
+	g := NewWithT(t)
+
+	matchers, err := ParseExcludeAnnotations([]string{"example.com/critical", "tier = core"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(matchers).To(Equal(map[string]string{"example.com/critical": "", "tier": "core"}))
+
+	_, err = ParseExcludeAnnotations([]string{"=core"})
+	g.Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// ttlOwnerReference는 resource 컨트롤러가 TTLResource에 붙이는 owner 리소스의 OwnerReference입니다.
+// 다른 컨트롤러가 붙인 OwnerReference와 함께 있을 수 있도록 controller 필드는 설정하지 않습니다.
+func ttlOwnerReference(obj client.Object, gvk schema.GroupVersionKind) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Name:       obj.GetName(),
+		UID:        obj.GetUID(),
+	}
+}
+
+// ensureOwnerReference는 TTLResource에 ref가 없으면 기존 OwnerReference 뒤에 덧붙이고 true를 반환합니다.
+// 다른 컨트롤러가 붙인 OwnerReference는 바꾸거나 지우지 않습니다.
+func ensureOwnerReference(ttlResource *ttlv1alpha1.TTLResource, ref metav1.OwnerReference) bool {
+	for _, existing := range ttlResource.OwnerReferences {
+		if sameTarget(existing, ref) && !uidMismatch(existing.UID, ref.UID) {
+			return false
+		}
+	}
+	ttlResource.OwnerReferences = append(ttlResource.OwnerReferences, ref)
+	return true
+}
+
+// managedOwners는 resource 컨트롤러가 만든 TTLResource의 owner 중 TTL annotation을 가진 리소스
+// (이름 템플릿으로 이 TTLResource 이름이 나오는 owner, 기본값은 "ttl-<name>"의 <name>)만 반환합니다.
+// 다른 컨트롤러가 같은 TTLResource에 OwnerReference를 덧붙여도 만료 시 그 리소스까지 삭제하지 않기 위해 사용합니다.
+// 직접 작성한 TTLResource는 owners를 그대로 반환하고, 컨트롤러가 만든 TTLResource에 해당하는 owner가 없으면
+// (자신의 OwnerReference가 지워지고 다른 컨트롤러의 것만 남은 경우) 다른 컨트롤러의 리소스를 지우지 않도록 nil을 반환합니다.
+func (r *ResourceReconciler) managedOwners(ttlResource *ttlv1alpha1.TTLResource, owners []metav1.OwnerReference) []metav1.OwnerReference {
+	if ttlResource.Labels[TTLResourceLabelKey] != TTLResourceLabelValue {
+		return owners
+	}
+	managed := slices.DeleteFunc(slices.Clone(owners), func(owner metav1.OwnerReference) bool {
+		_, supported := supportedKinds[owner.Kind]
+		return !r.TTLResourceNamer.ownsTTLResource(ttlResource, owner) || !supported
+	})
+	if len(managed) == 0 {
+		return nil
+	}
+	return managed
+}
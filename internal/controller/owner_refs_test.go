@@ -0,0 +1,147 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// foreignOwnerRef는 다른 operator가 TTLResource에 덧붙인 OwnerReference입니다
+var foreignOwnerRef = metav1.OwnerReference{APIVersion: "v1", Kind: "ConfigMap", Name: "other-operator-state", UID: "cm-uid"}
+
+func TestOwnerReferenceIsAppendedNextToForeignOwners(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod := annotatedPod("uid-1")
+	// 다른 operator가 OwnerReference를 덮어써 우리 owner가 빠진 TTLResource
+	ttlResource := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "ttl-web",
+			Namespace:       "default",
+			Labels:          map[string]string{TTLResourceLabelKey: TTLResourceLabelValue},
+			Annotations:     map[string]string{TTLSourceAnnotationKey: TTLSourceAnnotation},
+			OwnerReferences: []metav1.OwnerReference{foreignOwnerRef},
+		},
+		Spec: ttlv1alpha1.TTLResourceSpec{TTLSeconds: 60},
+	}
+	r := newTestReconciler(t, pod, ttlResource)
+
+	reconcileKey(t, r, "default", "web")
+	reconcileKey(t, r, "default", "web")
+
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(latest.OwnerReferences).To(HaveLen(2))
+	g.Expect(latest.OwnerReferences[0]).To(Equal(foreignOwnerRef))
+	g.Expect(latest.OwnerReferences[1]).To(Equal(metav1.OwnerReference{APIVersion: "v1", Kind: "Pod", Name: "web", UID: "uid-1"}))
+}
+
+func TestExpiryDeletesOnlyManagedOwnerWithMultipleOwnerReferences(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	foreign := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: foreignOwnerRef.Name, Namespace: "default", UID: foreignOwnerRef.UID}}
+	ttlResource.Labels = map[string]string{TTLResourceLabelKey: TTLResourceLabelValue}
+	ttlResource.OwnerReferences = append(ttlResource.OwnerReferences, foreignOwnerRef)
+	r := newTestReconciler(t, pod, foreign, ttlResource)
+
+	reconcileKey(t, r, "default", "ttl-web")
+
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{}))).To(BeTrue())
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(foreign), &corev1.ConfigMap{})).To(Succeed(),
+		"owners added by other controllers must not be deleted on expiry")
+}
+
+func TestExpiryKeepsForeignOwnerWhenManagedOwnerReferenceIsGone(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	_, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	foreign := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: foreignOwnerRef.Name, Namespace: "default", UID: foreignOwnerRef.UID}}
+	ttlResource.Labels = map[string]string{TTLResourceLabelKey: TTLResourceLabelValue}
+	// 다른 컨트롤러가 OwnerReference를 덮어써 우리 owner가 빠짐
+	ttlResource.OwnerReferences = []metav1.OwnerReference{foreignOwnerRef}
+	r := newTestReconciler(t, foreign, ttlResource)
+
+	reconcileKey(t, r, "default", "ttl-web")
+
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(foreign), &corev1.ConfigMap{})).To(Succeed(),
+		"owners added by other controllers must not be deleted on expiry")
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(meta.IsStatusConditionTrue(latest.Status.Conditions, ConditionNoManagedOwner)).To(BeTrue())
+}
+
+func TestOutOfBandOwnerDeletionWithForeignOwner(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod := annotatedPod("uid-1")
+	ttlResource := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "ttl-web",
+			Namespace:   "default",
+			Labels:      map[string]string{TTLResourceLabelKey: TTLResourceLabelValue},
+			Annotations: map[string]string{TTLSourceAnnotationKey: TTLSourceAnnotation},
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "v1", Kind: "Pod", Name: "web", UID: "uid-1"},
+				foreignOwnerRef,
+			},
+		},
+		Spec: ttlv1alpha1.TTLResourceSpec{TTLSeconds: 3600},
+	}
+	r := newTestReconciler(t, pod, ttlResource)
+
+	// 우리 owner가 남아 있으면 TTLResource를 유지
+	reconcileKey(t, r, "default", "web")
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &ttlv1alpha1.TTLResource{})).To(Succeed())
+
+	// GC는 다른 owner가 남아 있어 TTLResource를 지우지 않지만, 우리 owner가 사라졌으므로 직접 정리
+	g.Expect(r.Delete(ctx, pod)).To(Succeed())
+	reconcileKey(t, r, "default", "web")
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &ttlv1alpha1.TTLResource{}))).To(BeTrue())
+}
+
+func TestManagedOwners(t *testing.T) {
+	g := NewWithT(t)
+
+	r := &ResourceReconciler{}
+	podRef := metav1.OwnerReference{APIVersion: "v1", Kind: "Pod", Name: "web"}
+	managed := &ttlv1alpha1.TTLResource{ObjectMeta: metav1.ObjectMeta{
+		Name:   "ttl-web",
+		Labels: map[string]string{TTLResourceLabelKey: TTLResourceLabelValue},
+	}}
+	g.Expect(r.managedOwners(managed, []metav1.OwnerReference{foreignOwnerRef, podRef})).To(Equal([]metav1.OwnerReference{podRef}))
+	// 해당하는 owner가 없으면 다른 컨트롤러의 리소스를 지우지 않도록 삭제 대상 없음
+	g.Expect(r.managedOwners(managed, []metav1.OwnerReference{foreignOwnerRef})).To(BeEmpty())
+
+	// 직접 작성한 TTLResource는 모든 owner가 삭제 대상
+	handWritten := &ttlv1alpha1.TTLResource{ObjectMeta: metav1.ObjectMeta{Name: "ttl-web"}}
+	g.Expect(r.managedOwners(handWritten, []metav1.OwnerReference{foreignOwnerRef, podRef})).To(HaveLen(2))
+}
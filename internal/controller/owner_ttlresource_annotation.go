@@ -0,0 +1,79 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// OwnerTTLResourceAnnotationKey는 owner를 관리하는 TTLResource 이름을 나타내는 annotation 키입니다.
+// --annotate-owners가 켜져 있을 때 operator가 채우는 읽기 전용 값입니다
+const OwnerTTLResourceAnnotationKey = "ttl.example.com/ttlresource"
+
+// annotateOwner는 resource 컨트롤러가 만든 TTLResource의 이름을 owner annotation에 기록합니다.
+// managed-by label이 없는(사용자가 직접 만든) TTLResource는 가리키지 않으며, 값이 같으면 patch하지 않습니다.
+func (r *ResourceReconciler) annotateOwner(ctx context.Context, obj client.Object, ttlResource *ttlv1alpha1.TTLResource, logger logr.Logger) error {
+	if !r.AnnotateOwners || ttlResource.Labels[TTLResourceLabelKey] != TTLResourceLabelValue {
+		return nil
+	}
+	if obj.GetAnnotations()[OwnerTTLResourceAnnotationKey] == ttlResource.Name {
+		return nil
+	}
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[OwnerTTLResourceAnnotationKey] = ttlResource.Name
+	obj.SetAnnotations(annotations)
+	if err := r.Patch(ctx, obj, patch); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	logger.V(1).Info("Annotated owner with its TTLResource", "resource", obj.GetName(), "ttlResource", ttlResource.Name)
+	return nil
+}
+
+// unannotateOwner는 TTLResource를 정리할 때 owner에 남은 TTLResource annotation을 제거합니다.
+// 기능을 끈 뒤에도 이전에 남긴 annotation이 가리키는 TTLResource가 없어지므로 설정과 관계없이 제거합니다.
+func (r *ResourceReconciler) unannotateOwner(ctx context.Context, obj client.Object, logger logr.Logger) error {
+	if _, ok := obj.GetAnnotations()[OwnerTTLResourceAnnotationKey]; !ok {
+		return nil
+	}
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	annotations := obj.GetAnnotations()
+	delete(annotations, OwnerTTLResourceAnnotationKey)
+	obj.SetAnnotations(annotations)
+	if err := r.Patch(ctx, obj, patch); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	logger.V(1).Info("Removed TTLResource annotation from owner", "resource", obj.GetName())
+	return nil
+}
+
+// cleanupOwnerTTLResource는 owner가 남아 있는 상태에서 TTLResource를 정리하고 owner의 TTLResource annotation도 제거합니다.
+func (r *ResourceReconciler) cleanupOwnerTTLResource(ctx context.Context, obj client.Object, logger logr.Logger) (ctrl.Result, error) {
+	if result, err := r.cleanupTTLResource(ctx, client.ObjectKeyFromObject(obj)); err != nil {
+		return result, err
+	}
+	return ctrl.Result{}, r.unannotateOwner(ctx, obj, logger)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestAnnotateOwnersAddsAndRemovesTTLResourceAnnotation(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		Namespace:   "default",
+		Annotations: map[string]string{TTLAnnotationKey: "3600"},
+	}}
+	r := newTestReconciler(t, pod)
+	r.AnnotateOwners = true
+
+	reconcileKey(t, r, "default", "web")
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+	g.Expect(pod.Annotations).To(HaveKeyWithValue(OwnerTTLResourceAnnotationKey, "ttl-web"))
+
+	// TTL annotation을 지우면 TTLResource와 함께 owner annotation도 제거
+	delete(pod.Annotations, TTLAnnotationKey)
+	g.Expect(r.Update(ctx, pod)).To(Succeed())
+	reconcileKey(t, r, "default", "web")
+
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+	g.Expect(pod.Annotations).NotTo(HaveKey(OwnerTTLResourceAnnotationKey))
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &ttlv1alpha1.TTLResource{}))).To(BeTrue())
+}
+
+func TestAnnotateOwnersBackfillsExistingTTLResource(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		Namespace:   "default",
+		Annotations: map[string]string{TTLAnnotationKey: "3600"},
+	}}
+	r := newTestReconciler(t, pod)
+
+	// 기능을 켜기 전에 만든 TTLResource도 다음 reconcile에서 annotation을 남김
+	reconcileKey(t, r, "default", "web")
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+	g.Expect(pod.Annotations).NotTo(HaveKey(OwnerTTLResourceAnnotationKey))
+
+	r.AnnotateOwners = true
+	reconcileKey(t, r, "default", "web")
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+	g.Expect(pod.Annotations).To(HaveKeyWithValue(OwnerTTLResourceAnnotationKey, "ttl-web"))
+}
+
+func TestAnnotateOwnersSkipsUnmanagedTTLResource(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		Namespace:   "default",
+		Annotations: map[string]string{TTLAnnotationKey: "3600"},
+	}}
+	// managed-by label이 없는 TTLResource는 사용자가 직접 만든 것이므로 owner에서 가리키지 않음
+	handWritten := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{Name: "ttl-web", Namespace: "default"},
+		Spec:       ttlv1alpha1.TTLResourceSpec{TTLSeconds: 3600},
+	}
+	r := newTestReconciler(t, pod, handWritten)
+	r.AnnotateOwners = true
+
+	reconcileKey(t, r, "default", "web")
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+	g.Expect(pod.Annotations).NotTo(HaveKey(OwnerTTLResourceAnnotationKey))
+}
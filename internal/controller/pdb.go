@@ -0,0 +1,61 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// pdbRequeueInterval은 PodDisruptionBudget 때문에 막힌 삭제를 다시 시도하는 간격입니다
+const pdbRequeueInterval = 10 * time.Second
+
+// errBlockedByPDB는 Eviction이 PodDisruptionBudget에 의해 거부되었음을 나타냅니다
+var errBlockedByPDB = stderrors.New("eviction blocked by PodDisruptionBudget")
+
+// evictPod는 Pod를 직접 삭제하는 대신 Eviction API로 내보냅니다.
+// API 서버가 해당 Pod에 적용되는 PodDisruptionBudget을 확인하며, 예산을 초과하면 429를 반환합니다.
+// gracePeriod가 nil이면 Pod에 설정된 유예 시간을 사용합니다.
+func (r *ResourceReconciler) evictPod(ctx context.Context, name, namespace string, gracePeriod *int64) error {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	eviction := &policyv1.Eviction{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	if gracePeriod != nil {
+		eviction.DeleteOptions = &metav1.DeleteOptions{GracePeriodSeconds: gracePeriod}
+	}
+	release, err := r.DeletionLimiter.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+	if err := r.SubResource("eviction").Create(ctx, pod, eviction); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		if errors.IsTooManyRequests(err) {
+			return fmt.Errorf("pod %s/%s: %w", namespace, name, errBlockedByPDB)
+		}
+		return fmt.Errorf("failed to evict pod %s/%s: %w", namespace, name, err)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// ttlSecondsCeil은 TTL을 spec.ttlSeconds로 쓸 정수 초로 올림합니다 (1초 미만은 1초).
+func ttlSecondsCeil(ttl time.Duration) int {
+	return int((ttl + time.Second - 1) / time.Second)
+}
+
+// preciseTTL은 초 단위로 나누어떨어지지 않는 TTL이면 spec.ttl에 기록할 값을, 아니면 nil을 반환합니다.
+// 정수 초 TTL은 지금처럼 spec.ttlSeconds만 사용합니다.
+func preciseTTL(ttl time.Duration) *metav1.Duration {
+	if ttl%time.Second == 0 {
+		return nil
+	}
+	return &metav1.Duration{Duration: ttl}
+}
+
+// ttlDuration은 spec의 유효 TTL입니다. spec.ttl이 있으면 그 값을, 없으면 spec.ttlSeconds를 사용합니다.
+func ttlDuration(spec ttlv1alpha1.TTLResourceSpec) time.Duration {
+	if spec.TTL != nil && spec.TTL.Duration > 0 {
+		return spec.TTL.Duration
+	}
+	return time.Duration(spec.TTLSeconds) * time.Second
+}
+
+// expiryTime은 TTLResource의 만료 시각입니다. status.expiredAt은 초 단위로 저장되므로
+// spec.ttl이 있으면 status.createdAt에 TTL과 jitter를 더해 ms 단위까지 다시 계산합니다.
+// 다시 계산한 시각이 status.expiredAt과 다른 초이면 (quota pressure 등으로 바뀐 경우) status.expiredAt을 따릅니다.
+func expiryTime(ttlResource *ttlv1alpha1.TTLResource) time.Time {
+	jitter := time.Duration(ttlResource.Status.JitterSeconds) * time.Second
+	computed := ttlResource.Status.CreatedAt.Add(ttlDuration(ttlResource.Spec) + jitter)
+	if ttlResource.Status.ExpiredAt == nil {
+		return computed
+	}
+	stored := ttlResource.Status.ExpiredAt.Time
+	if ttlResource.Spec.TTL == nil || !computed.Truncate(time.Second).Equal(stored.Truncate(time.Second)) {
+		return stored
+	}
+	return computed
+}
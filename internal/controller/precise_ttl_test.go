@@ -0,0 +1,115 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestParseTTL(t *testing.T) {
+	g := NewWithT(t)
+
+	for value, expected := range map[string]time.Duration{
+		"60":    time.Minute,
+		"0.5":   500 * time.Millisecond,
+		"1.25":  1250 * time.Millisecond,
+		".001":  time.Millisecond,
+		"2.000": 2 * time.Second,
+		"30m":   30 * time.Minute,
+		"2h":    2 * time.Hour,
+		"7d":    7 * 24 * time.Hour,
+		"1w":    7 * 24 * time.Hour,
+		"1d12h": 36 * time.Hour,
+		"1.5d":  36 * time.Hour,
+		"500ms": 500 * time.Millisecond,
+	} {
+		ttl, err := parseTTL(value)
+		g.Expect(err).NotTo(HaveOccurred(), value)
+		g.Expect(ttl).To(Equal(expected), value)
+	}
+	for _, value := range []string{"0", "0.0", "0.0001", "-0.5", "-1h", "0s", "7x", "d", "1e3", "abc"} {
+		_, err := parseTTL(value)
+		g.Expect(err).To(HaveOccurred(), value)
+	}
+}
+
+func TestSubSecondTTLExpiresOnTime(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	pod := annotatedPod("uid-1")
+	pod.Annotations[TTLAnnotationKey] = "0.5"
+	r := newClockedTestReconciler(t, fakeClock, pod)
+	key := client.ObjectKey{Namespace: "default", Name: "ttl-web"}
+
+	reconcileKey(t, r, "default", "web")
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, key, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.Spec.TTLSeconds).To(Equal(1))
+	g.Expect(ttlResource.Spec.TTL).NotTo(BeNil())
+	g.Expect(ttlResource.Spec.TTL.Duration).To(Equal(500 * time.Millisecond))
+
+	// 초 단위로 올림하지 않고 500ms 뒤에 다시 확인
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(Equal(500 * time.Millisecond))
+
+	fakeClock.Step(300 * time.Millisecond)
+	result = reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(Equal(200 * time.Millisecond))
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+
+	fakeClock.Step(200 * time.Millisecond)
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{}))).To(BeTrue())
+}
+
+func TestIntegerTTLDoesNotSetPreciseTTL(t *testing.T) {
+	g := NewWithT(t)
+
+	r := newTestReconciler(t, annotatedPod("uid-1"))
+	reconcileKey(t, r, "default", "web")
+
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.Spec.TTLSeconds).To(Equal(60))
+	g.Expect(ttlResource.Spec.TTL).To(BeNil())
+}
+
+func TestDurationTTLIsConvertedToSeconds(t *testing.T) {
+	g := NewWithT(t)
+
+	pod := annotatedPod("uid-1")
+	pod.Annotations[TTLAnnotationKey] = "7d"
+	r := newTestReconciler(t, pod)
+	reconcileKey(t, r, "default", "web")
+
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.Spec.TTLSeconds).To(Equal(7 * 24 * 60 * 60))
+	g.Expect(ttlResource.Spec.TTL).To(BeNil())
+}
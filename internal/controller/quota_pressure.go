@@ -0,0 +1,184 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// ConditionExpiredEarly는 네임스페이스의 ResourceQuota 사용량 때문에 TTL보다 일찍 만료되었음을 나타냅니다
+const ConditionExpiredEarly = "ExpiredEarly"
+
+// DefaultQuotaPressureMaxPerCycle은 한 번의 주기에서 네임스페이스마다 일찍 만료시키는 TTLResource 수의 기본값입니다
+const DefaultQuotaPressureMaxPerCycle = 5
+
+// QuotaPressureReclaimer는 ResourceQuota 사용량이 Threshold 이상인 네임스페이스에서
+// 가장 오래된 TTLResource부터 만료 시각을 지금으로 앞당겨 리소스를 일찍 정리합니다.
+// 고정된 타이머가 아니라 실제 리소스 압박에 따라 정리하기 위한 기능으로, Threshold를 지정해야만 동작합니다.
+type QuotaPressureReclaimer struct {
+	// Client는 ResourceQuota와 TTLResource 조회, status 업데이트에 사용합니다
+	Client client.Client
+	// Threshold는 ResourceQuota의 hard 대비 used 비율(0~1)입니다. 어느 항목이든 이 값 이상이면 압박으로 봅니다
+	Threshold float64
+	// Interval은 확인 주기입니다
+	Interval time.Duration
+	// MaxPerCycle은 한 번의 주기에서 네임스페이스마다 일찍 만료시키는 최대 TTLResource 수입니다.
+	// 0 이하이면 DefaultQuotaPressureMaxPerCycle을 사용합니다
+	MaxPerCycle int
+	// StatusViaUpdate가 true이면 status subresource가 없는 CRD에서 status를 일반 update로 기록합니다
+	StatusViaUpdate bool
+	// Clock은 일찍 만료시킬 시각을 정하는 시계입니다. nil이면 실제 시계를 사용합니다
+	Clock clock.Clock
+}
+
+// Start는 ctx가 끝날 때까지 Interval마다 압박을 받는 네임스페이스의 TTLResource를 일찍 만료시킵니다.
+func (q *QuotaPressureReclaimer) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("quota-pressure")
+
+	ticker := time.NewTicker(q.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		expired, err := q.Run(ctx, clockOrReal(q.Clock).Now())
+		if err != nil {
+			logger.Error(err, "Quota pressure check failed")
+		} else if expired > 0 {
+			logger.Info("Expired TTLResources early due to quota pressure", "count", expired)
+		}
+	}
+}
+
+// NeedLeaderElection은 리더만 TTLResource를 일찍 만료시키도록 합니다.
+func (q *QuotaPressureReclaimer) NeedLeaderElection() bool {
+	return true
+}
+
+// Run은 압박을 받는 네임스페이스마다 가장 오래된 TTLResource를 최대 MaxPerCycle개까지 일찍 만료시키고, 만료시킨 개수를 반환합니다.
+func (q *QuotaPressureReclaimer) Run(ctx context.Context, now time.Time) (int, error) {
+	logger := logf.FromContext(ctx).WithName("quota-pressure")
+
+	namespaces, err := q.pressuredNamespaces(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for namespace, reason := range namespaces {
+		n, err := q.expireOldest(ctx, namespace, reason, now, logger)
+		expired += n
+		if err != nil {
+			// 한 네임스페이스의 실패로 나머지를 멈추지 않음
+			logger.Error(err, "Failed to relieve quota pressure", "namespace", namespace)
+		}
+	}
+	return expired, nil
+}
+
+// pressuredNamespaces는 ResourceQuota 사용량이 Threshold 이상인 네임스페이스와 그 사유를 반환합니다.
+func (q *QuotaPressureReclaimer) pressuredNamespaces(ctx context.Context) (map[string]string, error) {
+	var quotas corev1.ResourceQuotaList
+	if err := q.Client.List(ctx, &quotas); err != nil {
+		return nil, fmt.Errorf("failed to list ResourceQuotas: %w", err)
+	}
+
+	namespaces := map[string]string{}
+	for _, quota := range quotas.Items {
+		for name, hard := range quota.Status.Hard {
+			used, ok := quota.Status.Used[name]
+			if !ok || hard.IsZero() {
+				continue
+			}
+			ratio := used.AsApproximateFloat64() / hard.AsApproximateFloat64()
+			if ratio >= q.Threshold {
+				namespaces[quota.Namespace] = fmt.Sprintf("ResourceQuota %s: %s used %s of %s",
+					quota.Name, name, used.String(), hard.String())
+				break
+			}
+		}
+	}
+	return namespaces, nil
+}
+
+// expireOldest는 네임스페이스의 만료 전 TTLResource를 생성 시각이 오래된 순서로 최대 MaxPerCycle개까지 일찍 만료시킵니다.
+func (q *QuotaPressureReclaimer) expireOldest(ctx context.Context, namespace, reason string, now time.Time, logger logr.Logger) (int, error) {
+	var list ttlv1alpha1.TTLResourceList
+	if err := q.Client.List(ctx, &list, client.InNamespace(namespace),
+		client.MatchingLabels{TTLResourceLabelKey: TTLResourceLabelValue}); err != nil {
+		return 0, fmt.Errorf("failed to list TTLResources: %w", err)
+	}
+
+	var candidates []*ttlv1alpha1.TTLResource
+	for i := range list.Items {
+		ttlResource := &list.Items[i]
+		// TTLSeconds 0은 삭제하지 않음을 뜻하므로 압박이 있어도 건드리지 않음
+		if ttlResource.Spec.TTLSeconds == 0 || ttlResource.Status.Expired || ttlResource.Status.ExpiredAt == nil ||
+			!ttlResource.Status.ExpiredAt.After(now) || ttlResource.DeletionTimestamp != nil {
+			continue
+		}
+		candidates = append(candidates, ttlResource)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Status.CreatedAt.Before(&candidates[j].Status.CreatedAt)
+	})
+
+	limit := q.MaxPerCycle
+	if limit <= 0 {
+		limit = DefaultQuotaPressureMaxPerCycle
+	}
+	expired := 0
+	for _, ttlResource := range candidates[:min(limit, len(candidates))] {
+		ttlResource.Status.ExpiredAt = &metav1.Time{Time: now}
+		meta.SetStatusCondition(&ttlResource.Status.Conditions, metav1.Condition{
+			Type:               ConditionExpiredEarly,
+			Status:             metav1.ConditionTrue,
+			Reason:             "QuotaPressure",
+			Message:            reason,
+			ObservedGeneration: ttlResource.Generation,
+			LastTransitionTime: metav1.Time{Time: now},
+		})
+		if err := updateTTLResourceStatus(ctx, q.Client, ttlResource, q.StatusViaUpdate); err != nil {
+			if errors.IsConflict(err) || errors.IsNotFound(err) {
+				// 다음 주기에 다시 확인
+				continue
+			}
+			return expired, err
+		}
+		logger.Info("Expiring TTLResource early due to quota pressure",
+			"namespace", namespace, "name", ttlResource.Name, "reason", reason)
+		expired++
+	}
+	return expired, nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func podQuota(namespace string, used, hard int64) *corev1.ResourceQuota {
+	return &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "pods", Namespace: namespace},
+		Status: corev1.ResourceQuotaStatus{
+			Hard: corev1.ResourceList{corev1.ResourcePods: *resource.NewQuantity(hard, resource.DecimalSI)},
+			Used: corev1.ResourceList{corev1.ResourcePods: *resource.NewQuantity(used, resource.DecimalSI)},
+		},
+	}
+}
+
+// pendingTTLResource는 age 전에 생성되어 1시간 뒤 만료되는 Pod TTLResource와 그 owner를 반환합니다.
+func pendingTTLResource(namespace, name string, age time.Duration, now time.Time) (*corev1.Pod, *ttlv1alpha1.TTLResource) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	createdAt := metav1.NewTime(now.Add(-age))
+	ttlResource := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "ttl-" + name,
+			Namespace:       namespace,
+			Labels:          map[string]string{TTLResourceLabelKey: TTLResourceLabelValue},
+			OwnerReferences: []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: name}},
+		},
+		Spec: ttlv1alpha1.TTLResourceSpec{TTLSeconds: int(age.Seconds()) + 3600},
+		Status: ttlv1alpha1.TTLResourceStatus{
+			CreatedAt: createdAt,
+			ExpiredAt: &metav1.Time{Time: now.Add(time.Hour)},
+		},
+	}
+	return pod, ttlResource
+}
+
+func TestQuotaPressureExpiresOldestFirst(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	objs := []client.Object{podQuota("busy", 9, 10), podQuota("calm", 1, 10)}
+	for _, tc := range []struct {
+		namespace, name string
+		age             time.Duration
+	}{
+		{"busy", "newest", time.Minute},
+		{"busy", "oldest", 3 * time.Hour},
+		{"busy", "middle", time.Hour},
+		{"calm", "idle", 5 * time.Hour},
+	} {
+		pod, ttlResource := pendingTTLResource(tc.namespace, tc.name, tc.age, now)
+		objs = append(objs, pod, ttlResource)
+	}
+	r := newTestReconciler(t, objs...)
+
+	reclaimer := &QuotaPressureReclaimer{Client: r.Client, Threshold: 0.9, MaxPerCycle: 2}
+	expired, err := reclaimer.Run(ctx, now)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(expired).To(Equal(2))
+
+	for name, early := range map[string]bool{"oldest": true, "middle": true, "newest": false} {
+		var latest ttlv1alpha1.TTLResource
+		g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "busy", Name: "ttl-" + name}, &latest)).To(Succeed())
+		g.Expect(meta.IsStatusConditionTrue(latest.Status.Conditions, ConditionExpiredEarly)).To(Equal(early), name)
+		g.Expect(latest.Status.ExpiredAt.After(now)).To(Equal(!early), name)
+	}
+	var idle ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "calm", Name: "ttl-idle"}, &idle)).To(Succeed())
+	g.Expect(idle.Status.Conditions).To(BeEmpty(), "namespaces below the threshold are left alone")
+
+	// 일찍 만료된 TTLResource는 다음 reconcile에서 owner를 삭제
+	reconcileKey(t, r, "busy", "ttl-oldest")
+	err = r.Get(ctx, client.ObjectKey{Namespace: "busy", Name: "oldest"}, &corev1.Pod{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestQuotaPressureEarlyExpiryTriggersReconcile(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	pod, ttlResource := pendingTTLResource("busy", "oldest", 3*time.Hour, now)
+	r := newTestReconciler(t, podQuota("busy", 9, 10), pod, ttlResource)
+
+	// manager와 같이 watch 이벤트를 TTLResource watch의 predicate로 걸러 reconcile
+	watcher, err := r.Client.(client.WithWatch).Watch(ctx, &ttlv1alpha1.TTLResourceList{}, client.InNamespace("busy"))
+	g.Expect(err).NotTo(HaveOccurred())
+	defer watcher.Stop()
+	var old ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &old)).To(Succeed())
+
+	reclaimer := &QuotaPressureReclaimer{Client: r.Client, Threshold: 0.9}
+	expired, err := reclaimer.Run(ctx, now)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(expired).To(Equal(1))
+
+	var updated *ttlv1alpha1.TTLResource
+	g.Eventually(watcher.ResultChan()).Should(Receive(WithTransform(func(e watch.Event) bool {
+		updated, _ = e.Object.(*ttlv1alpha1.TTLResource)
+		return e.Type == watch.Modified && updated != nil
+	}, BeTrue())))
+	g.Expect(ttlResourceMetadataOrSpecChanged.Update(event.UpdateEvent{ObjectOld: &old, ObjectNew: updated})).To(BeTrue(),
+		"the early expiry status write must pass the TTLResource watch predicate")
+
+	reconcileKey(t, r, "busy", "ttl-oldest")
+	err = r.Get(ctx, client.ObjectKey{Namespace: "busy", Name: "oldest"}, &corev1.Pod{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+}
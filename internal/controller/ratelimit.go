@@ -0,0 +1,90 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// reconcile workqueue rate limiter 기본값
+const (
+	// DefaultRateLimiterBaseDelay는 같은 리소스가 처음 충돌했을 때의 재시도 지연입니다. 충돌이 반복될 때마다 두 배로 늘어납니다
+	DefaultRateLimiterBaseDelay = time.Second
+	// DefaultRateLimiterMaxDelay는 같은 리소스의 재시도 지연 상한입니다
+	DefaultRateLimiterMaxDelay = 5 * time.Minute
+	// DefaultRateLimiterQPS는 workqueue 전체의 재시도 허용 속도(초당)입니다
+	DefaultRateLimiterQPS = 10
+	// DefaultRateLimiterBurst는 workqueue 전체의 재시도 burst 크기입니다
+	DefaultRateLimiterBurst = 100
+)
+
+// requeueOnConflict는 충돌 시 workqueue rate limiter를 거쳐 재시도하도록 요청합니다.
+// 같은 리소스에서 충돌이 이어지면 지연이 점점 늘어나고, 성공하면 다시 기본 지연으로 돌아갑니다.
+func requeueOnConflict() ctrl.Result {
+	return ctrl.Result{Requeue: true} //nolint:staticcheck // 리소스별 backoff는 rate limiter를 거치는 requeue로만 할 수 있음
+}
+
+// rateLimiter는 리소스별 지수 backoff와 전체 token bucket 중 더 긴 지연을 사용하는 rate limiter를 만듭니다.
+// 0 이하로 지정된 값은 기본값을 사용합니다.
+func (r *ResourceReconciler) rateLimiter() workqueue.TypedRateLimiter[reconcile.Request] {
+	baseDelay := r.RateLimiterBaseDelay
+	if baseDelay <= 0 {
+		baseDelay = DefaultRateLimiterBaseDelay
+	}
+	maxDelay := r.RateLimiterMaxDelay
+	if maxDelay <= 0 {
+		maxDelay = DefaultRateLimiterMaxDelay
+	}
+	if maxDelay < baseDelay {
+		maxDelay = baseDelay
+	}
+	qps := r.RateLimiterQPS
+	if qps <= 0 {
+		qps = DefaultRateLimiterQPS
+	}
+	burst := r.RateLimiterBurst
+	if burst <= 0 {
+		burst = DefaultRateLimiterBurst
+	}
+
+	var perObject workqueue.TypedRateLimiter[reconcile.Request] = workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](baseDelay, maxDelay)
+	if r.RateLimiterJitter > 0 {
+		perObject = &jitteredRateLimiter{TypedRateLimiter: perObject, maxFactor: r.RateLimiterJitter}
+	}
+	return workqueue.NewTypedMaxOfRateLimiter(
+		perObject,
+		&workqueue.TypedBucketRateLimiter[reconcile.Request]{Limiter: rate.NewLimiter(rate.Limit(qps), burst)},
+	)
+}
+
+// jitteredRateLimiter는 감싼 rate limiter의 지연에 [0, maxFactor) 비율의 무작위 지연을 더합니다.
+// 같은 시각에 충돌한 많은 리소스가 같은 지연 뒤에 다시 충돌하는 것을 막습니다.
+type jitteredRateLimiter struct {
+	workqueue.TypedRateLimiter[reconcile.Request]
+	maxFactor float64
+}
+
+// When은 감싼 rate limiter의 지연에 jitter를 더해 반환합니다.
+func (l *jitteredRateLimiter) When(item reconcile.Request) time.Duration {
+	return wait.Jitter(l.TypedRateLimiter.When(item), l.maxFactor)
+}
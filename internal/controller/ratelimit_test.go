@@ -0,0 +1,142 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestRateLimiterBacksOffPerObject(t *testing.T) {
+	g := NewWithT(t)
+
+	r := &ResourceReconciler{RateLimiterBaseDelay: time.Second, RateLimiterMaxDelay: 5 * time.Second}
+	limiter := r.rateLimiter()
+	hot := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "ttl-hot"}}
+	other := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "ttl-other"}}
+
+	g.Expect(limiter.When(hot)).To(Equal(time.Second))
+	g.Expect(limiter.When(hot)).To(Equal(2 * time.Second))
+	g.Expect(limiter.When(hot)).To(Equal(4 * time.Second))
+	g.Expect(limiter.When(hot)).To(Equal(5*time.Second), "delay should be capped at the max delay")
+
+	// 다른 리소스는 영향을 받지 않음
+	g.Expect(limiter.When(other)).To(Equal(time.Second))
+
+	// 성공하면 backoff가 초기화됨
+	limiter.Forget(hot)
+	g.Expect(limiter.When(hot)).To(Equal(time.Second))
+}
+
+func TestRateLimiterJitterSpreadsRetries(t *testing.T) {
+	g := NewWithT(t)
+
+	r := &ResourceReconciler{RateLimiterBaseDelay: time.Second, RateLimiterMaxDelay: time.Minute, RateLimiterJitter: 0.5}
+	limiter := r.rateLimiter()
+
+	// 처음 충돌한 여러 리소스의 지연이 [1s, 1.5s) 안에서 서로 다름
+	delays := map[time.Duration]bool{}
+	for i := range 20 {
+		item := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: fmt.Sprintf("ttl-%d", i)}}
+		delay := limiter.When(item)
+		g.Expect(delay).To(BeNumerically(">=", time.Second))
+		g.Expect(delay).To(BeNumerically("<", 1500*time.Millisecond))
+		delays[delay] = true
+	}
+	g.Expect(len(delays)).To(BeNumerically(">", 1))
+
+	// 지수 backoff는 그대로 유지됨
+	hot := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "ttl-hot"}}
+	limiter.When(hot)
+	g.Expect(limiter.When(hot)).To(BeNumerically(">=", 2*time.Second))
+	limiter.Forget(hot)
+	g.Expect(limiter.When(hot)).To(BeNumerically("<", 1500*time.Millisecond))
+}
+
+func TestSpecUpdateConflictRequeuesThroughRateLimiter(t *testing.T) {
+	g := NewWithT(t)
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		Namespace:   "default",
+		Annotations: map[string]string{TTLAnnotationKey: "600"},
+	}}
+	existing := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ttl-web",
+			Namespace: "default",
+			Labels:    map[string]string{TTLResourceLabelKey: TTLResourceLabelValue},
+		},
+		Spec: ttlv1alpha1.TTLResourceSpec{TTLSeconds: 300},
+	}
+	scheme := newTestScheme(t)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(pod, existing).
+		WithStatusSubresource(&ttlv1alpha1.TTLResource{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+				return errors.NewConflict(schema.GroupResource{Group: "ttl.example.com", Resource: "ttlresources"},
+					obj.GetName(), nil)
+			},
+		}).
+		Build()
+	r := &ResourceReconciler{Client: c, Scheme: scheme}
+
+	// TTL 변경이 충돌로 반영되지 않으면 버리지 않고 owner를 다시 reconcile함
+	result := reconcileKey(t, r, "default", "web")
+	g.Expect(result.Requeue).To(BeTrue()) //nolint:staticcheck
+}
+
+func TestConflictRequeuesThroughRateLimiter(t *testing.T) {
+	g := NewWithT(t)
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	scheme := newTestScheme(t)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(pod, ttlResource).
+		WithStatusSubresource(&ttlv1alpha1.TTLResource{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string,
+				obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				return errors.NewConflict(schema.GroupResource{Group: "ttl.example.com", Resource: "ttlresources"},
+					obj.GetName(), nil)
+			},
+		}).
+		Build()
+	r := &ResourceReconciler{Client: c, Scheme: scheme}
+
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(BeZero())
+	g.Expect(result.Requeue).To(BeTrue()) //nolint:staticcheck
+}
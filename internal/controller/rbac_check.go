@@ -0,0 +1,141 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// DeletePermissionChecker는 watch하는 Kind마다 operator가 리소스를 삭제할 권한이 있는지
+// SelfSubjectAccessReview로 확인합니다. 삭제할 수 없는 Kind는 TTLResource를 만들지 않도록 해
+// 만료 후 Forbidden으로 계속 실패하는 TTLResource가 쌓이지 않게 합니다.
+// RBAC가 나중에 부여될 수 있으므로 Interval마다 다시 확인합니다.
+type DeletePermissionChecker struct {
+	Client client.Client
+	// Kinds는 권한을 확인할 Kind 목록입니다 (supportedKinds의 키)
+	Kinds []string
+	// Interval은 권한을 다시 확인하는 주기입니다
+	Interval time.Duration
+
+	mu sync.RWMutex
+	// denied는 마지막 확인에서 삭제 권한이 없었던 Kind입니다
+	denied map[string]bool
+}
+
+// Start는 시작 시 한 번 권한을 확인하고, ctx가 끝날 때까지 Interval마다 다시 확인합니다.
+func (c *DeletePermissionChecker) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("rbac-check")
+	ctx = logf.IntoContext(ctx, logger)
+
+	ticker := time.NewTicker(c.Interval)
+	defer ticker.Stop()
+	for {
+		c.Check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection은 모든 replica가 권한을 확인하도록 합니다. 리더가 바뀌어도 바로 판단할 수 있어야 합니다.
+func (c *DeletePermissionChecker) NeedLeaderElection() bool {
+	return false
+}
+
+// DeleteAllowed는 kind의 리소스를 삭제할 수 있는지 반환합니다.
+// 아직 확인하지 않았거나 확인에 실패한 Kind는 허용된 것으로 봅니다.
+func (c *DeletePermissionChecker) DeleteAllowed(kind string) bool {
+	if c == nil {
+		return true
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return !c.denied[kind]
+}
+
+// Check는 Kinds마다 삭제 권한을 확인해 결과를 갱신합니다.
+// 권한이 없어진 Kind는 경고로, 다시 생긴 Kind는 정보 로그로 남깁니다.
+// SelfSubjectAccessReview 자체가 실패한 Kind는 이전 결과를 유지합니다.
+func (c *DeletePermissionChecker) Check(ctx context.Context) {
+	logger := logf.FromContext(ctx)
+
+	for _, kind := range c.Kinds {
+		allowed, err := c.deleteAllowed(ctx, kind)
+		if err != nil {
+			logger.Error(err, "Failed to check delete permission", "kind", kind)
+			continue
+		}
+
+		c.mu.Lock()
+		if c.denied == nil {
+			c.denied = map[string]bool{}
+		}
+		wasDenied := c.denied[kind]
+		c.denied[kind] = !allowed
+		c.mu.Unlock()
+
+		switch {
+		case !allowed && !wasDenied:
+			logger.Error(nil, "Operator is not allowed to delete this kind, TTLResources will not be created for it "+
+				"until the delete permission is granted", "kind", kind)
+		case allowed && wasDenied:
+			logger.Info("Delete permission granted, TTLResources will be created for this kind again", "kind", kind)
+		}
+		if allowed {
+			kindDeletePermitted.WithLabelValues(kind).Set(1)
+		} else {
+			kindDeletePermitted.WithLabelValues(kind).Set(0)
+		}
+	}
+}
+
+// deleteAllowed는 SelfSubjectAccessReview로 kind의 리소스를 모든 네임스페이스에서 삭제할 수 있는지 확인합니다.
+func (c *DeletePermissionChecker) deleteAllowed(ctx context.Context, kind string) (bool, error) {
+	gvk, ok := supportedKinds[kind]
+	if !ok {
+		return false, fmt.Errorf("unsupported kind %q", kind)
+	}
+	mapping, err := c.Client.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return false, fmt.Errorf("failed to map %s to a resource: %w", kind, err)
+	}
+
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Verb:     "delete",
+				Group:    mapping.Resource.Group,
+				Version:  mapping.Resource.Version,
+				Resource: mapping.Resource.Resource,
+			},
+		},
+	}
+	if err := c.Client.Create(ctx, review); err != nil {
+		return false, fmt.Errorf("failed to create SelfSubjectAccessReview: %w", err)
+	}
+	return review.Status.Allowed, nil
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// newPermissionCheckClient는 denied에 있는 resource의 삭제만 거부하는 SelfSubjectAccessReview 응답을 돌려주는 fake client를 만듭니다.
+// 검토 요청 자체를 실패시키려면 failReviews를 true로 설정합니다.
+func newPermissionCheckClient(t *testing.T, denied map[string]bool, failReviews *bool) client.Client {
+	t.Helper()
+	mapper := meta.NewDefaultRESTMapper(nil)
+	for _, gvk := range supportedKinds {
+		mapper.Add(gvk, meta.RESTScopeNamespace)
+	}
+	return fake.NewClientBuilder().
+		WithScheme(newTestScheme(t)).
+		WithRESTMapper(mapper).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				review, ok := obj.(*authorizationv1.SelfSubjectAccessReview)
+				if !ok {
+					return c.Create(ctx, obj, opts...)
+				}
+				if failReviews != nil && *failReviews {
+					return stderrors.New("authorization API unavailable")
+				}
+				attrs := review.Spec.ResourceAttributes
+				review.Status.Allowed = attrs.Verb != "delete" || !denied[attrs.Resource]
+				return nil
+			},
+		}).
+		Build()
+}
+
+func TestDeletePermissionCheckerCheck(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	denied := map[string]bool{"deployments": true}
+	failReviews := false
+	checker := &DeletePermissionChecker{
+		Client: newPermissionCheckClient(t, denied, &failReviews),
+		Kinds:  []string{"Pod", "Deployment"},
+	}
+
+	// 확인 전에는 모든 Kind를 허용
+	g.Expect(checker.DeleteAllowed("Deployment")).To(BeTrue())
+
+	checker.Check(ctx)
+	g.Expect(checker.DeleteAllowed("Pod")).To(BeTrue())
+	g.Expect(checker.DeleteAllowed("Deployment")).To(BeFalse())
+	// 확인 대상이 아닌 Kind는 허용
+	g.Expect(checker.DeleteAllowed("Job")).To(BeTrue())
+
+	// 확인이 실패하면 이전 결과를 유지
+	failReviews = true
+	checker.Check(ctx)
+	g.Expect(checker.DeleteAllowed("Deployment")).To(BeFalse())
+
+	// 나중에 권한이 부여되면 다시 허용
+	failReviews = false
+	delete(denied, "deployments")
+	checker.Check(ctx)
+	g.Expect(checker.DeleteAllowed("Deployment")).To(BeTrue())
+}
+
+func TestDeletePermissionCheckerNil(t *testing.T) {
+	var checker *DeletePermissionChecker
+	NewWithT(t).Expect(checker.DeleteAllowed("Pod")).To(BeTrue())
+}
+
+func TestSkipTTLResourceCreationWithoutDeletePermission(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	denied := map[string]bool{"pods": true}
+	r := newTestReconciler(t, annotatedPod("uid-1"))
+	r.DeletePermissions = &DeletePermissionChecker{
+		Client:   newPermissionCheckClient(t, denied, nil),
+		Kinds:    []string{"Pod"},
+		Interval: 10 * time.Minute,
+	}
+	r.DeletePermissions.Check(ctx)
+
+	// 삭제할 수 없는 Kind는 TTLResource를 만들지 않고 확인 주기 후 다시 시도
+	result := reconcileKey(t, r, "default", "web")
+	g.Expect(result.RequeueAfter).To(Equal(10 * time.Minute))
+	var ttlResource ttlv1alpha1.TTLResource
+	err := r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &ttlResource)
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+
+	// 권한이 부여되면 TTLResource를 만듦
+	delete(denied, "pods")
+	r.DeletePermissions.Check(ctx)
+	reconcileKey(t, r, "default", "web")
+	g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &ttlResource)).To(Succeed())
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// staleTTLResourceRequeueInterval은 이전 owner의 TTLResource를 지운 뒤 새 TTLResource를 만들기 위해 다시 확인하는 간격입니다.
+const staleTTLResourceRequeueInterval = time.Second
+
+// ownedByPreviousIncarnation은 TTLResource가 같은 Kind와 이름이지만 UID가 다른, 즉 삭제 후 다시 만들어진 리소스의
+// 이전 버전을 가리키는지 확인합니다. GC가 아직 지우지 않은 TTLResource나 보존 모드의 기록이 여기에 해당합니다.
+func ownedByPreviousIncarnation(ttlResource *ttlv1alpha1.TTLResource, obj client.Object, kind string) bool {
+	for _, owner := range ownersOf(ttlResource) {
+		if owner.Kind == kind && owner.Name == obj.GetName() && uidMismatch(owner.UID, obj.GetUID()) {
+			return true
+		}
+	}
+	return false
+}
+
+// replaceStaleTTLResource는 이전 owner의 TTLResource를 지우고 재큐잉합니다.
+// 그대로 두면 다시 만들어진 리소스가 이미 만료된 status를 물려받아 곧바로 삭제되므로, 다음 reconcile에서 새로 만듭니다.
+func (r *ResourceReconciler) replaceStaleTTLResource(ctx context.Context, stale *ttlv1alpha1.TTLResource,
+	obj client.Object, logger logr.Logger) (ctrl.Result, error) {
+	logger.Info("Replacing TTLResource left over from a previous incarnation of the resource",
+		"name", stale.Name, "resource", client.ObjectKeyFromObject(obj), "uid", obj.GetUID())
+	// 그 사이에 다른 TTLResource로 바뀌었으면 지우지 않도록 UID 조건을 붙임
+	if err := r.Delete(ctx, stale, client.Preconditions{UID: &stale.UID}); err != nil &&
+		!errors.IsNotFound(err) && !errors.IsConflict(err) {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: staleTTLResourceRequeueInterval}, nil
+}
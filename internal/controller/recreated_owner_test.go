@@ -0,0 +1,131 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// newClockedTestReconciler는 API 서버처럼 생성 시 creationTimestamp를 fakeClock 기준으로 채우는 reconciler를 만듭니다.
+func newClockedTestReconciler(t *testing.T, fakeClock *clocktesting.FakeClock, objs ...client.Object) *ResourceReconciler {
+	t.Helper()
+	scheme := newTestScheme(t)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&ttlv1alpha1.TTLResource{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				obj.SetCreationTimestamp(metav1.NewTime(fakeClock.Now()))
+				return c.Create(ctx, obj, opts...)
+			},
+		}).
+		Build()
+	return &ResourceReconciler{Client: c, Scheme: scheme, Clock: fakeClock}
+}
+
+func annotatedPod(uid string) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		Namespace:   "default",
+		UID:         types.UID(uid),
+		Annotations: map[string]string{TTLAnnotationKey: "60"},
+	}}
+}
+
+func TestRecreatedOwnerGetsFreshTTLResource(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	r := newClockedTestReconciler(t, fakeClock, annotatedPod("uid-1"))
+	key := client.ObjectKey{Namespace: "default", Name: "ttl-web"}
+
+	reconcileKey(t, r, "default", "web")
+	reconcileKey(t, r, "default", "ttl-web")
+
+	// 만료되면 Pod와 TTLResource가 모두 삭제됨
+	fakeClock.Step(time.Minute)
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "web"}, &corev1.Pod{}))).To(BeTrue())
+	g.Expect(errors.IsNotFound(r.Get(ctx, key, &ttlv1alpha1.TTLResource{}))).To(BeTrue())
+
+	// 같은 이름으로 다시 만들면 새 만료 시각을 가진 TTLResource가 생성됨
+	fakeClock.Step(10 * time.Second)
+	g.Expect(r.Create(ctx, annotatedPod("uid-2"))).To(Succeed())
+	reconcileKey(t, r, "default", "web")
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(Equal(time.Minute))
+
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, key, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.OwnerReferences).To(HaveLen(1))
+	g.Expect(ttlResource.OwnerReferences[0].UID).To(BeEquivalentTo("uid-2"))
+	g.Expect(ttlResource.Status.Expired).To(BeFalse())
+	g.Expect(ttlResource.Status.ExpiredAt.Time.Equal(fakeClock.Now().Add(time.Minute))).To(BeTrue())
+}
+
+func TestStaleTTLResourceOfPreviousOwnerIsReplaced(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	expiredAt := metav1.NewTime(fakeClock.Now().Add(-time.Minute))
+	// GC가 아직 지우지 않은 이전 Pod의 만료된 TTLResource
+	stale := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "ttl-web",
+			Namespace:         "default",
+			UID:               "ttl-uid-1",
+			CreationTimestamp: metav1.NewTime(fakeClock.Now().Add(-2 * time.Minute)),
+			Labels:            map[string]string{TTLResourceLabelKey: TTLResourceLabelValue},
+			OwnerReferences:   []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: "web", UID: "uid-1"}},
+		},
+		Spec:   ttlv1alpha1.TTLResourceSpec{TTLSeconds: 60},
+		Status: ttlv1alpha1.TTLResourceStatus{CreatedAt: metav1.NewTime(expiredAt.Add(-time.Minute)), ExpiredAt: &expiredAt, Expired: true},
+	}
+	r := newClockedTestReconciler(t, fakeClock, annotatedPod("uid-2"), stale)
+	key := client.ObjectKey{Namespace: "default", Name: "ttl-web"}
+
+	result := reconcileKey(t, r, "default", "web")
+	g.Expect(result.RequeueAfter).To(Equal(staleTTLResourceRequeueInterval))
+	g.Expect(errors.IsNotFound(r.Get(ctx, key, &ttlv1alpha1.TTLResource{}))).To(BeTrue())
+
+	reconcileKey(t, r, "default", "web")
+	reconcileKey(t, r, "default", "ttl-web")
+
+	// 새 Pod는 이전 Pod의 만료 상태를 물려받지 않음
+	g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "web"}, &corev1.Pod{})).To(Succeed())
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, key, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.OwnerReferences[0].UID).To(BeEquivalentTo("uid-2"))
+	g.Expect(ttlResource.Status.Expired).To(BeFalse())
+}
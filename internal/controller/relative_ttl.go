@@ -0,0 +1,146 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"math"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+const (
+	// RelativeToAnnotationKey는 만료 시각을 같은 네임스페이스의 다른 TTLResource(부모) 수명에 맞출 때 부모 TTLResource 이름을 지정하는 annotation 키입니다
+	RelativeToAnnotationKey = "ttl.example.com/relative-to"
+	// RelativeFractionAnnotationKey는 부모 수명 중 몇 번째 지점(0 초과 1 이하)에서 만료할지 지정하는 annotation 키입니다. 없으면 1(부모와 동시)입니다
+	RelativeFractionAnnotationKey = "ttl.example.com/relative-fraction"
+)
+
+// TTLSourceRelative는 relative-to annotation으로 부모 TTLResource의 수명에서 계산한 TTL입니다
+const TTLSourceRelative = "relative-to"
+
+// relativeTTL은 relative-to annotation이 가리키는 부모 TTLResource의 수명(status.createdAt ~ status.expiredAt) 중
+// relative-fraction 지점에 만료되도록 부모와 같은 시작 시각과 그에 맞춘 TTL을 반환합니다.
+// 부모의 만료 시각이 아직 계산되지 않았으면 waiting이 true이며, 부모의 status가 바뀌면 다시 reconcile됩니다.
+// annotation이 없거나 잘못되었거나 부모가 없으면 ok가 false이며 리소스 자신의 TTL을 사용합니다.
+func (r *ResourceReconciler) relativeTTL(ctx context.Context, obj client.Object,
+	logger logr.Logger) (spec ttlv1alpha1.TTLResourceSpec, ok, waiting bool, err error) {
+	annotations := obj.GetAnnotations()
+	parentName, found := annotations[RelativeToAnnotationKey]
+	if !found || parentName == "" {
+		return spec, false, false, nil
+	}
+	fraction := 1.0
+	if value, found := annotations[RelativeFractionAnnotationKey]; found {
+		if fraction, err = parseRelativeFraction(value); err != nil {
+			logger.Info("Invalid relative-fraction annotation, ignoring relative-to", "resource", client.ObjectKeyFromObject(obj),
+				"value", value, "error", err.Error())
+			return spec, false, false, nil
+		}
+	}
+
+	var parent ttlv1alpha1.TTLResource
+	if err := r.Get(ctx, client.ObjectKey{Namespace: obj.GetNamespace(), Name: parentName}, &parent); err != nil {
+		if errors.IsNotFound(err) {
+			logger.Info("Parent TTLResource of relative-to not found, using the resource's own TTL",
+				"resource", client.ObjectKeyFromObject(obj), "parent", parentName)
+			return spec, false, false, nil
+		}
+		return spec, false, false, err
+	}
+	if parent.Status.ExpiredAt == nil || parent.Status.CreatedAt.IsZero() {
+		logger.V(1).Info("Waiting for parent TTLResource expiry", "resource", client.ObjectKeyFromObject(obj), "parent", parentName)
+		return spec, false, true, nil
+	}
+
+	lifetime := parent.Status.ExpiredAt.Sub(parent.Status.CreatedAt.Time).Seconds()
+	startTime := parent.Status.CreatedAt
+	return ttlv1alpha1.TTLResourceSpec{
+		TTLSeconds: max(int(math.Ceil(lifetime*fraction)), 1),
+		StartTime:  &startTime,
+	}, true, false, nil
+}
+
+// relativeParentExpiryChanged는 TTLResource의 만료 시각이 정해지거나 바뀐 경우에만 이벤트를 통과시킵니다.
+var relativeParentExpiryChanged = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		ttlResource, ok := e.Object.(*ttlv1alpha1.TTLResource)
+		return ok && ttlResource.Status.ExpiredAt != nil
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldTTL, oldOK := e.ObjectOld.(*ttlv1alpha1.TTLResource)
+		newTTL, newOK := e.ObjectNew.(*ttlv1alpha1.TTLResource)
+		if !oldOK || !newOK {
+			return false
+		}
+		return !oldTTL.Status.CreatedAt.Equal(&newTTL.Status.CreatedAt) ||
+			(oldTTL.Status.ExpiredAt == nil) != (newTTL.Status.ExpiredAt == nil) ||
+			(newTTL.Status.ExpiredAt != nil && !oldTTL.Status.ExpiredAt.Equal(newTTL.Status.ExpiredAt))
+	},
+	DeleteFunc: func(event.DeleteEvent) bool {
+		return false
+	},
+}
+
+// requestsForRelativeChildren은 부모 TTLResource의 만료 시각이 바뀌었을 때
+// relative-to annotation으로 그 TTLResource를 가리키는 리소스를 다시 reconcile하도록 요청을 만듭니다.
+func (r *ResourceReconciler) requestsForRelativeChildren(ctx context.Context, obj client.Object) []reconcile.Request {
+	logger := logf.FromContext(ctx)
+
+	var requests []reconcile.Request
+	for _, list := range r.watchedLists() {
+		if err := r.List(ctx, list, client.InNamespace(obj.GetNamespace())); err != nil {
+			logger.Error(err, "Failed to list resources for relative-to", "namespace", obj.GetNamespace())
+			continue
+		}
+		_ = meta.EachListItem(list, func(item runtime.Object) error {
+			if o, ok := item.(client.Object); ok && o.GetAnnotations()[RelativeToAnnotationKey] == obj.GetName() {
+				requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(o)})
+			}
+			return nil
+		})
+	}
+	return requests
+}
+
+// watchedLists는 watchedKinds에 해당하는 목록 객체를 반환합니다.
+func (r *ResourceReconciler) watchedLists() []client.ObjectList {
+	lists := []client.ObjectList{
+		&corev1.PodList{},
+		&corev1.ServiceList{},
+		&appsv1.DeploymentList{},
+		&corev1.ConfigMapList{},
+		&batchv1.JobList{},
+	}
+	if r.EnableEndpointKinds {
+		lists = append(lists, endpointLists()...)
+	}
+	return lists
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func parentTTLResource(createdAt time.Time, expiredAt *time.Time) *ttlv1alpha1.TTLResource {
+	parent := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{Name: "ttl-db", Namespace: "default"},
+		Spec:       ttlv1alpha1.TTLResourceSpec{TTLSeconds: 100},
+		Status:     ttlv1alpha1.TTLResourceStatus{CreatedAt: metav1.NewTime(createdAt)},
+	}
+	if expiredAt != nil {
+		parent.Status.ExpiredAt = &metav1.Time{Time: *expiredAt}
+	}
+	return parent
+}
+
+func relativeChild(fraction string) *corev1.Pod {
+	annotations := map[string]string{RelativeToAnnotationKey: "ttl-db"}
+	if fraction != "" {
+		annotations[RelativeFractionAnnotationKey] = fraction
+	}
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: "default", Annotations: annotations}}
+}
+
+func TestRelativeToExpiresAtFractionOfParentLifetime(t *testing.T) {
+	g := NewWithT(t)
+
+	createdAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	expiredAt := createdAt.Add(100 * time.Second)
+	r := newTestReconciler(t, parentTTLResource(createdAt, &expiredAt), relativeChild("0.8"))
+
+	reconcileKey(t, r, "default", "worker")
+
+	var child ttlv1alpha1.TTLResource
+	g.Expect(r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-worker"}, &child)).To(Succeed())
+	g.Expect(child.Spec.TTLSeconds).To(Equal(80))
+	g.Expect(child.Spec.StartTime).NotTo(BeNil())
+	g.Expect(child.Spec.StartTime.Time.Equal(createdAt)).To(BeTrue())
+	g.Expect(ttlSourceOf(&child)).To(Equal(TTLSourceRelative))
+}
+
+func TestRelativeToWaitsForParentExpiry(t *testing.T) {
+	g := NewWithT(t)
+
+	r := newTestReconciler(t, parentTTLResource(time.Now(), nil), relativeChild(""))
+	reconcileKey(t, r, "default", "worker")
+
+	err := r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-worker"}, &ttlv1alpha1.TTLResource{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestRelativeToFallsBackToOwnTTLWhenParentMissing(t *testing.T) {
+	g := NewWithT(t)
+
+	child := relativeChild("0.5")
+	child.Annotations[TTLAnnotationKey] = "300"
+	r := newTestReconciler(t, child)
+	reconcileKey(t, r, "default", "worker")
+
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-worker"}, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.Spec.TTLSeconds).To(Equal(300))
+	g.Expect(ttlResource.Spec.StartTime).To(BeNil())
+}
+
+func TestParseRelativeFraction(t *testing.T) {
+	g := NewWithT(t)
+
+	fraction, err := parseRelativeFraction("0.25")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(fraction).To(Equal(0.25))
+	for _, value := range []string{"0", "1.5", "-0.1", "half"} {
+		_, err := parseRelativeFraction(value)
+		g.Expect(err).To(HaveOccurred(), value)
+	}
+}
+
+func TestParentExpiryChangeRequeuesRelativeChildren(t *testing.T) {
+	g := NewWithT(t)
+
+	createdAt := time.Now()
+	expiredAt := createdAt.Add(100 * time.Second)
+	parent := parentTTLResource(createdAt, &expiredAt)
+	unrelated := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}}
+	r := newTestReconciler(t, parent, relativeChild("0.8"), unrelated)
+
+	requests := r.requestsForRelativeChildren(context.Background(), parent)
+	g.Expect(requests).To(HaveLen(1))
+	g.Expect(requests[0].Name).To(Equal("worker"))
+
+	extended := parent.DeepCopy()
+	extended.Status.ExpiredAt = &metav1.Time{Time: expiredAt.Add(time.Hour)}
+	g.Expect(relativeParentExpiryChanged.Update(event.UpdateEvent{ObjectOld: parent, ObjectNew: extended})).To(BeTrue())
+	g.Expect(relativeParentExpiryChanged.Update(event.UpdateEvent{ObjectOld: parent, ObjectNew: parent.DeepCopy()})).To(BeFalse())
+}
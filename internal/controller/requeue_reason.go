@@ -0,0 +1,72 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// status.lastRequeueReason에 기록하는 재큐잉 이유
+const (
+	// RequeueReasonWaitingForExpiry는 만료 시각까지 기다리는 중임을 나타냅니다
+	RequeueReasonWaitingForExpiry = "waiting for expiry"
+	// RequeueReasonConflictRetry는 status 쓰기가 충돌해 rate limiter의 backoff 후 다시 시도함을 나타냅니다
+	RequeueReasonConflictRetry = "conflict retry"
+	// RequeueReasonBatchLimit은 배치 삭제 한도에 걸려 남은 대상을 다음 reconcile에서 삭제함을 나타냅니다
+	RequeueReasonBatchLimit = "batch limit reached"
+	// RequeueReasonAPIThrottled는 API 서버가 삭제를 늦추라고 응답했음을 나타냅니다
+	RequeueReasonAPIThrottled = "throttled by API server"
+	// RequeueReasonDeletionBudget은 전역 삭제 한도(--max-deletions-per-window)를 다 썼음을 나타냅니다
+	RequeueReasonDeletionBudget = "deletion budget exhausted"
+)
+
+// deferredRequeueReason은 삭제를 미룬 condition 종류로 재큐잉 이유를 만듭니다. 자세한 사유는 status.deferReason에 있습니다.
+func deferredRequeueReason(conditionType string) string {
+	return "deferred: " + conditionType
+}
+
+// recordRequeueReason은 status 쓰기가 없는 재큐잉 경로에서 status.lastRequeueReason을 기록합니다.
+// 값이 바뀔 때만 resourceVersion 없이 merge patch하므로 이 기록 자체는 충돌하지 않으며, 실패해도 reconcile 결과에 영향을 주지 않습니다.
+// status를 쓰는 경로에서는 같은 쓰기에 이유를 함께 담아 별도 요청을 만들지 않습니다.
+func (r *ResourceReconciler) recordRequeueReason(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource, reason string, logger logr.Logger) {
+	if ttlResource.Status.LastRequeueReason == reason {
+		return
+	}
+	patch := client.MergeFrom(ttlResource.DeepCopy())
+	ttlResource.Status.LastRequeueReason = reason
+	var err error
+	if r.StatusViaUpdate {
+		err = r.Patch(ctx, ttlResource, patch)
+	} else {
+		err = r.Status().Patch(ctx, ttlResource, patch)
+	}
+	if err != nil {
+		logger.V(1).Info("Failed to record requeue reason", "name", ttlResource.Name, "reason", reason, "error", err.Error())
+	}
+}
+
+// retryOnConflict는 TTLResource status 쓰기가 충돌했을 때 재큐잉 이유를 남기고 rate limiter를 거쳐 다시 시도하도록 요청합니다.
+func (r *ResourceReconciler) retryOnConflict(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource, logger logr.Logger) ctrl.Result {
+	r.recordRequeueReason(ctx, ttlResource, RequeueReasonConflictRetry, logger)
+	return requeueOnConflict()
+}
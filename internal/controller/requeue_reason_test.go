@@ -0,0 +1,98 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestWaitingForExpiryIsRecordedWithoutExtraWrites(t *testing.T) {
+	g := NewWithT(t)
+
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	writes := 0
+	r := newWriteCountingReconciler(t, fakeClock, &writes, annotatedPod("uid-1"))
+
+	// 시작/만료 시각을 기록하는 같은 status 쓰기에 재큐잉 이유를 담음
+	reconcileKey(t, r, "default", "web")
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(writes).To(Equal(3))
+
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.Status.LastRequeueReason).To(Equal(RequeueReasonWaitingForExpiry))
+}
+
+func TestDeferredDeletionRecordsRequeueReason(t *testing.T) {
+	g := NewWithT(t)
+
+	deployment, ttlResource := expiredOwner(t, &appsv1.Deployment{
+		Spec:   appsv1.DeploymentSpec{Replicas: ptr.To[int32](2)},
+		Status: appsv1.DeploymentStatus{Replicas: 2},
+	}, map[string]string{DeleteIfAnnotationKey: "object.spec.replicas == 0"})
+	r := newTestReconciler(t, deployment, ttlResource)
+
+	reconcileKey(t, r, "default", "ttl-web")
+
+	var current ttlv1alpha1.TTLResource
+	g.Expect(r.Get(context.Background(), client.ObjectKeyFromObject(ttlResource), &current)).To(Succeed())
+	g.Expect(current.Status.LastRequeueReason).To(Equal(deferredRequeueReason(ConditionDeleteIfNotMet)))
+}
+
+func TestConflictRetryRecordsRequeueReason(t *testing.T) {
+	g := NewWithT(t)
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	scheme := newTestScheme(t)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(pod, ttlResource).
+		WithStatusSubresource(&ttlv1alpha1.TTLResource{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string,
+				obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				return errors.NewConflict(schema.GroupResource{Group: "ttl.example.com", Resource: "ttlresources"},
+					obj.GetName(), nil)
+			},
+		}).
+		Build()
+	r := &ResourceReconciler{Client: c, Scheme: scheme}
+
+	// 충돌한 쓰기 대신 resourceVersion 없는 merge patch로 이유만 기록
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.Requeue).To(BeTrue()) //nolint:staticcheck
+
+	var current ttlv1alpha1.TTLResource
+	g.Expect(c.Get(context.Background(), client.ObjectKeyFromObject(ttlResource), &current)).To(Succeed())
+	g.Expect(current.Status.LastRequeueReason).To(Equal(RequeueReasonConflictRetry))
+	g.Expect(current.Status.Expired).To(BeFalse(), "the conflicting status write must not be applied")
+}
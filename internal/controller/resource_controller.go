@@ -18,19 +18,30 @@ package controller
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
-	"strconv"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -44,24 +55,202 @@ const (
 	TTLResourceLabelKey = "ttl.example.com/managed-by"
 	// TTLResourceLabelValue는 resource 컨트롤러가 생성한 TTLResource임을 나타냅니다
 	TTLResourceLabelValue = "resource-controller"
+	// TargetKindAnnotationKey는 같은 이름의 리소스가 여러 Kind로 존재할 때 TTL을 적용할 Kind를 지정하는 annotation 키입니다
+	TargetKindAnnotationKey = "ttl.example.com/target-kind"
+	// ReconcileAnnotationKey는 리소스에 대한 Operator의 모든 처리를 멈출 때 사용하는 annotation 키입니다
+	ReconcileAnnotationKey = "ttl.example.com/reconcile"
+	// ReconcileDisabledValue는 ReconcileAnnotationKey에 지정하면 처리를 멈추는 값입니다
+	ReconcileDisabledValue = "disabled"
 )
 
-// ResourceReconciler는 Pod, Service, Deployment 등의 리소스를 감시하여 TTL을 적용합니다.
+// ResourceReconciler는 Pod, Service, Deployment, ConfigMap 등의 리소스를 감시하여 TTL을 적용합니다.
 type ResourceReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// ConflictLog는 충돌 로그를 샘플링합니다. nil이면 모든 충돌 로그를 출력합니다
+	ConflictLog *LogSampler
+	// MaxDeletesPerCycle은 한 번의 reconcile에서 삭제하는 최대 리소스 수입니다. 0이면 제한하지 않습니다
+	MaxDeletesPerCycle int
+	// TTLConflictPolicy는 Pod와 상위 Deployment의 TTL이 겹칠 때의 처리 방식입니다. 비어 있으면 pod-wins입니다
+	TTLConflictPolicy string
+	// RespectPDB가 true이면 Pod를 Eviction API로 삭제하여 PodDisruptionBudget을 지킵니다
+	RespectPDB bool
+	// Events는 수명 주기 이벤트를 외부 브로커로 발행합니다. nil이면 발행하지 않습니다
+	Events *EventSink
+	// DeletionLog를 지정하면 만료로 삭제한 owner를 ConfigMap에 기록합니다 (Event보다 오래 보존되는 삭제 이력)
+	DeletionLog *DeletionLog
+	// Recorder는 Kubernetes Event를 기록합니다. nil이면 기록하지 않습니다
+	Recorder record.EventRecorder
+	// OwnerTraversalDepth가 0보다 크면 TTL annotation이 없는 리소스가 owner를 그 단계까지 따라가 상위 리소스의 TTL을 상속합니다
+	OwnerTraversalDepth int
+	// KindDeletionPolicies는 Kind별 기본 삭제 방식입니다. nil이면 DefaultKindDeletionPolicies를 사용합니다
+	KindDeletionPolicies map[string]DeletionPolicy
+	// RetainExpired가 true이면 owner를 삭제한 뒤에도 TTLResource를 기록용으로 남겨둡니다.
+	// TTLResource의 Spec.KeepAfterExpiry가 지정되어 있으면 그 값이 우선합니다
+	RetainExpired bool
+	// ConfirmDeleteNamespaces에 있는 네임스페이스에서는 TTLResource에 confirm-delete annotation이 추가될 때까지
+	// 만료된 owner를 삭제하지 않습니다
+	ConfirmDeleteNamespaces []string
+	// SkipPodsControlledBy에 있는 Kind(예: ReplicaSet, Job)가 controller owner인 Pod는 TTL 대상에서 제외합니다
+	SkipPodsControlledBy []string
+	// ExcludeSelector에 label이 일치하거나 ExcludeAnnotations(key -> value, 빈 값은 key만 확인)에 일치하는 리소스는
+	// 네임스페이스와 관계없이 TTL annotation이 있어도 TTL 대상에서 제외합니다
+	ExcludeSelector    labels.Selector
+	ExcludeAnnotations map[string]string
+	// ImportJobTTL이 true이면 TTL annotation이 없는 Job의 spec.ttlSecondsAfterFinished를 TTLResource로 옮겨 관리합니다
+	ImportJobTTL bool
+	// ExcludedNamespaces에 있는 네임스페이스의 리소스는 TTL annotation이 있어도 처리하지 않습니다
+	ExcludedNamespaces []string
+	// OperatorNamespace는 operator가 실행 중인 네임스페이스입니다. 지정하면 operator가 자신을 삭제하지 않도록
+	// 이 네임스페이스의 리소스는 TTL annotation이 있어도 처리하지 않습니다
+	OperatorNamespace string
+	// MaxRequeueAfter는 만료를 기다리는 재큐잉 간격의 최댓값입니다. 0이면 만료 시각까지 한 번에 기다립니다
+	MaxRequeueAfter time.Duration
+	// DeletionHooks는 만료된 owner를 삭제하기 전후에 순서대로 실행됩니다
+	DeletionHooks []DeletionHook
+	// TargetRefPrecedence는 spec.targetRef와 OwnerReference가 모두 있을 때 삭제 대상을 정하는 방식입니다.
+	// 비어 있으면 TargetRefPrecedenceAuto를 사용합니다
+	TargetRefPrecedence string
+	// RateLimiterBaseDelay와 RateLimiterMaxDelay는 같은 리소스에서 충돌이 반복될 때의 재시도 지연 범위입니다
+	RateLimiterBaseDelay time.Duration
+	RateLimiterMaxDelay  time.Duration
+	// RateLimiterJitter가 0보다 크면 리소스별 재시도 지연에 최대 이 비율만큼 무작위 지연을 더해 충돌한 리소스들이 한꺼번에 재시도하지 않게 합니다
+	RateLimiterJitter float64
+	// RateLimiterQPS와 RateLimiterBurst는 workqueue 전체의 재시도 속도 제한입니다
+	RateLimiterQPS   float64
+	RateLimiterBurst int
+	// StatusViaUpdate가 true이면 status subresource가 없는 CRD에서 status를 일반 update로 기록합니다
+	StatusViaUpdate bool
+	// TrashNamespace는 expiry action이 trash인 owner의 사본을 만들 네임스페이스입니다. 비어 있으면 trash를 delete로 처리합니다
+	TrashNamespace string
+	// TrashTTL은 보관용 사본에 지정할 TTL입니다. 0이면 사본을 자동으로 삭제하지 않습니다
+	TrashTTL time.Duration
+	// NotifyAllowedHosts는 notify annotation으로 지정할 수 있는 알림 대상 호스트입니다. 비어 있으면 notify annotation을 모두 거부합니다
+	NotifyAllowedHosts []string
+	// ExpiryAuditLog가 true이면 만료로 owner를 처리할 때마다 결정 근거를 "Expiry audit" 로그로 남깁니다
+	ExpiryAuditLog bool
+	// Clock은 만료 판단과 status 시각 기록에 사용할 시계입니다. nil이면 실제 시계를 사용합니다
+	Clock clock.Clock
+	// DeletionMaxRetries는 오류로 owner 삭제에 실패했을 때 다시 시도하는 횟수의 상한입니다. 0이면 성공할 때까지 재시도합니다
+	DeletionMaxRetries int
+	// DeletionRetryBackoff는 첫 삭제 실패 후의 재시도 간격입니다. 실패할 때마다 두 배씩 늘어납니다. 0이면 30초입니다
+	DeletionRetryBackoff time.Duration
+	// EnableEndpointKinds가 true이면 Endpoints와 EndpointSlice에도 TTL annotation을 적용합니다
+	EnableEndpointKinds bool
+	// EnableTTLFromSecret이 true이면 ttl-from-secret annotation을 적용하고 Secret의 metadata를 watch합니다
+	EnableTTLFromSecret bool
+	// APIReader는 캐시를 거치지 않고 읽는 클라이언트입니다. Secret은 캐시하지 않으므로 이것으로 읽습니다. nil이면 Client를 사용합니다
+	APIReader client.Reader
+
+	// ZeroTTLBehavior는 spec.ttlSeconds가 0인 TTLResource의 처리 방식(ZeroTTLBehavior*)입니다. 비어 있으면 never-expire입니다
+	ZeroTTLBehavior string
+	// ExtendMinRemaining는 extend annotation으로 연장한 뒤 남아야 하는 최소 수명입니다.
+	// 연장해도 만료 시각이 이보다 가까우면 TTL을 더 늘립니다. 0이면 보장하지 않습니다
+	ExtendMinRemaining time.Duration
+	// DeletionLimiter는 CleanupSweep과 공유하는 전역 삭제 동시 실행 제한입니다. nil이면 제한하지 않습니다
+	DeletionLimiter *DeletionLimiter
+	// SoakUntil 이전에는 만료된 리소스를 삭제하지 않고 삭제할 대상만 로그와 Event로 남깁니다. zero time이면 soak 기간이 없습니다
+	SoakUntil time.Time
+	// RequireDeleteOptIn이 true이면 allow-delete=true label이 있는 owner만 만료 시 삭제합니다
+	RequireDeleteOptIn bool
+	// DeletionGracePeriod가 0보다 크면 만료 후 이 시간 동안 owner에 pending-deletion-at annotation을 붙이고 삭제를 미룹니다
+	DeletionGracePeriod time.Duration
+	// DefaultTTL이 0보다 크면 TTL annotation과 네임스페이스 기본 TTL이 모두 없는 리소스에도 이 TTL을 적용합니다
+	DefaultTTL time.Duration
+	// DefaultTTLNamespaces를 지정하면 DefaultTTL을 이 네임스페이스의 리소스에만 적용합니다
+	DefaultTTLNamespaces []string
+	// DefaultTTLSelector를 지정하면 DefaultTTL을 label이 일치하는 리소스에만 적용합니다
+	DefaultTTLSelector labels.Selector
+	// DeletionOrder는 owner가 여러 개일 때 배치 삭제 순서(DeletionOrder*)입니다. 비어 있으면 OwnerReference 순서입니다
+	DeletionOrder string
+	// AllowIgnoreSuspend가 true이면 ignore-suspend: "true" annotation이 있는 리소스는 네임스페이스가 suspend되어 있어도 삭제합니다
+	AllowIgnoreSuspend bool
+	// OwnerConditions가 true이면 status.conditions가 있는 owner에 TTLExpiring/TTLExpired condition을 기록합니다
+	OwnerConditions bool
+	// AnnotationMigrations를 지정하면 리소스에 남은 이전 annotation 키를 현재 키로 옮깁니다
+	AnnotationMigrations []AnnotationMigration
+	// DeletePermissions를 지정하면 operator가 삭제할 권한이 없는 Kind에는 TTLResource를 새로 만들지 않습니다
+	DeletePermissions *DeletePermissionChecker
+	// AnnotateOwners가 true이면 TTLResource를 만든 owner에 ttl.example.com/ttlresource annotation으로 그 이름을 남깁니다
+	AnnotateOwners bool
+	// KeepAliveLabel을 지정하면 owner의 이 label 값이 바뀔 때마다 TTL 카운트다운을 다시 시작합니다
+	KeepAliveLabel string
+	// DeletionBudget을 지정하면 일정 시간 동안 삭제하는 owner 수를 Operator 전체에서 제한합니다
+	DeletionBudget *DeletionBudget
+	// ActivityChecker를 지정하면 만료된 owner가 아직 사용 중인 동안 삭제를 미룹니다
+	ActivityChecker ActivityChecker
+	// ActivityRecheckInterval은 사용 중이라 삭제를 미룬 owner를 다시 확인하는 간격입니다. 0이면 DefaultActivityRecheckInterval입니다
+	ActivityRecheckInterval time.Duration
+	// TTLResourceNamer는 owner에 대한 TTLResource 이름을 만듭니다. nil이면 "ttl-<name>"을 사용합니다
+	TTLResourceNamer *TTLResourceNamer
+
+	// invalidTTLReported는 잘못된 TTL annotation을 이미 Event로 알린 리소스와 그 값입니다 (중복 Event 방지)
+	invalidTTLReported sync.Map
+	// deleteIfPrograms는 delete-if annotation의 식마다 컴파일해 둔 CEL 프로그램입니다
+	deleteIfPrograms sync.Map
+	// nameCollisionReported는 TTLResource 이름이 다른 리소스와 겹친다고 이미 Event로 알린 리소스와 그 상대입니다 (중복 Event 방지)
+	nameCollisionReported sync.Map
+	// operatorNamespaceReported는 operator 네임스페이스에 있어 건너뛴다고 이미 Info 로그로 알린 리소스입니다
+	operatorNamespaceReported sync.Map
 }
 
-// +kubebuilder:rbac:groups="",resources=pods;services,verbs=get;list;watch;delete
-// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups="",resources=pods;services,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups="",resources=pods/eviction,verbs=create
+// +kubebuilder:rbac:groups="",resources=pods/status;services/status,verbs=patch
+// +kubebuilder:rbac:groups=apps,resources=deployments/status,verbs=patch
+// +kubebuilder:rbac:groups=batch,resources=jobs/status,verbs=patch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=resourcequotas,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=apps,resources=replicasets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;watch
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=selfsubjectaccessreviews,verbs=create
 // +kubebuilder:rbac:groups=ttl.example.com,resources=ttlresources,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=ttl.example.com,resources=ttlresources/status,verbs=get;update;patch
 
 // Reconcile는 리소스의 annotation을 확인하고 TTLResource를 생성/관리합니다.
 // TTLResource도 watch하여 만료 시 리소스를 삭제합니다.
 func (r *ResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	result, err := r.reconcile(ctx, req)
+	// API 서버가 늦추라고 응답하면 rate limiter의 고정 backoff 대신 서버가 제안한 지연 후에 다시 시도
+	if delay, throttled := throttleDelay(err); throttled {
+		apiThrottledTotal.WithLabelValues("reconcile").Inc()
+		logf.FromContext(ctx).Info("API server is throttling requests, retrying after the suggested delay",
+			"resource", req.NamespacedName, "retryAfter", delay.String(), "error", err.Error())
+		return ctrl.Result{RequeueAfter: delay}, nil
+	}
+	return result, err
+}
+
+// reconcile은 Reconcile의 본문입니다.
+func (r *ResourceReconciler) reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := logf.FromContext(ctx)
 
+	// 제외된 네임스페이스에서는 TTLResource를 만들지도, 만료된 리소스를 삭제하지도 않음
+	if r.namespaceExcluded(req.Namespace) {
+		logger.V(1).Info("Skipping resource in excluded namespace", "resource", req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+
+	// operator 자신의 네임스페이스도 명시적으로 허용하지 않는 한 처리하지 않음
+	if r.skipOperatorNamespace(req, logger) {
+		return ctrl.Result{}, nil
+	}
+
+	// 삭제 중인 네임스페이스는 Kubernetes가 모두 정리하므로 TTLResource 생성, 상태 갱신, 삭제를 모두 건너뜀
+	if terminating, err := r.namespaceTerminating(ctx, req.Namespace); err != nil {
+		return ctrl.Result{}, err
+	} else if terminating {
+		logger.V(1).Info("Skipping resource in terminating namespace", "resource", req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+
 	// TTLResource인지 확인 (TTLResource도 watch하므로)
 	ttlResource := &ttlv1alpha1.TTLResource{}
 	if err := r.Get(ctx, req.NamespacedName, ttlResource); err == nil {
@@ -71,42 +260,31 @@ func (r *ResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		return ctrl.Result{}, err
 	}
 
-	// Pod, Service, Deployment를 순서대로 시도
-	var obj client.Object
-	var gvk string
-	var apiVersion string
-
-	// Pod 시도
-	pod := &corev1.Pod{}
-	if err := r.Get(ctx, req.NamespacedName, pod); err == nil {
-		obj = pod
-		gvk = "Pod"
-		apiVersion = "v1"
-	} else if !errors.IsNotFound(err) {
+	// 같은 이름의 Pod, Service, Deployment, ConfigMap을 모두 조회한 뒤 대상 선택
+	candidates, err := r.findCandidates(ctx, req.NamespacedName)
+	if err != nil {
 		return ctrl.Result{}, err
-	} else {
-		// Service 시도
-		svc := &corev1.Service{}
-		if err := r.Get(ctx, req.NamespacedName, svc); err == nil {
-			obj = svc
-			gvk = "Service"
-			apiVersion = "v1"
-		} else if !errors.IsNotFound(err) {
-			return ctrl.Result{}, err
-		} else {
-			// Deployment 시도
-			deploy := &appsv1.Deployment{}
-			if err := r.Get(ctx, req.NamespacedName, deploy); err == nil {
-				obj = deploy
-				gvk = "Deployment"
-				apiVersion = "apps/v1"
-			} else if !errors.IsNotFound(err) {
-				return ctrl.Result{}, err
-			} else {
-				// 리소스를 찾지 못했으면 관련 TTLResource 정리
-				return r.cleanupTTLResource(ctx, req.NamespacedName)
-			}
-		}
+	}
+	if len(candidates) == 0 {
+		// 리소스를 찾지 못했으면 관련 TTLResource 정리
+		r.forgetInvalidTTL(req.NamespacedName)
+		return r.cleanupTTLResource(ctx, req.NamespacedName)
+	}
+	target := selectCandidate(candidates, logger)
+	obj := target.obj
+	gvk := target.gvk.Kind
+	apiVersion := target.gvk.GroupVersion().String()
+
+	// 이전 annotation 키가 남아 있으면 현재 키로 옮긴 뒤 patch로 인한 다음 reconcile에서 처리
+	if patched, err := r.migrateLegacyAnnotations(ctx, obj, gvk, logger); err != nil || patched {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// reconcile이 비활성화된 리소스는 TTLResource와 리소스 자체를 모두 건드리지 않음
+	// (남아 있는 삭제 예정 표시는 다시 활성화되었을 때 정리)
+	if reconcileDisabled(obj) {
+		logger.Info("Skipping resource with reconcile disabled", "resource", req.NamespacedName, "kind", gvk)
+		return ctrl.Result{}, nil
 	}
 
 	// 리소스가 삭제 중이면 TTLResource 정리
@@ -114,47 +292,246 @@ func (r *ResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		return r.cleanupTTLResource(ctx, req.NamespacedName)
 	}
 
+	// 지정한 Kind의 컨트롤러가 관리하는 Pod는 TTLResource를 만들지 않음 (이미 있으면 정리)
+	if kind := r.skippedController(obj); kind != "" {
+		logger.V(1).Info("Skipping Pod managed by a skipped controller kind", "resource", req.NamespacedName, "controllerKind", kind)
+		return r.cleanupOwnerTTLResource(ctx, obj, logger)
+	}
+
+	// 보호 대상 label/annotation이 있는 리소스는 TTLResource를 만들지 않음 (이미 있으면 정리)
+	if reason := r.excludedResource(obj); reason != "" {
+		logger.Info("Skipping excluded resource", "resource", req.NamespacedName, "kind", gvk, "reason", reason)
+		return r.cleanupOwnerTTLResource(ctx, obj, logger)
+	}
+
+	// ephemeral debug 컨테이너가 붙은 Pod는 컨테이너 시작 시각부터 debug TTL을 적용
+	if pod, ok := obj.(*corev1.Pod); ok {
+		if debugSpec, ok := ephemeralDebugTTL(pod, logger); ok {
+			return r.ensureTTLResource(ctx, obj, target.gvk, debugSpec, TTLSourceEphemeralDebug, logger)
+		}
+	}
+
+	// relative-to annotation이 있으면 부모 TTLResource 수명의 지정한 비율 지점에서 만료
+	if relative, ok, waiting, err := r.relativeTTL(ctx, obj, logger); err != nil || waiting {
+		return ctrl.Result{}, err
+	} else if ok {
+		return r.ensureTTLResource(ctx, obj, target.gvk, relative, TTLSourceRelative, logger)
+	}
+
 	// TTL annotation 확인
 	annotations := obj.GetAnnotations()
 	ttlSecondsStr, hasTTL := annotations[TTLAnnotationKey]
+	if r.ttlOptedOut(obj) {
+		// TTL annotation이 disabled(클러스터 기본 TTL이 켜져 있으면 "0"도)이면 네임스페이스/클러스터 기본 TTL과 상속을 모두 적용하지 않음
+		logger.V(1).Info("Resource opted out of TTL", "resource", req.NamespacedName, "kind", gvk)
+		r.forgetInvalidTTL(req.NamespacedName)
+		if err := r.clearPendingDeletion(ctx, obj, logger); err != nil {
+			return ctrl.Result{}, err
+		}
+		return r.cleanupOwnerTTLResource(ctx, obj, logger)
+	}
+	source := TTLSourceAnnotation
+	ttlAnnotation := TTLAnnotationKey
+	if ref, ok := annotations[TTLFromSecretAnnotationKey]; ok && !hasTTL {
+		// TTL annotation이 없고 ttl-from-secret이 있으면 Secret 키에서 TTL을 읽음 (읽을 수 없으면 Warning Event 후 건너뜀)
+		value, found, err := r.secretTTL(ctx, obj, ref, logger)
+		if err != nil || !found {
+			return ctrl.Result{}, err
+		}
+		ttlSecondsStr, hasTTL = value, true
+		source = TTLSourceSecret
+		ttlAnnotation = TTLFromSecretAnnotationKey
+	}
+	if job, ok := obj.(*batchv1.Job); ok && !hasTTL && r.ImportJobTTL && job.Spec.TTLSecondsAfterFinished != nil {
+		// Job의 ttlSecondsAfterFinished를 완료 시각 기준 TTLResource로 옮김
+		mirrored, finished := mirroredJobTTL(job)
+		if !finished {
+			// 완료 전에는 만료 시각을 알 수 없으므로 Job 상태 변경으로 다시 reconcile될 때까지 기다림
+			return ctrl.Result{}, nil
+		}
+		return r.ensureTTLResource(ctx, obj, target.gvk, mirrored, TTLSourceJob, logger)
+	}
+	if !hasTTL && r.OwnerTraversalDepth > 0 {
+		// 상위 리소스(owner)에 TTL이 있으면 같은 시각에 만료되도록 상속
+		inherited, ok, err := r.inheritedOwnerTTL(ctx, obj, logger)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if ok {
+			return r.ensureTTLResource(ctx, obj, target.gvk, inherited, TTLSourceOwner, logger)
+		}
+	}
 	if !hasTTL {
-		// TTL annotation이 없으면 기존 TTLResource 삭제 (있는 경우)
-		return r.cleanupTTLResource(ctx, req.NamespacedName)
+		// TTL annotation이 없으면 네임스페이스의 기본 TTL 상속
+		source = TTLSourceNamespaceDefault
+		ttlAnnotation = "namespace " + NamespaceDefaultTTLAnnotationKey
+		ttlSecondsStr, hasTTL, err = r.namespaceDefaultTTL(ctx, req.Namespace)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if !hasTTL {
+		// 네임스페이스 기본 TTL도 없으면 클러스터 기본 TTL(--default-ttl) 적용
+		source = TTLSourceClusterDefault
+		ttlAnnotation = "--default-ttl"
+		ttlSecondsStr, hasTTL = r.clusterDefaultTTL(obj)
+	}
+	if !hasTTL {
+		// TTL annotation과 네임스페이스/클러스터 기본 TTL이 모두 없으면 기존 TTLResource 삭제 (있는 경우)
+		r.forgetInvalidTTL(req.NamespacedName)
+		if err := r.clearPendingDeletion(ctx, obj, logger); err != nil {
+			return ctrl.Result{}, err
+		}
+		return r.cleanupOwnerTTLResource(ctx, obj, logger)
 	}
 
 	// TTL 값 파싱
-	ttlSeconds, err := strconv.Atoi(ttlSecondsStr)
-	if err != nil || ttlSeconds <= 0 {
-		logger.Info("Invalid TTL annotation value, ignoring", "value", ttlSecondsStr, "resource", req.NamespacedName)
+	ttl, err := parseTTL(ttlSecondsStr)
+	if err != nil {
+		logger.Info("Invalid TTL annotation value, ignoring", "value", ttlSecondsStr, "resource", req.NamespacedName, "error", err.Error())
+		// 로그를 볼 수 없는 사용자도 kubectl describe로 확인할 수 있도록 owner에 Event를 남김
+		r.reportInvalidTTL(obj, ttlAnnotation, ttlSecondsStr, err)
 		return ctrl.Result{}, nil
 	}
+	r.forgetInvalidTTL(req.NamespacedName)
+	ttlSeconds := ttlSecondsCeil(ttl)
+
+	// extend annotation이 있으면 TTL annotation에 반영 (patch로 인한 다음 reconcile에서 TTLResource 갱신)
+	if patched, err := r.consumeExtendAnnotation(ctx, obj, gvk, ttlSeconds, logger); err != nil || patched {
+		return ctrl.Result{}, err
+	}
 
 	logger.Info("[Step1] Found resource", "resource", req.NamespacedName, "kind", gvk, "apiVersion", apiVersion)
 
-	// TTLResource 이름 생성
-	ttlResourceName := "ttl-" + obj.GetName()
+	desiredSpec := ttlv1alpha1.TTLResourceSpec{TTLSeconds: ttlSeconds}
+
+	// Pod와 상위 Deployment에 모두 TTL이 있으면 정책에 따라 유효 만료 시각 결정
+	if pod, ok := obj.(*corev1.Pod); ok {
+		resolved, err := r.resolveConflictingTTL(ctx, pod, ttlSeconds, logger)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if resolved.StartTime != nil {
+			// 상위 Deployment의 TTL과 합쳐 만료 시각을 다시 계산함
+			source = TTLSourceConflictPolicy
+		}
+		desiredSpec = resolved
+	}
+	if desiredSpec.StartTime == nil {
+		// 초 단위로 나누어떨어지지 않는 TTL은 ms 단위로 기록하여 만료 계산에 사용
+		desiredSpec.TTL = preciseTTL(ttl)
+	}
+	// ttl.example.com/from이 있으면 TTLResource 생성 시각 대신 지정한 시각부터 셈
+	if desiredSpec.StartTime == nil {
+		if startTime, ok := ttlFromStartTime(obj, logger); ok {
+			desiredSpec.StartTime = startTime
+		}
+	}
+
+	return r.ensureTTLResource(ctx, obj, target.gvk, desiredSpec, source, logger)
+}
+
+// ensureTTLResource는 리소스에 대한 TTLResource를 desiredSpec으로 생성하거나, 관리하는 spec이 바뀌었으면 업데이트합니다.
+// source는 TTL을 어디에서 가져왔는지(TTLSource*)이며 TTLResource의 ttl-source annotation에 기록됩니다.
+func (r *ResourceReconciler) ensureTTLResource(ctx context.Context, obj client.Object, ownerGVK schema.GroupVersionKind,
+	desiredSpec ttlv1alpha1.TTLResourceSpec, source string, logger logr.Logger) (ctrl.Result, error) {
+	gvk := ownerGVK.Kind
+	apiVersion := ownerGVK.GroupVersion().String()
+
+	// TTLResource 이름 생성 (--ttlresource-name-template, 기본값 "ttl-<name>")
+	ttlResourceName, err := r.TTLResourceNamer.Name(gvk, obj)
+	if err != nil {
+		logger.Error(err, "Failed to generate TTLResource name", "resource", client.ObjectKeyFromObject(obj), "kind", gvk)
+		return ctrl.Result{}, nil
+	}
+	// 만료 시각 분산용 jitter는 TTL을 어떻게 정했든 리소스의 annotation을 따름
+	desiredSpec.JitterSeconds = ttlJitterSeconds(obj, logger)
+	// 알림 대상도 리소스의 annotation을 따름 (없으면 전역 event sink)
+	desiredSpec.NotifyTargets = r.notifyTargets(obj, logger)
 
 	// 기존 TTLResource 확인
 	var existingTTLResource ttlv1alpha1.TTLResource
 	if err := r.Get(ctx, client.ObjectKey{
-		Namespace: req.Namespace,
+		Namespace: obj.GetNamespace(),
 		Name:      ttlResourceName,
 	}, &existingTTLResource); err == nil {
-		// 이미 존재하면 업데이트 (TTL 값이 변경되었을 수 있음)
-		if existingTTLResource.Spec.TTLSeconds != ttlSeconds {
-			existingTTLResource.Spec.TTLSeconds = ttlSeconds
-			// TTL이 변경되면 상태 초기화
-			existingTTLResource.Status = ttlv1alpha1.TTLResourceStatus{}
+		// 리소스가 삭제 후 다시 만들어졌으면 이전 리소스의 TTLResource를 이어 쓰지 않음
+		if ownedByPreviousIncarnation(&existingTTLResource, obj, gvk) {
+			return r.replaceStaleTTLResource(ctx, &existingTTLResource, obj, logger)
+		}
+		// 같은 이름의 다른 Kind 리소스가 이미 이 TTLResource를 쓰고 있으면 그 리소스를 대신 관리하지 않음
+		if other, collided, err := r.ttlResourceNameCollision(ctx, &existingTTLResource, obj, gvk); err != nil {
+			return ctrl.Result{}, err
+		} else if collided {
+			r.reportNameCollision(&existingTTLResource, obj, gvk, other, logger)
+			return ctrl.Result{}, nil
+		} else if other != nil {
+			// 이 TTLResource를 쓰던 리소스가 이미 사라졌으면 새로 만듦
+			return r.replaceStaleTTLResource(ctx, &existingTTLResource, obj, logger)
+		}
+		r.nameCollisionReported.Delete(client.ObjectKeyFromObject(obj).String())
+		// owner에서 TTLResource를 찾을 수 있도록 이름을 annotation으로 남김 (기능을 켜기 전에 만든 TTLResource도 포함)
+		if err := r.annotateOwner(ctx, obj, &existingTTLResource, logger); err != nil {
+			return ctrl.Result{}, err
+		}
+		// keep-alive label이 바뀌었으면 지금부터 TTL을 다시 셈
+		keepAliveChanged := r.applyKeepAlive(&existingTTLResource, obj, &desiredSpec, logger)
+		// 이미 존재하면 업데이트 (TTL 값이나 TTL 출처가 변경되었을 수 있음)
+		specChanged := managedSpecChanged(existingTTLResource.Spec, desiredSpec)
+		// 다른 컨트롤러가 OwnerReference를 바꿔 우리 owner가 빠졌으면 다른 OwnerReference는 그대로 두고 덧붙임
+		// (보존 모드에서 떼어낸 TTLResource는 GC되지 않도록 다시 붙이지 않음)
+		ownerRefAdded := existingTTLResource.Annotations[RetainedOwnersAnnotationKey] == "" &&
+			ensureOwnerReference(&existingTTLResource, ttlOwnerReference(obj, ownerGVK))
+		// 알림 대상만 바뀐 경우에는 만료 상태를 초기화하지 않음
+		notifyChanged := !slices.Equal(existingTTLResource.Spec.NotifyTargets, desiredSpec.NotifyTargets)
+		if specChanged || notifyChanged || ownerRefAdded || keepAliveChanged ||
+			existingTTLResource.Annotations[TTLSourceAnnotationKey] != source {
+			existingTTLResource.Spec.NotifyTargets = desiredSpec.NotifyTargets
+			if specChanged {
+				existingTTLResource.Spec.TTLSeconds = desiredSpec.TTLSeconds
+				existingTTLResource.Spec.TTL = desiredSpec.TTL
+				existingTTLResource.Spec.StartTime = desiredSpec.StartTime
+				existingTTLResource.Spec.JitterSeconds = desiredSpec.JitterSeconds
+				// TTL이 변경되면 상태 초기화
+				existingTTLResource.Status = ttlv1alpha1.TTLResourceStatus{}
+			}
+			if existingTTLResource.Annotations == nil {
+				existingTTLResource.Annotations = map[string]string{}
+			}
+			existingTTLResource.Annotations[TTLSourceAnnotationKey] = source
 			if err := r.Update(ctx, &existingTTLResource); err != nil {
 				if errors.IsConflict(err) {
-					// 충돌 발생 시 재시도하지 않고 TTLResource reconcile에 맡김
-					logger.V(1).Info("[Reconcile1] Conflict updating TTLResource spec, will be handled by TTLResource reconcile", "name", ttlResourceName)
-					return ctrl.Result{}, nil
+					// 충돌 발생 시 TTLResource status 충돌과 같은 rate limiter의 backoff 후 재시도
+					// (TTLResource reconcile은 spec을 고치지 않으므로 여기서 다시 시도해야 새 TTL이 반영됨)
+					r.ConflictLog.Info(logger.V(1), "[Reconcile1] Conflict updating TTLResource spec, will retry", "name", ttlResourceName)
+					return requeueOnConflict(), nil
 				}
 				logger.Error(err, "Failed to update TTLResource", "name", ttlResourceName)
 				return ctrl.Result{}, err
 			}
-			logger.Info("Updated TTLResource", "name", ttlResourceName, "ttlSeconds", ttlSeconds)
+			logger.Info("Updated TTLResource", "name", ttlResourceName, "ttlSeconds", desiredSpec.TTLSeconds, "ttlSource", source)
+		}
+		// TTL이 바뀌면(연장 등) 만료 상태가 초기화되므로 유예 기간의 삭제 예정 표시도 제거
+		if specChanged {
+			if err := r.clearPendingDeletion(ctx, obj, logger); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		// reconcile이 비활성화된 동안 만료 시각이 지났으면 TTLResource 자체의 reconcile이 다시 일어나지 않으므로
+		// 다시 활성화된 지금 바로 만료 처리 (TTL을 처음부터 다시 기다리지 않음)
+		if !specChanged && expiryMissed(&existingTTLResource, r.now()) {
+			logger.Info("TTLResource passed its expiry while reconcile was disabled, expiring it now", "name", ttlResourceName)
+			return r.reconcileTTLResource(ctx, &existingTTLResource, logger)
+		}
+		// reconcile이 비활성화되기 전에 붙인 삭제 예정 표시가 남아 있으면, 만료된 TTLResource는 그 reconcile이
+		// 삭제 예정 시각을 다시 맞추거나 삭제하고, 만료되지 않았으면 표시를 제거
+		if pendingDeletionMarked(obj) {
+			if existingTTLResource.Status.Expired {
+				return r.reconcileTTLResource(ctx, &existingTTLResource, logger)
+			}
+			if err := r.clearPendingDeletion(ctx, obj, logger); err != nil {
+				return ctrl.Result{}, err
+			}
 		}
 		// TTLResource가 이미 존재하고 TTL 값이 같으면 reconcile하지 않음
 		// TTLResource 자체의 reconcile이 만료 관리를 담당
@@ -163,30 +540,31 @@ func (r *ResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		return ctrl.Result{}, err
 	}
 
+	// 삭제할 권한이 없는 Kind는 만료되어도 지울 수 없으므로 TTLResource를 만들지 않고 권한이 생기는지 다시 확인
+	if !r.DeletePermissions.DeleteAllowed(gvk) {
+		logger.V(1).Info("Skipping TTLResource creation, operator is not allowed to delete this kind",
+			"resource", client.ObjectKeyFromObject(obj), "kind", gvk)
+		return ctrl.Result{RequeueAfter: r.DeletePermissions.Interval}, nil
+	}
+
 	// TTLResource 생성
-	ttlResource = &ttlv1alpha1.TTLResource{
+	ttlResource := &ttlv1alpha1.TTLResource{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      ttlResourceName,
-			Namespace: req.Namespace,
+			Namespace: obj.GetNamespace(),
 			Labels: map[string]string{
 				TTLResourceLabelKey:            TTLResourceLabelValue,
 				"app.kubernetes.io/managed-by": "ttl-operator",
 			},
-			OwnerReferences: []metav1.OwnerReference{
-				{
-					APIVersion: apiVersion,
-					Kind:       gvk,
-					Name:       obj.GetName(),
-					UID:        obj.GetUID(),
-				},
-			},
-		},
-		Spec: ttlv1alpha1.TTLResourceSpec{
-			TTLSeconds: ttlSeconds,
+			Annotations:     map[string]string{TTLSourceAnnotationKey: source},
+			OwnerReferences: []metav1.OwnerReference{ttlOwnerReference(obj, ownerGVK)},
 		},
+		Spec: desiredSpec,
 	}
+	// 이후 keep-alive label이 바뀌었는지 알 수 있도록 생성 시점의 값을 기록
+	r.applyKeepAlive(ttlResource, obj, &ttlResource.Spec, logger)
 
-	logger.Info("[Step2] Creating TTLResource", "resource", req.NamespacedName, "kind", gvk, "apiVersion", apiVersion)
+	logger.Info("[Step2] Creating TTLResource", "resource", client.ObjectKeyFromObject(obj), "kind", gvk, "apiVersion", apiVersion)
 
 	if err := r.Create(ctx, ttlResource); err != nil {
 		if errors.IsAlreadyExists(err) {
@@ -196,9 +574,13 @@ func (r *ResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		logger.Error(err, "Failed to create TTLResource", "name", ttlResourceName)
 		return ctrl.Result{}, err
 	}
+	r.Events.Emit(newLifecycleEvent(LifecycleEventCreated, ttlResource, r.now()))
+	if err := r.annotateOwner(ctx, obj, ttlResource, logger); err != nil {
+		return ctrl.Result{}, err
+	}
 
 	// logger.Info("Created TTLResource for resource",
-	// 	"resource", req.NamespacedName,
+	// 	"resource", client.ObjectKeyFromObject(obj),
 	// 	"kind", gvk,
 	// 	"ttlResource", ttlResourceName,
 	// 	"ttlSeconds", ttlSeconds)
@@ -209,64 +591,85 @@ func (r *ResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 
 // cleanupTTLResource는 리소스와 관련된 TTLResource를 삭제합니다.
 func (r *ResourceReconciler) cleanupTTLResource(ctx context.Context, namespacedName client.ObjectKey) (ctrl.Result, error) {
+	names, err := r.ttlResourceNamesFor(ctx, namespacedName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	for _, name := range names {
+		if err := r.cleanupTTLResourceNamed(ctx, client.ObjectKey{Namespace: namespacedName.Namespace, Name: name}); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	return ctrl.Result{}, nil
+}
+
+// cleanupTTLResourceNamed는 resource 컨트롤러가 만든 이름의 TTLResource를 보존 중이 아니면 삭제합니다.
+func (r *ResourceReconciler) cleanupTTLResourceNamed(ctx context.Context, key client.ObjectKey) error {
 	logger := logf.FromContext(ctx)
 
-	ttlResourceName := "ttl-" + namespacedName.Name
+	ttlResourceName := key.Name
 	var ttlResource ttlv1alpha1.TTLResource
-	if err := r.Get(ctx, client.ObjectKey{
-		Namespace: namespacedName.Namespace,
-		Name:      ttlResourceName,
-	}, &ttlResource); err != nil {
+	if err := r.Get(ctx, key, &ttlResource); err != nil {
 		if errors.IsNotFound(err) {
-			return ctrl.Result{}, nil
+			return nil
 		}
-		return ctrl.Result{}, err
+		return err
+	}
+
+	// 보존 중인 TTLResource는 owner가 사라져도 남겨둠
+	if retained(&ttlResource) || ttlResource.Annotations[RetainedOwnersAnnotationKey] != "" {
+		return nil
 	}
 
 	// Resource 컨트롤러가 생성한 TTLResource인지 확인
 	if ttlResource.Labels[TTLResourceLabelKey] == TTLResourceLabelValue {
-		if err := r.Delete(ctx, &ttlResource); err != nil {
+		if err := r.DeletionLimiter.Delete(ctx, r.Client, &ttlResource); err != nil {
 			if !errors.IsNotFound(err) {
 				logger.Error(err, "Failed to delete TTLResource", "name", ttlResourceName)
-				return ctrl.Result{}, err
+				return err
 			}
 		}
 		logger.Info("Deleted TTLResource", "name", ttlResourceName)
 	}
 
-	return ctrl.Result{}, nil
+	return nil
 }
 
 // reconcileTTLResource는 TTLResource의 만료를 관리하고 만료 시 대상 리소스를 삭제합니다.
 func (r *ResourceReconciler) reconcileTTLResource(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource, logger logr.Logger) (ctrl.Result, error) {
-	now := metav1.Now()
-	// TTLSeconds가 0이면 삭제하지 않고 종료
+	now := metav1.NewTime(r.now())
+	// TTLSeconds가 0이면 삭제하지 않고 종료 (--zero-ttl-behavior=invalid이면 잘못된 설정으로 알림)
 	if ttlResource.Spec.TTLSeconds == 0 {
+		if r.ZeroTTLBehavior == ZeroTTLBehaviorInvalid {
+			return r.reportZeroTTL(ctx, ttlResource, logger)
+		}
 		return ctrl.Result{}, nil
 	}
 
-	// Status 업데이트 후 최신 버전을 사용하기 위한 변수
-	var currentTTLResource *ttlv1alpha1.TTLResource
-
-	// Status 업데이트가 필요한지 확인하고 한 번에 처리
-	needsUpdate := false
-
-	// 최초 Reconcile 시 CreatedAt 기록
-	if ttlResource.Status.CreatedAt.IsZero() {
-		ttlResource.Status.CreatedAt = ttlResource.ObjectMeta.CreationTimestamp
-		needsUpdate = true
+	// 보존 모드에서 삭제가 끝난 TTLResource는 기록용이므로 더 이상 처리하지 않음
+	if retained(ttlResource) {
+		return ctrl.Result{}, nil
 	}
 
-	// ExpiredAt 계산
-	if ttlResource.Status.ExpiredAt == nil && !ttlResource.Status.CreatedAt.IsZero() {
-		expireTime := ttlResource.Status.CreatedAt.Add(time.Duration(ttlResource.Spec.TTLSeconds) * time.Second)
-		ttlResource.Status.ExpiredAt = &metav1.Time{Time: expireTime}
-		needsUpdate = true
+	// owner의 reconcile이 비활성화되어 있으면 상태 업데이트와 삭제 모두 하지 않음
+	if owners := ownersOf(ttlResource); len(owners) > 0 {
+		// owner 조회 실패(삭제되었거나 지원하지 않는 Kind)는 이후 단계에서 처리
+		owner, err := r.getOwnerObject(ctx, owners[0], ttlResource.Namespace)
+		if err == nil && reconcileDisabled(owner) {
+			logger.Info("Skipping TTLResource whose owner has reconcile disabled",
+				"name", ttlResource.Name, "owner", owner.GetName())
+			return ctrl.Result{}, nil
+		}
 	}
 
-	// Status 업데이트가 필요하면 한 번에 업데이트
-	if needsUpdate {
-		// 충돌 방지를 위해 최신 버전 다시 가져오기
+	// CreatedAt, ExpiredAt, Expired를 모두 계산한 뒤 바뀐 값이 있으면 한 번의 status 쓰기로 기록
+	// (값마다 따로 쓰면 쓸 때마다 충돌과 재큐잉이 생길 수 있음)
+	currentTTLResource := ttlResource
+	wasExpired := ttlResource.Status.Expired
+	desired := ttlResource.DeepCopy()
+	applyExpiryStatus(desired, now.Time)
+	if wasExpired || statusChanged(&ttlResource.Status, &desired.Status) {
+		// 충돌 방지와 UID 확인을 위해 최신 버전 다시 가져오기
 		latestTTLResource := &ttlv1alpha1.TTLResource{}
 		if err := r.Get(ctx, client.ObjectKey{
 			Namespace: ttlResource.Namespace,
@@ -279,7 +682,7 @@ func (r *ResourceReconciler) reconcileTTLResource(ctx context.Context, ttlResour
 		}
 
 		// UID가 일치하는지 확인 (리소스가 삭제 후 재생성되었는지 확인)
-		if ttlResource.UID != latestTTLResource.UID {
+		if uidMismatch(ttlResource.UID, latestTTLResource.UID) {
 			logger.V(1).Info("TTLResource UID mismatch, resource may have been recreated",
 				"name", latestTTLResource.Name,
 				"oldUID", ttlResource.UID,
@@ -288,122 +691,70 @@ func (r *ResourceReconciler) reconcileTTLResource(ctx context.Context, ttlResour
 			return ctrl.Result{}, nil
 		}
 
-		// 최신 버전에서 Status 업데이트
-		if latestTTLResource.Status.CreatedAt.IsZero() {
-			latestTTLResource.Status.CreatedAt = latestTTLResource.ObjectMeta.CreationTimestamp
-		}
-		if latestTTLResource.Status.ExpiredAt == nil && !latestTTLResource.Status.CreatedAt.IsZero() {
-			expireTime := latestTTLResource.Status.CreatedAt.Add(time.Duration(latestTTLResource.Spec.TTLSeconds) * time.Second)
-			latestTTLResource.Status.ExpiredAt = &metav1.Time{Time: expireTime}
-		}
-
-		if err := r.Status().Update(ctx, latestTTLResource); err != nil {
-			if errors.IsConflict(err) {
-				// 충돌 발생 시 짧은 지연 후 재시도 (무한 루프 방지)
-				logger.V(1).Info("Conflict updating TTLResource status, will retry", "name", latestTTLResource.Name)
-				return ctrl.Result{RequeueAfter: time.Second}, nil
-			}
-			// 리소스가 삭제되었을 수 있음
-			if errors.IsNotFound(err) {
-				logger.V(1).Info("TTLResource not found, may have been deleted", "name", latestTTLResource.Name)
-				return ctrl.Result{}, nil
+		// 최신 버전에서 다시 계산 (다른 reconcile이 먼저 기록했으면 같은 값이므로 쓰지 않음)
+		wasExpired = latestTTLResource.Status.Expired
+		observedStatus := latestTTLResource.Status.DeepCopy()
+		applyExpiryStatus(latestTTLResource, now.Time)
+		if statusChanged(observedStatus, &latestTTLResource.Status) {
+			if err := r.updateStatus(ctx, latestTTLResource); err != nil {
+				if errors.IsConflict(err) {
+					// 충돌 발생 시 rate limiter의 backoff 후 재시도 (무한 루프 방지)
+					r.ConflictLog.Info(logger.V(1), "Conflict updating TTLResource status, will retry", "name", latestTTLResource.Name)
+					return r.retryOnConflict(ctx, latestTTLResource, logger), nil
+				}
+				// 리소스가 삭제되었을 수 있음
+				if errors.IsNotFound(err) {
+					logger.V(1).Info("TTLResource not found, may have been deleted", "name", latestTTLResource.Name)
+					return ctrl.Result{}, nil
+				}
+				logger.Error(err, "Failed to update TTLResource status", "name", latestTTLResource.Name)
+				return ctrl.Result{}, err
 			}
-			return ctrl.Result{}, err
+			logger.Info("[Step4] Completely Updated TTLResource status!", "name", latestTTLResource.Name)
 		}
-		logger.Info("[Step4] Completely Updated TTLResource status!", "name", latestTTLResource.Name)
-		// Status 업데이트 후 최신 버전으로 만료 확인을 계속 진행
 		currentTTLResource = latestTTLResource
-		// Status 업데이트 후 now를 다시 계산하여 만료 확인
-		now = metav1.Now()
-	} else {
-		// Status 업데이트가 필요 없으면 현재 버전 사용
-		currentTTLResource = ttlResource
+	}
+
+	// 외부 도구가 status를 해석하지 않아도 되도록 유효 TTL과 만료 시각을 annotation으로 반영
+	if err := r.syncExpiryAnnotations(ctx, currentTTLResource, logger); err != nil {
+		return ctrl.Result{}, err
+	}
+	// 옵트인하면 owner를 watch하는 도구도 만료 상태를 알 수 있도록 owner의 status.conditions에도 기록
+	r.syncOwnerConditions(ctx, currentTTLResource, logger)
+
+	// 만료 시각 전에 expired가 설정되었으면 status를 직접 수정한 것이므로 삭제하지 않고 되돌림
+	// (status.expiredAt만 과거로 바꾸어 이번 reconcile에서 expired가 된 경우도 포함)
+	if result, reset, err := r.resetPrematureExpired(ctx, currentTTLResource, now.Time, logger); reset || err != nil {
+		return result, err
 	}
 
 	// 이미 만료 처리된 경우 삭제 진행
-	if currentTTLResource.Status.Expired {
+	if wasExpired {
 		logger.Info("[Step5] TTLResource already expired, deleting resources",
 			"name", currentTTLResource.Name,
 			"expiredAt", currentTTLResource.Status.ExpiredAt)
-		// 최신 버전 다시 가져오기 (UID 확인을 위해)
-		latestTTLResource := &ttlv1alpha1.TTLResource{}
-		if err := r.Get(ctx, client.ObjectKey{
-			Namespace: currentTTLResource.Namespace,
-			Name:      currentTTLResource.Name,
-		}, latestTTLResource); err != nil {
-			if errors.IsNotFound(err) {
-				return ctrl.Result{}, nil
-			}
-			return ctrl.Result{}, err
-		}
-
-		// UID가 일치하는지 확인
-		if currentTTLResource.UID != latestTTLResource.UID {
-			logger.V(1).Info("TTLResource UID mismatch, resource may have been recreated", "name", latestTTLResource.Name)
-			return ctrl.Result{}, nil
-		}
+		return r.deleteExpiredResources(ctx, currentTTLResource, logger)
+	}
 
-		// 리소스 삭제 진행
-		return r.deleteExpiredResources(ctx, latestTTLResource, logger)
+	// 이번 reconcile에서 만료 시각이 지난 것을 확인했으면 같은 status 쓰기에서 Expired까지 기록했으므로 바로 삭제
+	if currentTTLResource.Status.Expired {
+		logger.Info("[Step5] TTL expired, starting deletion process",
+			"name", currentTTLResource.Name,
+			"expiredAt", currentTTLResource.Status.ExpiredAt.Time,
+			"now", now.Time,
+			"overdue", now.Time.Sub(expiryTime(currentTTLResource)).String())
+		r.Events.Emit(newLifecycleEvent(LifecycleEventExpiring, currentTTLResource, r.now()))
+		return r.deleteExpiredResources(ctx, currentTTLResource, logger)
 	}
 
-	// TTL 만료 확인 및 삭제
+	// 만료 시간 전 - 남은 시간만큼 재큐잉
+	// TTL이 매우 길면 먼 미래의 타이머 하나에 의존하지 않도록 MaxRequeueAfter마다 다시 확인
 	if currentTTLResource.Status.ExpiredAt != nil {
-		// 만료 시간이 지났는지 확인
-		if !now.Time.Before(currentTTLResource.Status.ExpiredAt.Time) {
-			// 만료 시간이 지났음 - 삭제 진행
-			logger.Info("[Step5] TTL expired, starting deletion process",
-				"name", currentTTLResource.Name,
-				"expiredAt", currentTTLResource.Status.ExpiredAt.Time,
-				"now", now.Time)
-			// 최신 버전 다시 가져오기 (UID 확인을 위해)
-			latestTTLResource := &ttlv1alpha1.TTLResource{}
-			if err := r.Get(ctx, client.ObjectKey{
-				Namespace: currentTTLResource.Namespace,
-				Name:      currentTTLResource.Name,
-			}, latestTTLResource); err != nil {
-				if errors.IsNotFound(err) {
-					return ctrl.Result{}, nil
-				}
-				return ctrl.Result{}, err
-			}
-
-			// UID가 일치하는지 확인 (리소스가 삭제 후 재생성되었는지 확인)
-			if currentTTLResource.UID != latestTTLResource.UID {
-				logger.V(1).Info("TTLResource UID mismatch, resource may have been recreated",
-					"name", latestTTLResource.Name,
-					"oldUID", ttlResource.UID,
-					"newUID", latestTTLResource.UID)
-				// 리소스가 재생성되었으므로 새로운 reconcile을 기다림
-				return ctrl.Result{}, nil
-			}
-
-			// Expired 상태로 업데이트 시도
-			if !latestTTLResource.Status.Expired {
-				latestTTLResource.Status.Expired = true
-				if err := r.Status().Update(ctx, latestTTLResource); err != nil {
-					if errors.IsConflict(err) {
-						// 충돌 발생 시 짧은 지연 후 재시도 (무한 루프 방지)
-						logger.V(1).Info("Conflict updating TTLResource status, will retry", "name", latestTTLResource.Name)
-						return ctrl.Result{RequeueAfter: time.Second}, nil
-					}
-					// 리소스가 삭제되었을 수 있음
-					if errors.IsNotFound(err) {
-						logger.V(1).Info("TTLResource not found, may have been deleted", "name", latestTTLResource.Name)
-						return ctrl.Result{}, nil
-					}
-					logger.Error(err, "Failed to update TTLResource status", "name", latestTTLResource.Name)
-					return ctrl.Result{}, err
-				}
-			}
-
-			// 리소스 삭제 진행
-			return r.deleteExpiredResources(ctx, latestTTLResource, logger)
-		} else {
-			// 만료 시간 전 - 남은 시간만큼 재큐잉
-			requeueAfter := currentTTLResource.Status.ExpiredAt.Time.Sub(now.Time)
-			return ctrl.Result{RequeueAfter: requeueAfter}, nil
+		requeueAfter := expiryTime(currentTTLResource).Sub(now.Time)
+		if r.MaxRequeueAfter > 0 && requeueAfter > r.MaxRequeueAfter {
+			requeueAfter = r.MaxRequeueAfter
 		}
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
 	}
 
 	return ctrl.Result{}, nil
@@ -413,19 +764,232 @@ func (r *ResourceReconciler) reconcileTTLResource(ctx context.Context, ttlResour
 func (r *ResourceReconciler) deleteExpiredResources(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource, logger logr.Logger) (ctrl.Result, error) {
 	// OwnerReference를 통해 대상 리소스 삭제
 	logger.Info("[Step6] deleteExpiredResources() Deleting expired resources", "name", ttlResource.Name)
-	if len(ttlResource.OwnerReferences) > 0 {
-		ownerRef := ttlResource.OwnerReferences[0]
-		if err := r.deleteOwnerResource(ctx, ownerRef, ttlResource.Namespace); err != nil {
-			logger.Error(err, "Failed to delete owner resource", "ownerRef", ownerRef)
-			// Owner 리소스 삭제 실패해도 TTLResource는 삭제
-		} else {
-			logger.Info("Deleted owner resource", "kind", ownerRef.Kind, "name", ownerRef.Name)
+
+	// 네임스페이스에 suspend annotation이 있으면 풀릴 때까지 어떤 삭제도 하지 않음
+	suspended, err := r.namespaceSuspended(ctx, ttlResource.Namespace)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if suspended {
+		// ignore-suspend가 있는 리소스는 suspend 중에도 삭제 (--allow-ignore-suspend가 켜져 있을 때만)
+		bypass, err := r.ignoresSuspend(ctx, ttlResource)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !bypass {
+			return r.deferForSuspendedNamespace(ctx, ttlResource, logger)
+		}
+		logger.Info("Bypassing namespace suspend for resource annotated with ignore-suspend",
+			"name", ttlResource.Name, "namespace", ttlResource.Namespace)
+	}
+
+	// 확인이 필요한 네임스페이스에서는 confirm-delete annotation이 추가될 때까지 삭제하지 않음
+	if r.requiresConfirmation(ttlResource) && !deletionConfirmed(ttlResource) {
+		return r.awaitConfirmation(ctx, ttlResource, logger)
+	}
+
+	// targetRef와 OwnerReference가 서로 다른 리소스를 가리키면 잘못된 리소스를 지우지 않도록 삭제를 거부
+	// 컨트롤러가 만든 TTLResource에 다른 컨트롤러의 OwnerReference만 남아 있어도 그 리소스를 지우지 않음
+	owners, err := r.deletionTargets(ttlResource)
+	if stderrors.Is(err, errNoManagedOwner) {
+		logger.Error(err, "Refusing to delete expired resources", "name", ttlResource.Name)
+		r.recordEvent(ttlResource, corev1.EventTypeWarning, ConditionNoManagedOwner, err.Error())
+		return r.deferDeletion(ctx, ttlResource, metav1.Condition{
+			Type:    ConditionNoManagedOwner,
+			Status:  metav1.ConditionTrue,
+			Reason:  "OnlyForeignOwners",
+			Message: err.Error(),
+		}, 0, logger)
+	} else if err != nil {
+		logger.Error(err, "Refusing to delete expired resources", "name", ttlResource.Name)
+		r.recordEvent(ttlResource, corev1.EventTypeWarning, ConditionTargetMismatch, err.Error())
+		return r.deferDeletion(ctx, ttlResource, metav1.Condition{
+			Type:    ConditionTargetMismatch,
+			Status:  metav1.ConditionTrue,
+			Reason:  "TargetRefMismatch",
+			Message: err.Error(),
+		}, 0, logger)
+	}
+
+	// targetSelector가 있으면 일치하는 리소스를 모두 삭제 대상에 더함 (MaxDeletesPerCycle로 나눠 삭제)
+	if ttlResource.Spec.TargetSelector != nil {
+		selected, err := r.selectorTargets(ctx, ttlResource)
+		if stderrors.Is(err, errInvalidTargetSelector) {
+			logger.Error(err, "Refusing to delete expired resources", "name", ttlResource.Name)
+			r.recordEvent(ttlResource, corev1.EventTypeWarning, ConditionInvalidTargetSelector, err.Error())
+			return r.deferDeletion(ctx, ttlResource, metav1.Condition{
+				Type:    ConditionInvalidTargetSelector,
+				Status:  metav1.ConditionTrue,
+				Reason:  "InvalidSelector",
+				Message: err.Error(),
+			}, 0, logger)
+		} else if err != nil {
+			return ctrl.Result{}, err
+		}
+		owners = appendSelectorTargets(owners, selected)
+	}
+
+	// targetRef로 지정한 watch하지 않는 Kind를 조회할 권한이 없으면 다른 확인보다 먼저 원인을 알림
+	if unreadable := r.checkUnwatchedTargets(ctx, ttlResource, owners, logger); len(unreadable.forbidden) > 0 {
+		return r.deferForbiddenDeletion(ctx, ttlResource, unreadable.forbidden, logger)
+	} else if len(unreadable.failed) > 0 {
+		return r.deferFailedDeletion(ctx, ttlResource, unreadable.failed, logger)
+	}
+
+	// 유예 기간이 끝날 때까지 owner에 삭제 예정 시각을 표시하고 삭제를 미룸
+	if r.DeletionGracePeriod > 0 && r.now().Before(r.gracePeriodEnd(ttlResource)) {
+		return r.deferForGracePeriod(ctx, ttlResource, owners, logger)
+	}
+
+	if len(owners) == 0 && r.soaking() {
+		return r.reportSoakDeletion(ctx, ttlResource, nil, logger)
+	}
+
+	if len(owners) > 0 {
+		ownerRef := owners[0]
+
+		// delete-after로 지정된 의존 리소스가 남아 있으면 삭제를 미룸
+		dependency, waiting, err := r.waitForDeleteAfter(ctx, owners, ttlResource.Namespace, logger)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if waiting {
+			return r.deferDeletion(ctx, ttlResource, metav1.Condition{
+				Type:    ConditionWaitingForDependency,
+				Status:  metav1.ConditionTrue,
+				Reason:  "DeleteAfter",
+				Message: fmt.Sprintf("waiting for %s to be deleted first", dependency.String()),
+			}, deleteAfterRequeueInterval, logger)
+		}
+
+		// delete-if 식이 최신 owner 객체에서 true가 아니면 삭제를 미루고 주기적으로 다시 평가
+		if condition, waiting, err := r.waitForDeleteIf(ctx, ttlResource, ownerRef, logger); err != nil {
+			return ctrl.Result{}, err
+		} else if waiting {
+			return r.deferDeletion(ctx, ttlResource, condition, deleteIfRequeueInterval, logger)
+		}
+
+		// wait-for-lease로 지정한 Lease를 다른 프로세스가 잡고 있으면 풀릴 때까지 삭제를 미룸
+		if condition, requeueAfter, waiting, err := r.waitForLease(ctx, ttlResource, ownerRef, logger); err != nil {
+			return ctrl.Result{}, err
+		} else if waiting {
+			return r.deferDeletion(ctx, ttlResource, condition, requeueAfter, logger)
+		}
+
+		// 만료되었더라도 owner가 아직 사용 중(활동량이 기준 이상)이면 삭제를 미루고 나중에 다시 확인
+		if condition, waiting, err := r.waitForActivity(ctx, ttlResource, ownerRef, logger); err != nil {
+			return ctrl.Result{}, err
+		} else if waiting {
+			return r.deferDeletion(ctx, ttlResource, condition, r.activityRecheckInterval(), logger)
+		}
+
+		// 삭제 허용 label이 필요한데 없는 owner가 있으면 삭제하지 않음
+		if condition, waiting, err := r.waitForDeleteOptIn(ctx, ttlResource, owners, logger); err != nil {
+			return ctrl.Result{}, err
+		} else if waiting {
+			return r.deferDeletion(ctx, ttlResource, condition, deleteOptInRequeueInterval, logger)
+		}
+
+		// soak 기간에는 다른 조건을 모두 통과했더라도 삭제하지 않고 삭제할 대상만 알림
+		if r.soaking() {
+			return r.reportSoakDeletion(ctx, ttlResource, owners, logger)
+		}
+
+		// 보존 모드에서는 owner 삭제 시 TTLResource가 GC되지 않도록 먼저 OwnerReference를 떼어냄
+		if r.retainAfterExpiry(ttlResource) && len(ttlResource.OwnerReferences) > 0 {
+			return r.detachOwners(ctx, ttlResource, logger)
+		}
+
+		// 재시도 횟수 상한을 넘은 TTLResource는 상한을 올릴 때까지 다시 삭제를 시도하지 않음
+		if r.deletionRetriesExhausted(ttlResource, logger) {
+			logger.V(1).Info("Deletion retries exhausted, not retrying", "name", ttlResource.Name,
+				"retryCount", ttlResource.Status.RetryCount)
+			return ctrl.Result{}, nil
+		}
+
+		// drain-seconds가 있는 Service는 endpoint를 비운 뒤 진행 중인 연결이 끝날 때까지 기다렸다가 삭제
+		if wait, draining, err := r.drainServices(ctx, ttlResource, owners, logger); err != nil {
+			return ctrl.Result{}, err
+		} else if wait > 0 {
+			return r.deferDeletion(ctx, ttlResource, metav1.Condition{
+				Type:    ConditionDraining,
+				Status:  metav1.ConditionTrue,
+				Reason:  "DrainPeriod",
+				Message: fmt.Sprintf("draining Service %s before deletion", strings.Join(draining, ", ")),
+			}, wait, logger)
+		}
+
+		// owner가 많으면 한 번에 MaxDeletesPerCycle개까지만 삭제하고 재큐잉
+		result, err := r.deleteOwnersInBatch(ctx, ttlResource, owners, logger)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		r.logExpiryAudit(ttlResource, result.deleted, r.now(), logger)
+		if result.throttled > 0 {
+			// API 서버가 늦추라고 응답하면 실패로 세지 않고 서버가 제안한 지연 후에 남은 대상을 이어서 삭제
+			logger.Info("API server is throttling deletions, retrying after the suggested delay",
+				"name", ttlResource.Name, "retryAfter", result.throttled.String())
+			r.recordRequeueReason(ctx, ttlResource, RequeueReasonAPIThrottled, logger)
+			return ctrl.Result{RequeueAfter: result.throttled}, nil
+		}
+		if len(result.forbidden) > 0 {
+			// 삭제가 거부된 대상이 있으면 TTLResource를 남겨두고 backoff 후 다시 시도
+			return r.deferForbiddenDeletion(ctx, ttlResource, result.forbidden, logger)
+		}
+		if len(result.failed) > 0 {
+			// 삭제에 실패한 대상이 있으면 TTLResource를 남겨두어 만료 후에도 남은 owner를 추적하고 재시도 횟수를 기록
+			return r.deferFailedDeletion(ctx, ttlResource, result.failed, logger)
+		}
+		if len(result.blockedByHook) > 0 {
+			// hook이 삭제를 막은 대상이 있으면 TTLResource를 남겨두고 나중에 다시 시도
+			return r.deferDeletion(ctx, ttlResource, metav1.Condition{
+				Type:    ConditionBlockedByHook,
+				Status:  metav1.ConditionTrue,
+				Reason:  "HookRejected",
+				Message: strings.Join(result.blockedByHook, "; "),
+			}, hookRequeueInterval, logger)
+		}
+		if len(result.blockedByPDB) > 0 {
+			// PodDisruptionBudget에 막힌 Pod가 있으면 TTLResource를 남겨두고 나중에 다시 시도
+			return r.deferDeletion(ctx, ttlResource, metav1.Condition{
+				Type:    ConditionBlockedByPDB,
+				Status:  metav1.ConditionTrue,
+				Reason:  "EvictionRejected",
+				Message: fmt.Sprintf("eviction of %s would violate a PodDisruptionBudget", strings.Join(result.blockedByPDB, ", ")),
+			}, pdbRequeueInterval, logger)
+		}
+		if result.overBudget > 0 {
+			// 전역 삭제 한도에 걸리면 실패로 세지 않고 한도에 여유가 생긴 뒤 남은 대상을 이어서 삭제
+			deletionsThrottledTotal.Add(float64(result.overBudget))
+			logger.Info("Global deletion budget exhausted, throttling deletions", "name", ttlResource.Name,
+				"deferred", result.overBudget, "retryAfter", result.budgetWait.String())
+			r.recordRequeueReason(ctx, ttlResource, RequeueReasonDeletionBudget, logger)
+			return ctrl.Result{RequeueAfter: result.budgetWait}, nil
+		}
+		if result.remaining > 0 {
+			return r.recordRemainingDeletions(ctx, ttlResource, result.remaining, logger)
+		}
+		if len(result.waitingForLB) > 0 {
+			// 클라우드 load balancer 정리가 끝날 때까지 TTLResource를 남겨둠
+			return r.deferDeletion(ctx, ttlResource, metav1.Condition{
+				Type:    ConditionWaitingForLBCleanup,
+				Status:  metav1.ConditionTrue,
+				Reason:  "LoadBalancerFinalizerPresent",
+				Message: fmt.Sprintf("waiting for load balancer cleanup of Service %s", strings.Join(result.waitingForLB, ", ")),
+			}, lbCleanupRequeueInterval, logger)
 		}
 	}
 
+	// 보존 모드에서는 TTLResource를 삭제하지 않고 삭제 시각을 기록
+	if r.retainAfterExpiry(ttlResource) {
+		r.Events.Emit(newLifecycleEvent(LifecycleEventDeleted, ttlResource, r.now()))
+		expiredResourcesTotal.WithLabelValues(ownersMetricKind(owners)).Inc()
+		return r.recordDeletion(ctx, ttlResource, logger)
+	}
+
 	// TTL 만료 시 TTLResource 삭제
 	logger.Info("[Step7] deleteExpiredResources() Deleting TTLResource", "name", ttlResource.Name)
-	if err := r.Delete(ctx, ttlResource); err != nil {
+	if err := r.DeletionLimiter.Delete(ctx, r.Client, ttlResource); err != nil {
 		if errors.IsNotFound(err) {
 			// 이미 삭제된 경우 무시
 			return ctrl.Result{}, nil
@@ -434,61 +998,291 @@ func (r *ResourceReconciler) deleteExpiredResources(ctx context.Context, ttlReso
 	}
 
 	logger.Info("TTLResource expired and deleted", "name", ttlResource.Name)
+	expiredResourcesTotal.WithLabelValues(ownersMetricKind(owners)).Inc()
+	r.Events.Emit(newLifecycleEvent(LifecycleEventDeleted, ttlResource, r.now()))
 	return ctrl.Result{}, nil
 }
 
-// deleteOwnerResource는 OwnerReference를 통해 대상 리소스를 삭제합니다.
-func (r *ResourceReconciler) deleteOwnerResource(ctx context.Context, ownerRef metav1.OwnerReference, namespace string) error {
+// deleteOwnerResource는 OwnerReference를 통해 대상 리소스를 삭제하고, 실제로 수행한 동작(delete, evict, trash)을 반환합니다.
+func (r *ResourceReconciler) deleteOwnerResource(ctx context.Context, ownerRef metav1.OwnerReference, namespace string) (string, error) {
+	obj, gvk, err := newOwnerObject(ownerRef, namespace)
+	if err != nil {
+		if gvk.Empty() {
+			return "", err
+		}
+		// deleteOwnersInBatch는 watch하지 않는 Kind를 targetRef로 지정한 경우에만 넘기므로 unstructured로 삭제
+		obj = newUnwatchedObject(gvk, ownerRef.Name, namespace)
+	}
+
+	// owner의 annotation과 Kind별 기본값으로 삭제 방식 결정 (owner 조회에 실패하면 기본값 사용)
+	logger := logf.FromContext(ctx)
+	owner, _ := r.getOwnerObject(ctx, ownerRef, namespace)
+	policy := r.deletionPolicyFor(gvk.Kind, owner, logger)
+
+	// Pod에 termination-grace-seconds annotation이 있으면 삭제 시 유예 시간으로 사용
+	var gracePeriod *int64
+	if owner != nil {
+		gracePeriod = terminationGracePeriod(owner, logger)
+	}
+
+	// evict 동작이면 PodDisruptionBudget을 지키도록 Pod는 Eviction으로 삭제
+	if policy.Action == ExpiryActionEvict && gvk == supportedKinds["Pod"] {
+		return ExpiryActionEvict, r.evictPod(ctx, ownerRef.Name, namespace, gracePeriod)
+	}
+
+	// trash 동작이면 삭제 전에 보관용 네임스페이스에 사본을 만듦 (사본을 만들지 못하면 삭제하지 않음)
+	action := ExpiryActionDelete
+	if policy.Action == ExpiryActionTrash && owner != nil {
+		if err := r.moveToTrash(ctx, owner, logger); err != nil {
+			return ExpiryActionTrash, err
+		}
+		action = ExpiryActionTrash
+	}
+
+	var opts []client.DeleteOption
+	if gracePeriod != nil {
+		opts = append(opts, client.GracePeriodSeconds(*gracePeriod))
+	}
+	if policy.PropagationPolicy != "" {
+		opts = append(opts, client.PropagationPolicy(policy.PropagationPolicy))
+	}
+	if err := r.DeletionLimiter.Delete(ctx, r.Client, obj, opts...); err != nil {
+		if errors.IsNotFound(err) {
+			// 이미 삭제된 경우는 정상으로 처리
+			return action, nil
+		}
+		return action, fmt.Errorf("failed to delete owner resource %s/%s/%s: %w", gvk.Kind, namespace, ownerRef.Name, err)
+	}
+
+	return action, nil
+}
+
+// getOwnerObject는 OwnerReference가 가리키는 대상 리소스를 조회합니다.
+// watch하지 않는 Kind(targetRef로 지정한 커스텀 리소스 등)는 unstructured로 조회합니다.
+func (r *ResourceReconciler) getOwnerObject(ctx context.Context, ownerRef metav1.OwnerReference, namespace string) (client.Object, error) {
+	obj, gvk, err := newOwnerObject(ownerRef, namespace)
+	if err != nil {
+		if gvk.Empty() {
+			return nil, err
+		}
+		obj = newUnwatchedObject(gvk, ownerRef.Name, namespace)
+	}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// newOwnerObject는 OwnerReference에 해당하는 빈 객체를 이름과 네임스페이스만 채워 반환합니다.
+func newOwnerObject(ownerRef metav1.OwnerReference, namespace string) (client.Object, schema.GroupVersionKind, error) {
 	gv, err := schema.ParseGroupVersion(ownerRef.APIVersion)
 	if err != nil {
-		return fmt.Errorf("invalid apiVersion: %w", err)
+		return nil, schema.GroupVersionKind{}, fmt.Errorf("invalid apiVersion: %w", err)
 	}
 
-	gvk := schema.GroupVersionKind{
-		Group:   gv.Group,
-		Version: gv.Version,
-		Kind:    ownerRef.Kind,
+	gvk := gv.WithKind(ownerRef.Kind)
+	obj, err := newObjectForGVK(gvk)
+	if err != nil {
+		return nil, gvk, err
 	}
 
-	var obj client.Object
+	obj.SetName(ownerRef.Name)
+	obj.SetNamespace(namespace)
+	return obj, gvk, nil
+}
+
+// supportedKinds는 TTL을 적용할 수 있는 리소스의 Kind와 GVK 매핑입니다.
+var supportedKinds = map[string]schema.GroupVersionKind{
+	"Pod":        {Group: "", Version: "v1", Kind: "Pod"},
+	"Service":    {Group: "", Version: "v1", Kind: "Service"},
+	"Deployment": {Group: "apps", Version: "v1", Kind: "Deployment"},
+	"ConfigMap":  {Group: "", Version: "v1", Kind: "ConfigMap"},
+	"Job":        {Group: "batch", Version: "v1", Kind: "Job"},
+	// Endpoints와 EndpointSlice는 --enable-endpoint-kinds일 때만 watch함
+	"Endpoints":     {Group: "", Version: "v1", Kind: "Endpoints"},
+	"EndpointSlice": {Group: "discovery.k8s.io", Version: "v1", Kind: "EndpointSlice"},
+}
+
+// kindFallbackOrder는 같은 이름의 리소스가 여러 Kind로 존재할 때 대상을 고르는 순서입니다.
+var kindFallbackOrder = []string{"Pod", "Service", "Deployment", "ConfigMap", "Job"}
+
+// newObjectForGVK는 지원하는 GVK에 해당하는 빈 객체를 생성합니다.
+func newObjectForGVK(gvk schema.GroupVersionKind) (client.Object, error) {
 	switch gvk {
-	case schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"}:
-		obj = &corev1.Pod{}
-	case schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Service"}:
-		obj = &corev1.Service{}
-	case schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}:
-		obj = &appsv1.Deployment{}
+	case supportedKinds["Pod"]:
+		return &corev1.Pod{}, nil
+	case supportedKinds["Service"]:
+		return &corev1.Service{}, nil
+	case supportedKinds["Deployment"]:
+		return &appsv1.Deployment{}, nil
+	case supportedKinds["ConfigMap"]:
+		return &corev1.ConfigMap{}, nil
+	case supportedKinds["Job"]:
+		return &batchv1.Job{}, nil
+	case supportedKinds["Endpoints"]:
+		return &corev1.Endpoints{}, nil //nolint:staticcheck // 직접 만든 Endpoints를 정리하기 위해 deprecated API도 지원
+	case supportedKinds["EndpointSlice"]:
+		return &discoveryv1.EndpointSlice{}, nil
 	default:
-		return fmt.Errorf("unsupported resource type: %s", gvk.String())
+		return nil, fmt.Errorf("unsupported resource type: %s", gvk.String())
 	}
+}
 
-	obj.SetName(ownerRef.Name)
-	obj.SetNamespace(namespace)
+// ttlStartTime은 TTL 카운트다운의 기준 시각을 반환합니다.
+// Spec.StartTime이 없으면 TTLResource 생성 시각을 기준으로 합니다.
+func ttlStartTime(ttlResource *ttlv1alpha1.TTLResource) metav1.Time {
+	if ttlResource.Spec.StartTime != nil {
+		return *ttlResource.Spec.StartTime
+	}
+	return ttlResource.ObjectMeta.CreationTimestamp
+}
 
-	if err := r.Delete(ctx, obj); err != nil {
-		if errors.IsNotFound(err) {
-			// 이미 삭제된 경우는 정상으로 처리
-			return nil
+// expiryMissed는 TTLResource의 만료 시각이 now 이전인데 아직 만료 처리(status.expired)가 되지 않았는지 확인합니다.
+// status.expiredAt이 아직 기록되지 않았으면 시작 시각에 TTL을 더해 판단합니다.
+func expiryMissed(ttlResource *ttlv1alpha1.TTLResource, now time.Time) bool {
+	if ttlResource.Spec.TTLSeconds <= 0 || ttlResource.Status.Expired || retained(ttlResource) {
+		return false
+	}
+	if ttlResource.Status.ExpiredAt != nil {
+		return !now.Before(expiryTime(ttlResource))
+	}
+	start := ttlStartTime(ttlResource)
+	if start.IsZero() {
+		return false
+	}
+	return !now.Before(start.Add(ttlDuration(ttlResource.Spec)))
+}
+
+// managedSpecChanged는 resource 컨트롤러가 관리하는 spec 필드가 바뀌었는지 확인합니다.
+func managedSpecChanged(existing, desired ttlv1alpha1.TTLResourceSpec) bool {
+	if existing.TTLSeconds != desired.TTLSeconds || existing.JitterSeconds != desired.JitterSeconds {
+		return true
+	}
+	if ttlDuration(existing) != ttlDuration(desired) {
+		return true
+	}
+	if (existing.StartTime == nil) != (desired.StartTime == nil) {
+		return true
+	}
+	return existing.StartTime != nil && !existing.StartTime.Equal(desired.StartTime)
+}
+
+// reconcileDisabled는 리소스에 reconcile 비활성화 annotation이 있는지 확인합니다.
+func reconcileDisabled(obj client.Object) bool {
+	return obj.GetAnnotations()[ReconcileAnnotationKey] == ReconcileDisabledValue
+}
+
+// candidate는 요청 이름과 일치하는 리소스와 그 GVK입니다.
+type candidate struct {
+	obj client.Object
+	gvk schema.GroupVersionKind
+}
+
+// findCandidates는 watchedKinds 순서대로 요청 이름과 같은 리소스를 모두 조회합니다.
+func (r *ResourceReconciler) findCandidates(ctx context.Context, key client.ObjectKey) ([]candidate, error) {
+	var candidates []candidate
+	for _, kind := range r.watchedKinds() {
+		gvk := supportedKinds[kind]
+		obj, err := newObjectForGVK(gvk)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("failed to delete owner resource %s/%s/%s: %w", gvk.Kind, namespace, ownerRef.Name, err)
+		if err := r.Get(ctx, key, obj); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		candidates = append(candidates, candidate{obj: obj, gvk: gvk})
 	}
+	return candidates, nil
+}
 
-	return nil
+// selectCandidate는 같은 이름의 리소스 중 TTL을 적용할 대상을 고릅니다.
+// 어느 리소스든 target-kind annotation이 있으면 해당 Kind를 선택하고,
+// 없으면 kindFallbackOrder에서 가장 앞선 리소스를 선택합니다.
+func selectCandidate(candidates []candidate, logger logr.Logger) candidate {
+	for _, c := range candidates {
+		targetKind, ok := c.obj.GetAnnotations()[TargetKindAnnotationKey]
+		if !ok {
+			continue
+		}
+		for _, other := range candidates {
+			if other.gvk.Kind == targetKind {
+				return other
+			}
+		}
+		logger.Info("target-kind annotation does not match any resource with the same name, using fallback order",
+			"targetKind", targetKind, "name", c.obj.GetName())
+		break
+	}
+	return candidates[0]
+}
+
+// uidMismatch는 두 UID가 모두 존재하고 서로 다를 때만 true를 반환합니다.
+// 직접 작성한 TTLResource처럼 UID가 비어 있는 경우에는 재생성 여부를 판단하지 않습니다.
+func uidMismatch(a, b types.UID) bool {
+	return a != "" && b != "" && a != b
 }
 
 // SetupWithManager sets up the controller with the Manager.
-// Pod, Service, Deployment, TTLResource를 모두 watch합니다.
+// Pod, Service, Deployment, ConfigMap, TTLResource, 네임스페이스를 watch하고, ttl-from-secret이 켜져 있으면 Secret의 metadata도 watch합니다.
 func (r *ResourceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// 이름 템플릿을 사용하면 owner가 사라진 뒤에도 TTLResource를 찾을 수 있도록 owner 이름으로 index
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &ttlv1alpha1.TTLResource{},
+		ttlResourceOwnerIndex, r.TTLResourceNamer.indexTTLResourceOwner); err != nil {
+		return err
+	}
+
 	// Pod를 primary resource로 설정
 	builder := ctrl.NewControllerManagedBy(mgr).
 		Named("resource-ttl").
 		For(&corev1.Pod{})
 
-	// Service, Deployment, TTLResource도 watch
+	// owner 순회가 켜져 있으면 Deployment의 TTL 변경 시 상속받은 Pod도 다시 reconcile
+	var deploymentHandler handler.EventHandler = &handler.EnqueueRequestForObject{}
+	if r.OwnerTraversalDepth > 0 {
+		deploymentHandler = handler.EnqueueRequestsFromMapFunc(r.requestsForDeploymentTree)
+	}
+
+	// Service, Deployment, ConfigMap, Job, TTLResource도 watch
+	// ConfigMap watch는 클러스터의 모든 ConfigMap(data 포함)을 informer cache에 올리므로 ConfigMap이 많으면 메모리 사용량이 늘어납니다
 	builder = builder.
 		Watches(&corev1.Service{}, &handler.EnqueueRequestForObject{}).
-		Watches(&appsv1.Deployment{}, &handler.EnqueueRequestForObject{}).
-		Watches(&ttlv1alpha1.TTLResource{}, &handler.EnqueueRequestForObject{})
+		Watches(&appsv1.Deployment{}, deploymentHandler).
+		Watches(&corev1.ConfigMap{}, &handler.EnqueueRequestForObject{}).
+		Watches(&batchv1.Job{}, &handler.EnqueueRequestForObject{}).
+		// status만 바뀐 업데이트는 reconcile 도중 직접 기록한 것이므로 다시 reconcile하지 않음
+		Watches(&ttlv1alpha1.TTLResource{}, &handler.EnqueueRequestForObject{},
+			ctrlbuilder.WithPredicates(ttlResourceMetadataOrSpecChanged)).
+		// 부모 TTLResource의 만료 시각이 바뀌면 relative-to로 가리키는 리소스를 다시 reconcile
+		Watches(&ttlv1alpha1.TTLResource{},
+			handler.EnqueueRequestsFromMapFunc(r.requestsForRelativeChildren),
+			ctrlbuilder.WithPredicates(relativeParentExpiryChanged)).
+		// TTLResource가 만료 전에 삭제되면 아직 annotation이 있는 owner에 대해 다시 생성
+		Watches(&ttlv1alpha1.TTLResource{},
+			handler.EnqueueRequestsFromMapFunc(r.requestsForDeletedTTLResource),
+			ctrlbuilder.WithPredicates(managedTTLResourceDeleted)).
+		// 네임스페이스 기본 TTL이 바뀌면 기본값을 상속하는 리소스를 다시 reconcile
+		Watches(&corev1.Namespace{},
+			handler.EnqueueRequestsFromMapFunc(r.requestsForNamespaceDefault),
+			ctrlbuilder.WithPredicates(namespaceDefaultChanged)).
+		// 네임스페이스 suspend가 바뀌면 만료된 TTLResource를 다시 reconcile
+		Watches(&corev1.Namespace{},
+			handler.EnqueueRequestsFromMapFunc(r.requestsForSuspendedNamespace),
+			ctrlbuilder.WithPredicates(namespaceSuspendChanged))
+	if r.EnableEndpointKinds {
+		for _, obj := range endpointObjects() {
+			builder = builder.Watches(obj, &handler.EnqueueRequestForObject{})
+		}
+	}
+	if r.EnableTTLFromSecret {
+		// ttl-from-secret이 가리키는 Secret이 바뀌면 그 Secret에서 TTL을 읽는 리소스를 다시 reconcile
+		// Secret의 내용은 캐시하지 않도록 metadata만 watch하고, 값은 필요할 때 APIReader로 읽음
+		builder = builder.WatchesMetadata(&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.requestsForTTLSecret))
+	}
 
-	return builder.Complete(r)
+	// 같은 리소스의 반복 충돌이 API 서버를 두드리지 않도록 리소스별 backoff 적용
+	return builder.WithOptions(controller.Options{RateLimiter: r.rateLimiter()}).Complete(r)
 }
@@ -0,0 +1,367 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// 아래 테스트는 envtest 없이 fake client로 Reconcile 흐름을 검증합니다.
+// fake client는 UID와 CreationTimestamp를 채워주지 않으므로 필요한 값은 테스트에서 직접 지정합니다.
+
+func newTestScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	NewWithT(t).Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+	NewWithT(t).Expect(ttlv1alpha1.AddToScheme(scheme)).To(Succeed())
+	return scheme
+}
+
+func newTestReconciler(t *testing.T, objs ...client.Object) *ResourceReconciler {
+	t.Helper()
+	scheme := newTestScheme(t)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&ttlv1alpha1.TTLResource{}).
+		Build()
+	return &ResourceReconciler{Client: c, Scheme: scheme}
+}
+
+func reconcileKey(t *testing.T, r *ResourceReconciler, namespace, name string) ctrl.Result {
+	t.Helper()
+	result, err := r.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: client.ObjectKey{Namespace: namespace, Name: name},
+	})
+	NewWithT(t).Expect(err).NotTo(HaveOccurred())
+	return result
+}
+
+func TestUIDMismatch(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(uidMismatch("a", "b")).To(BeTrue())
+	g.Expect(uidMismatch("a", "a")).To(BeFalse())
+	g.Expect(uidMismatch("", "b")).To(BeFalse())
+	g.Expect(uidMismatch("a", "")).To(BeFalse())
+	g.Expect(uidMismatch("", "")).To(BeFalse())
+}
+
+func TestReconcileTTLResourceWithoutUIDPending(t *testing.T) {
+	g := NewWithT(t)
+
+	created := metav1.NewTime(time.Now().Add(-10 * time.Second))
+	r := newTestReconciler(t, &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "manual",
+			Namespace:         "default",
+			CreationTimestamp: created,
+		},
+		Spec: ttlv1alpha1.TTLResourceSpec{TTLSeconds: 3600},
+	})
+
+	result := reconcileKey(t, r, "default", "manual")
+	g.Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "manual"}, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.Status.CreatedAt.Time.Unix()).To(Equal(created.Unix()))
+	g.Expect(ttlResource.Status.ExpiredAt).NotTo(BeNil())
+	g.Expect(ttlResource.Status.Expired).To(BeFalse())
+}
+
+func TestMaxRequeueAfterCapsLongTTL(t *testing.T) {
+	g := NewWithT(t)
+
+	for _, tc := range []struct {
+		ttlSeconds int
+		max        time.Duration
+		atMost     time.Duration
+		atLeast    time.Duration
+	}{
+		// 30일 TTL은 1시간마다 다시 확인
+		{ttlSeconds: 30 * 24 * 3600, max: time.Hour, atMost: time.Hour, atLeast: time.Hour},
+		// 상한보다 짧은 TTL은 만료 시각까지 기다림
+		{ttlSeconds: 600, max: time.Hour, atMost: 10 * time.Minute, atLeast: 9 * time.Minute},
+		// 0이면 상한 없음
+		{ttlSeconds: 30 * 24 * 3600, max: 0, atMost: 30 * 24 * time.Hour, atLeast: 29 * 24 * time.Hour},
+	} {
+		r := newTestReconciler(t, &ttlv1alpha1.TTLResource{
+			ObjectMeta: metav1.ObjectMeta{Name: "long", Namespace: "default", CreationTimestamp: metav1.Now()},
+			Spec:       ttlv1alpha1.TTLResourceSpec{TTLSeconds: tc.ttlSeconds},
+		})
+		r.MaxRequeueAfter = tc.max
+
+		result := reconcileKey(t, r, "default", "long")
+		g.Expect(result.RequeueAfter).To(BeNumerically("<=", tc.atMost))
+		g.Expect(result.RequeueAfter).To(BeNumerically(">=", tc.atLeast))
+	}
+}
+
+func TestReconcileTTLResourceWithoutUIDExpired(t *testing.T) {
+	g := NewWithT(t)
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	r := newTestReconciler(t, pod, &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "manual",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+			// 직접 작성한 TTLResource처럼 UID 없이 owner를 가리킴
+			OwnerReferences: []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: "web"}},
+		},
+		Spec: ttlv1alpha1.TTLResourceSpec{TTLSeconds: 60},
+	})
+
+	reconcileKey(t, r, "default", "manual")
+
+	err := r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "web"}, &corev1.Pod{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue(), "owner Pod should be deleted")
+	err = r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "manual"}, &ttlv1alpha1.TTLResource{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue(), "TTLResource should be deleted")
+}
+
+func TestReconcileTargetKindAnnotation(t *testing.T) {
+	g := NewWithT(t)
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "foo",
+		Namespace:   "default",
+		Annotations: map[string]string{TTLAnnotationKey: "60"},
+	}}
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{
+		Name:      "foo",
+		Namespace: "default",
+		Annotations: map[string]string{
+			TTLAnnotationKey:        "120",
+			TargetKindAnnotationKey: "Service",
+		},
+	}}
+	r := newTestReconciler(t, pod, svc)
+
+	reconcileKey(t, r, "default", "foo")
+
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-foo"}, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.OwnerReferences).To(HaveLen(1))
+	g.Expect(ttlResource.OwnerReferences[0].Kind).To(Equal("Service"))
+	g.Expect(ttlResource.Spec.TTLSeconds).To(Equal(120))
+}
+
+func TestReconcileFallbackOrderWithoutTargetKind(t *testing.T) {
+	g := NewWithT(t)
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "foo",
+		Namespace:   "default",
+		Annotations: map[string]string{TTLAnnotationKey: "60"},
+	}}
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{
+		Name:        "foo",
+		Namespace:   "default",
+		Annotations: map[string]string{TTLAnnotationKey: "120"},
+	}}
+	r := newTestReconciler(t, pod, svc)
+
+	reconcileKey(t, r, "default", "foo")
+
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-foo"}, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.OwnerReferences[0].Kind).To(Equal("Pod"))
+}
+
+func TestReconcileDisabledOwnerIsLeftUntouched(t *testing.T) {
+	g := NewWithT(t)
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:      "web",
+		Namespace: "default",
+		Annotations: map[string]string{
+			TTLAnnotationKey:               "60",
+			ReconcileAnnotationKey:         ReconcileDisabledValue,
+			PendingDeletionAtAnnotationKey: "2025-06-01T10:02:00Z",
+		},
+	}}
+	ttlResource := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "ttl-web",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+			OwnerReferences:   []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: "web"}},
+		},
+		Spec: ttlv1alpha1.TTLResourceSpec{TTLSeconds: 60},
+	}
+	r := newTestReconciler(t, pod, ttlResource)
+	var before corev1.Pod
+	g.Expect(r.Get(context.Background(), client.ObjectKeyFromObject(pod), &before)).To(Succeed())
+
+	reconcileKey(t, r, "default", "ttl-web")
+	reconcileKey(t, r, "default", "web")
+
+	// 삭제 예정 표시도 지우지 않고 리소스를 전혀 쓰지 않음
+	var after corev1.Pod
+	g.Expect(r.Get(context.Background(), client.ObjectKeyFromObject(pod), &after)).To(Succeed())
+	g.Expect(after.ResourceVersion).To(Equal(before.ResourceVersion), "owner should not be written")
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(context.Background(), client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(latest.Status.ExpiredAt).To(BeNil(), "status should not be touched")
+}
+
+func TestReconcileDisabledOwnerDeletedExternallyCleansUpTTLResource(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	pod.Annotations = map[string]string{TTLAnnotationKey: "60", ReconcileAnnotationKey: ReconcileDisabledValue}
+	ttlResource.Labels = map[string]string{TTLResourceLabelKey: TTLResourceLabelValue}
+	r := newTestReconciler(t, pod, ttlResource)
+
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &ttlv1alpha1.TTLResource{})).To(Succeed())
+
+	// reconcile이 비활성화된 상태에서 owner가 외부에서 삭제되어도 TTLResource를 정리
+	g.Expect(r.Delete(ctx, pod)).To(Succeed())
+	reconcileKey(t, r, "default", "web")
+	err := r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &ttlv1alpha1.TTLResource{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestReenabledOwnerPastExpiryIsDeletedPromptly(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	pod.Annotations = map[string]string{TTLAnnotationKey: "60", ReconcileAnnotationKey: ReconcileDisabledValue}
+	ttlResource.Annotations = map[string]string{TTLSourceAnnotationKey: TTLSourceAnnotation}
+	r := newTestReconciler(t, pod, ttlResource)
+
+	// 비활성화된 동안에는 만료 시각이 지나도 삭제하지 않음
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+
+	// 다시 활성화하면 owner의 reconcile에서 TTL을 다시 기다리지 않고 바로 삭제
+	delete(pod.Annotations, ReconcileAnnotationKey)
+	g.Expect(r.Update(ctx, pod)).To(Succeed())
+	reconcileKey(t, r, "default", "web")
+	err := r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestDeleteOwnersInBatches(t *testing.T) {
+	g := NewWithT(t)
+
+	objs := []client.Object{}
+	ownerRefs := []metav1.OwnerReference{}
+	for _, name := range []string{"a", "b", "c"} {
+		objs = append(objs, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}})
+		ownerRefs = append(ownerRefs, metav1.OwnerReference{APIVersion: "v1", Kind: "Pod", Name: name})
+	}
+	ttlResource := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "bulk",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+			OwnerReferences:   ownerRefs,
+		},
+		Spec: ttlv1alpha1.TTLResourceSpec{TTLSeconds: 60},
+	}
+	r := newTestReconciler(t, append(objs, ttlResource)...)
+	r.MaxDeletesPerCycle = 2
+
+	result := reconcileKey(t, r, "default", "bulk")
+	g.Expect(result.RequeueAfter).To(Equal(batchRequeueInterval))
+
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(context.Background(), client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(latest.Status.RemainingDeletions).To(Equal(1))
+
+	reconcileKey(t, r, "default", "bulk")
+
+	var pods corev1.PodList
+	g.Expect(r.List(context.Background(), &pods)).To(Succeed())
+	g.Expect(pods.Items).To(BeEmpty())
+	err := r.Get(context.Background(), client.ObjectKeyFromObject(ttlResource), &latest)
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestDeleteOwnersInCreationOrder(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	now := time.Now()
+	objs := []client.Object{}
+	ownerRefs := []metav1.OwnerReference{}
+	for name, age := range map[string]time.Duration{"a": time.Hour, "b": 3 * time.Hour, "c": 2 * time.Hour} {
+		objs = append(objs, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name: name, Namespace: "default", CreationTimestamp: metav1.NewTime(now.Add(-age)),
+		}})
+	}
+	for _, name := range []string{"a", "b", "missing", "c"} {
+		ownerRefs = append(ownerRefs, metav1.OwnerReference{APIVersion: "v1", Kind: "Pod", Name: name})
+	}
+	ttlResource := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "bulk",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(now.Add(-2 * time.Minute)),
+			OwnerReferences:   ownerRefs,
+		},
+		Spec: ttlv1alpha1.TTLResourceSpec{TTLSeconds: 60},
+	}
+	r := newTestReconciler(t, append(objs, ttlResource)...)
+
+	// 조회할 수 없는 owner는 뒤로 가고, 나머지는 생성 시각 순서
+	names := func(refs []metav1.OwnerReference) []string {
+		var out []string
+		for _, ref := range refs {
+			out = append(out, ref.Name)
+		}
+		return out
+	}
+	r.DeletionOrder = DeletionOrderNewestFirst
+	ordered, err := r.orderOwnersForDeletion(ctx, "default", ownerRefs)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(names(ordered)).To(Equal([]string{"a", "c", "b", "missing"}))
+
+	// 배치 한도가 있어도 오래된 owner부터 하나씩 삭제
+	r.DeletionOrder = DeletionOrderOldestFirst
+	r.MaxDeletesPerCycle = 1
+	order := []string{"b", "c", "a"}
+	for i, name := range order {
+		reconcileKey(t, r, "default", "bulk")
+		err := r.Get(ctx, client.ObjectKey{Namespace: "default", Name: name}, &corev1.Pod{})
+		g.Expect(errors.IsNotFound(err)).To(BeTrue(), name)
+		for _, later := range order[i+1:] {
+			g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: later}, &corev1.Pod{})).To(Succeed(), later)
+		}
+	}
+	g.Expect(ValidateDeletionOrder("random")).To(HaveOccurred())
+}
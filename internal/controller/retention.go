@@ -0,0 +1,118 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// RetainedOwnersAnnotationKey는 보존 모드에서 TTLResource의 OwnerReference를 옮겨 기록하는 annotation 키입니다.
+// OwnerReference가 남아 있으면 owner 삭제 시 TTLResource도 GC되므로, 삭제 전에 annotation으로 옮깁니다
+const RetainedOwnersAnnotationKey = "ttl.example.com/retained-owners"
+
+// ownersOf는 TTLResource가 삭제할 대상 owner 목록을 반환합니다.
+// 보존 모드에서 OwnerReference를 annotation으로 옮긴 뒤에는 annotation의 목록을 사용하고,
+// OwnerReference가 없는 TTLResource는 spec.targetRef를 사용합니다.
+func ownersOf(ttlResource *ttlv1alpha1.TTLResource) []metav1.OwnerReference {
+	if len(ttlResource.OwnerReferences) > 0 {
+		return ttlResource.OwnerReferences
+	}
+	value, ok := ttlResource.Annotations[RetainedOwnersAnnotationKey]
+	if !ok {
+		if ttlResource.Spec.TargetRef != nil {
+			return []metav1.OwnerReference{targetRefOwner(ttlResource.Spec.TargetRef)}
+		}
+		return nil
+	}
+	var owners []metav1.OwnerReference
+	if err := json.Unmarshal([]byte(value), &owners); err != nil {
+		return nil
+	}
+	return owners
+}
+
+// retainAfterExpiry는 TTLResource를 owner 삭제 후에도 남겨둘지 결정합니다.
+// Spec.KeepAfterExpiry가 지정되어 있으면 전역 설정(--retain-expired)보다 우선합니다.
+func (r *ResourceReconciler) retainAfterExpiry(ttlResource *ttlv1alpha1.TTLResource) bool {
+	if ttlResource.Spec.KeepAfterExpiry != nil {
+		return *ttlResource.Spec.KeepAfterExpiry
+	}
+	return r.RetainExpired
+}
+
+// retained는 보존 모드에서 owner 삭제가 끝나 기록용으로 남아 있는 TTLResource인지 확인합니다.
+func retained(ttlResource *ttlv1alpha1.TTLResource) bool {
+	return ttlResource.Status.DeletedAt != nil
+}
+
+// detachOwners는 owner 삭제 시 TTLResource가 GC되지 않도록 OwnerReference를 annotation으로 옮깁니다.
+// 업데이트로 인한 다음 reconcile에서 owner 삭제가 진행됩니다.
+func (r *ResourceReconciler) detachOwners(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource, logger logr.Logger) (ctrl.Result, error) {
+	value, err := json.Marshal(ttlResource.OwnerReferences)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	annotations := ttlResource.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[RetainedOwnersAnnotationKey] = string(value)
+	ttlResource.SetAnnotations(annotations)
+	ttlResource.OwnerReferences = nil
+
+	if err := r.Update(ctx, ttlResource); err != nil {
+		if errors.IsConflict(err) {
+			r.ConflictLog.Info(logger.V(1), "Conflict detaching owners from TTLResource, will retry", "name", ttlResource.Name)
+			return r.retryOnConflict(ctx, ttlResource, logger), nil
+		}
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	logger.Info("Detached owners from TTLResource for retention", "name", ttlResource.Name)
+	return ctrl.Result{}, nil
+}
+
+// recordDeletion은 보존 모드에서 owner 삭제가 끝난 시각을 기록하고 TTLResource를 남겨둡니다.
+func (r *ResourceReconciler) recordDeletion(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource, logger logr.Logger) (ctrl.Result, error) {
+	now := metav1.NewTime(r.now())
+	ttlResource.Status.DeletedAt = &now
+	ttlResource.Status.RemainingDeletions = 0
+	ttlResource.Status.DeferReason = ""
+	if err := r.updateStatus(ctx, ttlResource); err != nil {
+		if errors.IsConflict(err) {
+			r.ConflictLog.Info(logger.V(1), "Conflict updating TTLResource status, will retry", "name", ttlResource.Name)
+			return r.retryOnConflict(ctx, ttlResource, logger), nil
+		}
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	logger.Info("Owner resources deleted, retaining TTLResource", "name", ttlResource.Name, "deletedAt", now.Time)
+	return ctrl.Result{}, nil
+}
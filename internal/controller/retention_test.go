@@ -0,0 +1,89 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestRetainExpiredRecordsDeletedAt(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	ttlResource.Labels = map[string]string{TTLResourceLabelKey: TTLResourceLabelValue}
+	r := newTestReconciler(t, pod, ttlResource)
+	r.RetainExpired = true
+
+	// 먼저 OwnerReference를 떼어내 owner 삭제 시 GC되지 않도록 함
+	reconcileKey(t, r, "default", "ttl-web")
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(latest.OwnerReferences).To(BeEmpty())
+	g.Expect(latest.Annotations).To(HaveKey(RetainedOwnersAnnotationKey))
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+
+	reconcileKey(t, r, "default", "ttl-web")
+	err := r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue(), "owner Pod should be deleted")
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(latest.Status.DeletedAt).NotTo(BeNil())
+	g.Expect(latest.Status.DeletedAt.Time).NotTo(BeTemporally("<", latest.Status.ExpiredAt.Time))
+
+	// owner가 사라진 뒤의 reconcile에서도 TTLResource는 남아 있어야 함
+	reconcileKey(t, r, "default", "web")
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+}
+
+func TestKeepAfterExpiryOverridesGlobalSetting(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		retainExpired bool
+		keep          bool
+	}{
+		{retainExpired: false, keep: true},
+		{retainExpired: true, keep: false},
+	} {
+		pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+		ttlResource.Spec.KeepAfterExpiry = &tc.keep
+		r := newTestReconciler(t, pod, ttlResource)
+		r.RetainExpired = tc.retainExpired
+
+		reconcileKey(t, r, "default", "ttl-web")
+		reconcileKey(t, r, "default", "ttl-web")
+
+		err := r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})
+		g.Expect(errors.IsNotFound(err)).To(BeTrue(), "owner Pod should be deleted")
+		err = r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &ttlv1alpha1.TTLResource{})
+		if tc.keep {
+			g.Expect(err).NotTo(HaveOccurred(), "keepAfterExpiry=true should retain the TTLResource")
+		} else {
+			g.Expect(errors.IsNotFound(err)).To(BeTrue(), "keepAfterExpiry=false should delete the TTLResource")
+		}
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+const (
+	// SelfTestLabelKey는 자가 진단용 카나리 ConfigMap을 식별하는 label 키입니다
+	SelfTestLabelKey = "ttl.example.com/self-test"
+	// selfTestPollInterval은 카나리 삭제 여부를 확인하는 주기입니다
+	selfTestPollInterval = time.Second
+)
+
+// SelfTest는 짧은 TTL을 가진 카나리 ConfigMap을 만들어
+// annotation → TTLResource → 삭제로 이어지는 전체 흐름이 동작하는지 확인합니다.
+// manager.Runnable로 등록하면 manager 시작 시 한 번 실행됩니다.
+type SelfTest struct {
+	Client client.Client
+	// Namespace는 카나리 ConfigMap을 생성할 네임스페이스입니다
+	Namespace string
+	// TTLSeconds는 카나리에 지정할 TTL(초)입니다
+	TTLSeconds int
+	// Timeout은 카나리가 삭제되기를 기다리는 최대 시간입니다
+	Timeout time.Duration
+	// TTLResourceNamer는 카나리의 TTLResource 이름을 만듭니다. reconciler와 같은 값을 사용해야 합니다
+	TTLResourceNamer *TTLResourceNamer
+	// DeletionBlocker는 설정 때문에 Namespace의 카나리가 삭제되지 않는다면 그 이유를 반환합니다.
+	// 이유가 있으면 진단을 건너뜁니다. nil이면 확인하지 않습니다
+	DeletionBlocker func(namespace string) string
+	// Clock은 진단에 걸린 시간을 재는 시계입니다. nil이면 실제 시계를 사용합니다
+	Clock clock.Clock
+}
+
+// Start는 자가 진단을 한 번 실행합니다. 진단 실패는 manager를 중단시키지 않습니다.
+func (s *SelfTest) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("self-test")
+
+	// 삭제를 막는 설정에서는 카나리가 남아 실패하므로 실패로 세지 않고 건너뜀
+	if s.DeletionBlocker != nil {
+		if reason := s.DeletionBlocker(s.Namespace); reason != "" {
+			logger.Info("Self-test skipped, the canary would not be deleted", "namespace", s.Namespace, "reason", reason)
+			selfTestRunsTotal.WithLabelValues("skipped").Inc()
+			return nil
+		}
+	}
+
+	clk := clockOrReal(s.Clock)
+	start := clk.Now()
+	if err := s.Run(ctx); err != nil {
+		logger.Error(err, "Self-test failed", "namespace", s.Namespace)
+		selfTestRunsTotal.WithLabelValues("failure").Inc()
+		selfTestLastSuccess.Set(0)
+		return nil
+	}
+
+	elapsed := clk.Since(start)
+	logger.Info("Self-test succeeded", "namespace", s.Namespace, "duration", elapsed)
+	selfTestRunsTotal.WithLabelValues("success").Inc()
+	selfTestLastSuccess.Set(1)
+	selfTestLastDuration.Set(elapsed.Seconds())
+	return nil
+}
+
+// NeedLeaderElection은 리더만 자가 진단을 실행하도록 합니다.
+func (s *SelfTest) NeedLeaderElection() bool {
+	return true
+}
+
+// Run은 카나리를 생성하고 Timeout 안에 TTL 만료로 삭제되는지 확인합니다.
+// 결과와 관계없이 카나리는 정리됩니다.
+func (s *SelfTest) Run(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("self-test")
+
+	canary := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "ttl-selftest-",
+			Namespace:    s.Namespace,
+			Labels: map[string]string{
+				SelfTestLabelKey:               "true",
+				"app.kubernetes.io/managed-by": "ttl-operator",
+				// --require-delete-optin이 켜져 있어도 카나리는 삭제되도록 허용
+				DeleteOptInLabelKey: "true",
+			},
+			Annotations: map[string]string{
+				TTLAnnotationKey: strconv.Itoa(s.TTLSeconds),
+			},
+		},
+	}
+	if err := s.Client.Create(ctx, canary); err != nil {
+		return fmt.Errorf("failed to create canary ConfigMap: %w", err)
+	}
+	logger.Info("Created canary ConfigMap", "name", canary.Name, "ttlSeconds", s.TTLSeconds)
+
+	// 결과와 관계없이 카나리 정리 (ctx가 취소되었을 수 있으므로 별도 context 사용)
+	defer func() {
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := s.Client.Delete(cleanupCtx, canary); err != nil && !errors.IsNotFound(err) {
+			logger.Error(err, "Failed to clean up canary ConfigMap", "name", canary.Name)
+		}
+	}()
+
+	ttlResourceName, err := s.TTLResourceNamer.Name("ConfigMap", canary)
+	if err != nil {
+		return err
+	}
+	sawTTLResource := false
+	err = wait.PollUntilContextTimeout(ctx, selfTestPollInterval, s.Timeout, true, func(ctx context.Context) (bool, error) {
+		if !sawTTLResource {
+			var ttlResource ttlv1alpha1.TTLResource
+			if err := s.Client.Get(ctx, client.ObjectKey{
+				Namespace: canary.Namespace,
+				Name:      ttlResourceName,
+			}, &ttlResource); err == nil {
+				sawTTLResource = true
+				logger.V(1).Info("Canary TTLResource created", "name", ttlResource.Name)
+			}
+		}
+
+		latest := &corev1.ConfigMap{}
+		if err := s.Client.Get(ctx, client.ObjectKeyFromObject(canary), latest); err != nil {
+			if errors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("canary %s/%s was not deleted within %s (TTLResource observed: %t): %w",
+			canary.Namespace, canary.Name, s.Timeout, sawTTLResource, err)
+	}
+
+	return nil
+}
+
+// SelfTestBlocker는 namespace에 만든 카나리가 TTL 만료로 삭제되지 않게 하는 설정이 있으면 그 이유를 반환합니다.
+// SelfTest.DeletionBlocker로 사용합니다.
+func (r *ResourceReconciler) SelfTestBlocker(namespace string) string {
+	switch {
+	case r.OperatorNamespace != "" && namespace == r.OperatorNamespace:
+		return "the namespace is the operator's own namespace"
+	case r.namespaceExcluded(namespace):
+		return "the namespace is listed in --excluded-namespaces"
+	case slices.Contains(r.ConfirmDeleteNamespaces, namespace):
+		return "the namespace is listed in --confirm-delete-namespaces"
+	case r.soaking():
+		return fmt.Sprintf("deletions are disabled until --soak-until %s", r.SoakUntil.UTC().Format(time.RFC3339))
+	}
+	return ""
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// newSelfTestClient는 카나리 ConfigMap이 만들어지면 namer로 정한 이름의 TTLResource를 만들고,
+// expire가 true이면 처음 조회될 때 카나리를 삭제하여 operator의 TTL 처리를 흉내 내는 fake client를 만듭니다.
+// 카나리가 삭제될 때 clk를 elapsed만큼 앞당깁니다.
+func newSelfTestClient(t *testing.T, namer *TTLResourceNamer, expire bool, clk *clocktesting.FakeClock, elapsed time.Duration) client.WithWatch {
+	t.Helper()
+	return fake.NewClientBuilder().
+		WithScheme(newTestScheme(t)).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				if err := c.Create(ctx, obj, opts...); err != nil {
+					return err
+				}
+				if _, ok := obj.(*corev1.ConfigMap); !ok {
+					return nil
+				}
+				name, err := namer.Name("ConfigMap", obj)
+				if err != nil {
+					return err
+				}
+				return c.Create(ctx, &ttlv1alpha1.TTLResource{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: obj.GetNamespace()},
+					Spec:       ttlv1alpha1.TTLResourceSpec{TTLSeconds: 5},
+				})
+			},
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				if _, ok := obj.(*corev1.ConfigMap); ok && expire {
+					if err := c.Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}); err == nil {
+						clk.Step(elapsed)
+					}
+				}
+				return c.Get(ctx, key, obj, opts...)
+			},
+		}).
+		Build()
+}
+
+func TestSelfTestSucceedsWhenCanaryIsDeleted(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	c := newSelfTestClient(t, nil, true, fakeClock, 7*time.Second)
+	successBefore := testutil.ToFloat64(selfTestRunsTotal.WithLabelValues("success"))
+
+	selfTest := &SelfTest{Client: c, Namespace: "default", TTLSeconds: 5, Timeout: 5 * time.Second, Clock: fakeClock}
+	g.Expect(selfTest.Start(ctx)).To(Succeed())
+
+	g.Expect(testutil.ToFloat64(selfTestRunsTotal.WithLabelValues("success"))).To(Equal(successBefore + 1))
+	g.Expect(testutil.ToFloat64(selfTestLastSuccess)).To(Equal(1.0))
+	// 걸린 시간은 주입한 시계로 잼
+	g.Expect(testutil.ToFloat64(selfTestLastDuration)).To(Equal(7.0))
+}
+
+func TestSelfTestFailsAndCleansUpOnTimeout(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	c := newSelfTestClient(t, nil, false, nil, 0)
+	failureBefore := testutil.ToFloat64(selfTestRunsTotal.WithLabelValues("failure"))
+
+	selfTest := &SelfTest{Client: c, Namespace: "default", TTLSeconds: 5, Timeout: 50 * time.Millisecond}
+	err := selfTest.Run(ctx)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring("TTLResource observed: true"))
+
+	// 실패해도 카나리는 정리됨
+	var configMaps corev1.ConfigMapList
+	g.Expect(c.List(ctx, &configMaps, client.InNamespace("default"))).To(Succeed())
+	g.Expect(configMaps.Items).To(BeEmpty())
+
+	// Start는 실패를 메트릭으로만 알리고 manager를 중단시키지 않음
+	g.Expect(selfTest.Start(ctx)).To(Succeed())
+	g.Expect(testutil.ToFloat64(selfTestRunsTotal.WithLabelValues("failure"))).To(Equal(failureBefore + 1))
+	g.Expect(testutil.ToFloat64(selfTestLastSuccess)).To(Equal(0.0))
+}
+
+func TestSelfTestUsesTTLResourceNamerForGeneratedName(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	namer, err := ParseTTLResourceNameTemplate("{{.Kind | lower}}-{{.Name}}-ttl")
+	g.Expect(err).NotTo(HaveOccurred())
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	c := newSelfTestClient(t, namer, false, fakeClock, 0)
+
+	selfTest := &SelfTest{Client: c, Namespace: "default", TTLSeconds: 5, Timeout: 50 * time.Millisecond, TTLResourceNamer: namer}
+	err = selfTest.Run(ctx)
+	g.Expect(err).To(HaveOccurred())
+	// GenerateName으로 만든 카나리의 실제 이름으로 TTLResource 이름을 만들어 찾음
+	g.Expect(err.Error()).To(ContainSubstring("TTLResource observed: true"))
+
+	var ttlResources ttlv1alpha1.TTLResourceList
+	g.Expect(c.List(ctx, &ttlResources, client.InNamespace("default"))).To(Succeed())
+	g.Expect(ttlResources.Items).To(HaveLen(1))
+	name := ttlResources.Items[0].Name
+	g.Expect(strings.HasPrefix(name, "configmap-ttl-selftest-")).To(BeTrue(), name)
+	g.Expect(strings.HasSuffix(name, "-ttl")).To(BeTrue(), name)
+	g.Expect(errors.IsNotFound(c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "configmap-ttl-selftest--ttl"},
+		&ttlv1alpha1.TTLResource{}))).To(BeTrue(), "the name must come from the generated canary name")
+}
+
+func TestSelfTestIsSkippedWhenDeletionIsBlocked(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	r := &ResourceReconciler{Clock: fakeClock}
+	g.Expect(r.SelfTestBlocker("default")).To(BeEmpty())
+
+	r.OperatorNamespace = "default"
+	g.Expect(r.SelfTestBlocker("default")).To(ContainSubstring("operator's own namespace"))
+	r.OperatorNamespace = ""
+	r.ExcludedNamespaces = []string{"default"}
+	g.Expect(r.SelfTestBlocker("default")).To(ContainSubstring("--excluded-namespaces"))
+	r.ExcludedNamespaces = nil
+	r.ConfirmDeleteNamespaces = []string{"default"}
+	g.Expect(r.SelfTestBlocker("default")).To(ContainSubstring("--confirm-delete-namespaces"))
+	r.ConfirmDeleteNamespaces = nil
+	r.SoakUntil = fakeClock.Now().Add(time.Hour)
+	g.Expect(r.SelfTestBlocker("default")).To(ContainSubstring("--soak-until"))
+	// soak 기간이 끝나면 다시 진단함
+	fakeClock.Step(time.Hour)
+	g.Expect(r.SelfTestBlocker("default")).To(BeEmpty())
+
+	// 삭제가 막혀 있으면 카나리를 만들지 않고 건너뛴 것으로만 셈
+	r.RequireDeleteOptIn = true
+	r.SoakUntil = fakeClock.Now().Add(time.Hour)
+	c := newSelfTestClient(t, nil, true, fakeClock, 0)
+	skippedBefore := testutil.ToFloat64(selfTestRunsTotal.WithLabelValues("skipped"))
+	failureBefore := testutil.ToFloat64(selfTestRunsTotal.WithLabelValues("failure"))
+	selfTest := &SelfTest{Client: c, Namespace: "default", TTLSeconds: 5, Timeout: 5 * time.Second,
+		DeletionBlocker: r.SelfTestBlocker}
+	g.Expect(selfTest.Start(ctx)).To(Succeed())
+	g.Expect(testutil.ToFloat64(selfTestRunsTotal.WithLabelValues("skipped"))).To(Equal(skippedBefore + 1))
+	g.Expect(testutil.ToFloat64(selfTestRunsTotal.WithLabelValues("failure"))).To(Equal(failureBefore))
+	var configMaps corev1.ConfigMapList
+	g.Expect(c.List(ctx, &configMaps, client.InNamespace("default"))).To(Succeed())
+	g.Expect(configMaps.Items).To(BeEmpty())
+}
+
+func TestSelfTestCanaryOptsInToDeletion(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	var canary *corev1.ConfigMap
+	c := fake.NewClientBuilder().
+		WithScheme(newTestScheme(t)).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				if configMap, ok := obj.(*corev1.ConfigMap); ok {
+					canary = configMap.DeepCopy()
+				}
+				return c.Create(ctx, obj, opts...)
+			},
+		}).
+		Build()
+
+	selfTest := &SelfTest{Client: c, Namespace: "default", TTLSeconds: 30, Timeout: 50 * time.Millisecond}
+	g.Expect(selfTest.Run(ctx)).To(HaveOccurred())
+	// --require-delete-optin이 켜져 있어도 삭제되도록 allow-delete label을 붙이고, 지정한 TTL을 사용
+	g.Expect(canary).NotTo(BeNil())
+	g.Expect(canary.Labels).To(HaveKeyWithValue(DeleteOptInLabelKey, "true"))
+	g.Expect(canary.Annotations).To(HaveKeyWithValue(TTLAnnotationKey, "30"))
+}
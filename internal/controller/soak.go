@@ -0,0 +1,80 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+const (
+	// ConditionSoaking은 --soak-until 기간이라 만료된 리소스를 삭제하지 않고 있음을 나타냅니다
+	ConditionSoaking = "Soaking"
+	// EventReasonWouldDelete는 soak 기간에 삭제했을 대상을 알리는 Event의 reason입니다
+	EventReasonWouldDelete = "WouldDelete"
+)
+
+// ParseSoakUntil은 --soak-until 값(RFC 3339 시각)을 파싱합니다. 비어 있으면 zero time(soak 없음)을 반환합니다.
+func ParseSoakUntil(value string) (time.Time, error) {
+	if strings.TrimSpace(value) == "" {
+		return time.Time{}, nil
+	}
+	until, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expected an RFC 3339 timestamp (e.g. 2025-06-01T09:00:00Z), got %q", value)
+	}
+	return until, nil
+}
+
+// soaking은 지금이 SoakUntil 이전이라 삭제 대신 로그와 Event만 남겨야 하는지 확인합니다.
+func (r *ResourceReconciler) soaking() bool {
+	return !r.SoakUntil.IsZero() && r.now().Before(r.SoakUntil)
+}
+
+// reportSoakDeletion은 soak 기간에 만료된 TTLResource가 삭제했을 대상을 로그와 Event로 남기고,
+// Soaking condition을 기록한 뒤 soak 기간이 끝나는 시각에 다시 reconcile하여 실제 삭제를 진행합니다.
+func (r *ResourceReconciler) reportSoakDeletion(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource,
+	owners []metav1.OwnerReference, logger logr.Logger) (ctrl.Result, error) {
+	targets := make([]string, 0, len(owners)+1)
+	for _, owner := range owners {
+		targets = append(targets, owner.Kind+"/"+owner.Name)
+	}
+	if !r.retainAfterExpiry(ttlResource) {
+		targets = append(targets, "TTLResource/"+ttlResource.Name)
+	}
+	message := fmt.Sprintf("would delete %s; deletions start after the soak period ends at %s",
+		strings.Join(targets, ", "), r.SoakUntil.UTC().Format(time.RFC3339))
+	logger.Info("Soak period: not deleting expired resources", "name", ttlResource.Name,
+		"wouldDelete", targets, "soakUntil", r.SoakUntil)
+	r.recordEvent(ttlResource, corev1.EventTypeNormal, EventReasonWouldDelete, message)
+
+	return r.deferDeletion(ctx, ttlResource, metav1.Condition{
+		Type:    ConditionSoaking,
+		Status:  metav1.ConditionTrue,
+		Reason:  "SoakPeriod",
+		Message: message,
+	}, r.SoakUntil.Sub(r.now()), logger)
+}
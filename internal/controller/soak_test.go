@@ -0,0 +1,81 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestParseSoakUntil(t *testing.T) {
+	g := NewWithT(t)
+
+	until, err := ParseSoakUntil("2025-06-01T09:00:00Z")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(until.Equal(time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC))).To(BeTrue())
+
+	until, err = ParseSoakUntil("")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(until.IsZero()).To(BeTrue())
+
+	_, err = ParseSoakUntil("tomorrow")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestSoakPeriodOnlyReportsDeletionsUntilItEnds(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	ttlResource.CreationTimestamp = metav1.NewTime(fakeClock.Now().Add(-2 * time.Minute))
+	r := newTestReconciler(t, pod, ttlResource)
+	recorder := record.NewFakeRecorder(10)
+	r.Recorder = recorder
+	r.Clock = fakeClock
+	r.SoakUntil = fakeClock.Now().Add(time.Hour)
+
+	// soak 기간에는 삭제하지 않고 soak 기간이 끝날 때 다시 확인
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(Equal(time.Hour))
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+	g.Expect(recorder.Events).To(Receive(And(ContainSubstring(EventReasonWouldDelete), ContainSubstring("Pod/web"))))
+
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	condition := meta.FindStatusCondition(latest.Status.Conditions, ConditionSoaking)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Reason).To(Equal("SoakPeriod"))
+
+	// soak 기간이 지나면 자동으로 실제 삭제를 진행
+	fakeClock.Step(time.Hour)
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{}))).To(BeTrue())
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &ttlv1alpha1.TTLResource{}))).To(BeTrue())
+}
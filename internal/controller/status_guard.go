@@ -0,0 +1,85 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// EventReasonExpiredStatusReset은 만료 시각 전에 status.expired가 true로 설정되어 되돌렸음을 알리는 Event reason입니다
+const EventReasonExpiredStatusReset = "ExpiredStatusReset"
+
+// resetPrematureExpired는 status.expired가 true인데 다시 계산한 만료 시각(guardedExpiryTime)이 아직 지나지 않았으면
+// 누군가 status를 직접 수정한 것으로 보고 owner를 삭제하지 않고 expired를 false로 되돌립니다.
+// 되돌렸으면 true와 남은 시간만큼 재큐잉하는 결과를 반환합니다.
+func (r *ResourceReconciler) resetPrematureExpired(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource,
+	now time.Time, logger logr.Logger) (ctrl.Result, bool, error) {
+	if !ttlResource.Status.Expired || ttlResource.Status.CreatedAt.IsZero() {
+		return ctrl.Result{}, false, nil
+	}
+	expireTime := guardedExpiryTime(ttlResource)
+	if !now.Before(expireTime) {
+		return ctrl.Result{}, false, nil
+	}
+
+	message := fmt.Sprintf("status.expired was set before the computed expiry time %s; resetting it and not deleting owners",
+		expireTime.UTC().Format(time.RFC3339))
+	logger.Error(nil, "TTLResource marked expired before its expiry time, refusing to delete",
+		"name", ttlResource.Name, "expiredAt", expireTime, "now", now)
+	r.recordEvent(ttlResource, corev1.EventTypeWarning, EventReasonExpiredStatusReset, message)
+
+	// status.expiredAt도 함께 바뀌었을 수 있으므로 다시 계산한 만료 시각으로 되돌림
+	ttlResource.Status.Expired = false
+	ttlResource.Status.ExpiredAt = &metav1.Time{Time: expireTime}
+	ttlResource.Status.LastRequeueReason = RequeueReasonWaitingForExpiry
+	if err := r.updateStatus(ctx, ttlResource); err != nil {
+		if errors.IsConflict(err) {
+			r.ConflictLog.Info(logger.V(1), "Conflict updating TTLResource status, will retry", "name", ttlResource.Name)
+			return r.retryOnConflict(ctx, ttlResource, logger), true, nil
+		}
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, true, nil
+		}
+		return ctrl.Result{}, true, err
+	}
+	return ctrl.Result{RequeueAfter: expireTime.Sub(now)}, true, nil
+}
+
+// guardedExpiryTime은 status.expiredAt을 믿지 않고 status.createdAt에 spec의 TTL과 jitter를 더해 만료 시각을 다시 계산합니다.
+// status.expiredAt도 함께 수정하면 expiryTime으로는 직접 수정한 것을 알아낼 수 없기 때문입니다.
+// quota pressure로 일찍 만료된 경우(ExpiredEarly condition)에만 더 이른 status.expiredAt을 인정합니다.
+func guardedExpiryTime(ttlResource *ttlv1alpha1.TTLResource) time.Time {
+	jitter := time.Duration(jitterOffset(ttlResource)) * time.Second
+	computed := ttlResource.Status.CreatedAt.Add(ttlDuration(ttlResource.Spec) + jitter)
+	stored := ttlResource.Status.ExpiredAt
+	if stored != nil && stored.Time.Before(computed) &&
+		meta.IsStatusConditionTrue(ttlResource.Status.Conditions, ConditionExpiredEarly) {
+		return stored.Time
+	}
+	return computed
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestTamperedExpiredStatusIsResetWithoutDeleting(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	ttlResource := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "ttl-web",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(fakeClock.Now()),
+			OwnerReferences:   []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: "web"}},
+		},
+		Spec: ttlv1alpha1.TTLResourceSpec{TTLSeconds: 3600},
+	}
+	r := newTestReconciler(t, pod, ttlResource)
+	r.Clock = fakeClock
+	recorder := record.NewFakeRecorder(10)
+	r.Recorder = recorder
+	reconcileKey(t, r, "default", "ttl-web")
+
+	// 만료 시각 전에 status를 직접 수정하여 expired로 표시
+	fakeClock.Step(10 * time.Minute)
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	latest.Status.Expired = true
+	g.Expect(r.Status().Update(ctx, &latest)).To(Succeed())
+
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(Equal(50 * time.Minute))
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(latest.Status.Expired).To(BeFalse())
+	g.Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonExpiredStatusReset)))
+
+	// 실제 만료 시각이 지나면 정상적으로 삭제
+	fakeClock.Step(50 * time.Minute)
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{}))).To(BeTrue())
+}
+
+func TestTamperedExpiredAtIsResetWithoutDeleting(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	ttlResource := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "ttl-web",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(fakeClock.Now()),
+			OwnerReferences:   []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: "web"}},
+		},
+		Spec: ttlv1alpha1.TTLResourceSpec{TTLSeconds: 3600},
+	}
+	r := newTestReconciler(t, pod, ttlResource)
+	r.Clock = fakeClock
+	reconcileKey(t, r, "default", "ttl-web")
+	fakeClock.Step(10 * time.Minute)
+
+	for _, markExpired := range []bool{true, false} {
+		// expiredAt을 과거로 바꾸고, expired도 함께 바꾸거나 expiredAt만 바꿈
+		var latest ttlv1alpha1.TTLResource
+		g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+		latest.Status.Expired = markExpired
+		latest.Status.ExpiredAt = &metav1.Time{Time: fakeClock.Now().Add(-time.Minute)}
+		g.Expect(r.Status().Update(ctx, &latest)).To(Succeed())
+
+		result := reconcileKey(t, r, "default", "ttl-web")
+		g.Expect(result.RequeueAfter).To(Equal(50*time.Minute), "expired=%v", markExpired)
+		g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed(), "expired=%v", markExpired)
+		g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+		g.Expect(latest.Status.Expired).To(BeFalse())
+		g.Expect(latest.Status.ExpiredAt.Time).To(BeTemporally("==", time.Date(2025, 6, 1, 11, 0, 0, 0, time.UTC)))
+	}
+}
+
+func TestGuardedExpiryTimeAllowsQuotaPressure(t *testing.T) {
+	g := NewWithT(t)
+
+	createdAt := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	earlier := createdAt.Add(10 * time.Minute)
+	ttlResource := &ttlv1alpha1.TTLResource{
+		Spec: ttlv1alpha1.TTLResourceSpec{TTLSeconds: 3600},
+		Status: ttlv1alpha1.TTLResourceStatus{
+			CreatedAt: metav1.NewTime(createdAt),
+			ExpiredAt: &metav1.Time{Time: earlier},
+		},
+	}
+	g.Expect(guardedExpiryTime(ttlResource)).To(Equal(createdAt.Add(time.Hour)))
+
+	// quota pressure로 일찍 만료된 경우에만 더 이른 expiredAt을 인정
+	meta.SetStatusCondition(&ttlResource.Status.Conditions, metav1.Condition{
+		Type: ConditionExpiredEarly, Status: metav1.ConditionTrue, Reason: "QuotaPressure",
+	})
+	g.Expect(guardedExpiryTime(ttlResource)).To(Equal(earlier))
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get
+
+// TTLResourceCRDName은 TTLResource CRD의 이름입니다
+var TTLResourceCRDName = "ttlresources." + ttlv1alpha1.GroupVersion.Group
+
+// StatusSubresourceEnabled는 클러스터에 설치된 TTLResource CRD의 현재 버전에 status subresource가 있는지 확인합니다.
+// 오래되었거나 잘못 설치된 CRD에는 status subresource가 없어 Status().Update가 NotFound로 실패합니다.
+func StatusSubresourceEnabled(ctx context.Context, c client.Reader) (bool, error) {
+	var crd apiextensionsv1.CustomResourceDefinition
+	if err := c.Get(ctx, client.ObjectKey{Name: TTLResourceCRDName}, &crd); err != nil {
+		return false, err
+	}
+	for _, version := range crd.Spec.Versions {
+		if version.Name == ttlv1alpha1.GroupVersion.Version {
+			return version.Subresources != nil && version.Subresources.Status != nil, nil
+		}
+	}
+	return false, fmt.Errorf("CRD %s does not serve version %s", TTLResourceCRDName, ttlv1alpha1.GroupVersion.Version)
+}
+
+// updateTTLResourceStatus는 TTLResource의 status를 기록합니다.
+// viaUpdate가 true이면 status subresource가 없는 CRD용으로 일반 update를 사용하며, 이때는 spec과 metadata도 함께 기록됩니다.
+func updateTTLResourceStatus(ctx context.Context, c client.Client, ttlResource *ttlv1alpha1.TTLResource, viaUpdate bool) error {
+	if viaUpdate {
+		return c.Update(ctx, ttlResource)
+	}
+	return c.Status().Update(ctx, ttlResource)
+}
+
+// updateStatus는 StatusViaUpdate 설정에 따라 TTLResource의 status를 기록합니다.
+func (r *ResourceReconciler) updateStatus(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource) error {
+	return updateTTLResourceStatus(ctx, r.Client, ttlResource, r.StatusViaUpdate)
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestStatusSubresourceEnabled(t *testing.T) {
+	g := NewWithT(t)
+
+	for _, tc := range []struct {
+		subresources *apiextensionsv1.CustomResourceSubresources
+		expected     bool
+	}{
+		{subresources: &apiextensionsv1.CustomResourceSubresources{Status: &apiextensionsv1.CustomResourceSubresourceStatus{}}, expected: true},
+		{subresources: nil, expected: false},
+	} {
+		scheme := newTestScheme(t)
+		g.Expect(apiextensionsv1.AddToScheme(scheme)).To(Succeed())
+		crd := &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: TTLResourceCRDName},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{Name: ttlv1alpha1.GroupVersion.Version, Served: true, Storage: true, Subresources: tc.subresources},
+				},
+			},
+		}
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(crd).Build()
+
+		enabled, err := StatusSubresourceEnabled(context.Background(), c)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(enabled).To(Equal(tc.expected))
+	}
+}
+
+func TestStatusViaUpdateWithoutSubresource(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	ttlResource := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "ttl-web",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Now()),
+		},
+		Spec: ttlv1alpha1.TTLResourceSpec{TTLSeconds: 3600},
+	}
+	// status subresource가 없는 CRD처럼 동작하는 client
+	scheme := newTestScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ttlResource).Build()
+	r := &ResourceReconciler{Client: c, Scheme: scheme, StatusViaUpdate: true}
+
+	reconcileKey(t, r, "default", "ttl-web")
+
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(latest.Status.ExpiredAt).NotTo(BeNil())
+}
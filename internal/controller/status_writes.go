@@ -0,0 +1,89 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// statusChanged는 status를 다시 계산한 결과가 읽어온 값과 다른지 확인합니다.
+// 같은 값을 다시 쓰면 resourceVersion만 바뀌어 watch 이벤트와 API 쓰기만 늘어나므로 바뀐 경우에만 기록합니다.
+func statusChanged(observed, desired *ttlv1alpha1.TTLResourceStatus) bool {
+	return !equality.Semantic.DeepEqual(observed, desired)
+}
+
+// ttlResourceMetadataOrSpecChanged는 TTLResource의 spec(generation)이나 metadata가 바뀐 업데이트만 통과시킵니다.
+// status는 reconcile이 직접 기록하고 같은 reconcile에서 이어서 처리하므로, status만 바뀐 업데이트로 다시 reconcile하지 않습니다.
+// confirm-delete처럼 annotation으로 동작하는 기능과 OwnerReference 변경은 그대로 반영됩니다.
+// 다만 quota pressure처럼 reconcile 밖에서 이미 기록된 만료 시각을 다시 쓰거나 만료 상태를 되돌리면
+// 원래 만료 시각까지 기다리지 않도록 통과시킵니다 (expiryStatusRewritten).
+var ttlResourceMetadataOrSpecChanged = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldObj, newObj := e.ObjectOld, e.ObjectNew
+		if oldObj == nil || newObj == nil {
+			return true
+		}
+		return oldObj.GetGeneration() != newObj.GetGeneration() ||
+			(oldObj.GetDeletionTimestamp() == nil) != (newObj.GetDeletionTimestamp() == nil) ||
+			!equality.Semantic.DeepEqual(oldObj.GetLabels(), newObj.GetLabels()) ||
+			!equality.Semantic.DeepEqual(oldObj.GetAnnotations(), newObj.GetAnnotations()) ||
+			!equality.Semantic.DeepEqual(oldObj.GetOwnerReferences(), newObj.GetOwnerReferences()) ||
+			!equality.Semantic.DeepEqual(oldObj.GetFinalizers(), newObj.GetFinalizers()) ||
+			expiryStatusRewritten(oldObj, newObj)
+	},
+}
+
+// expiryStatusRewritten은 이미 기록된 status.expiredAt이 바뀌었거나 status.expired가 true에서 false로 돌아갔는지 확인합니다.
+// reconcile이 처음 채우는 값(expiredAt이 없던 경우, expired가 true로 바뀌는 경우)은 같은 reconcile에서 처리하므로 제외합니다.
+func expiryStatusRewritten(oldObj, newObj client.Object) bool {
+	oldTTL, ok := oldObj.(*ttlv1alpha1.TTLResource)
+	if !ok {
+		return false
+	}
+	newTTL, ok := newObj.(*ttlv1alpha1.TTLResource)
+	if !ok {
+		return false
+	}
+	if oldTTL.Status.ExpiredAt != nil && !oldTTL.Status.ExpiredAt.Equal(newTTL.Status.ExpiredAt) {
+		return true
+	}
+	return oldTTL.Status.Expired && !newTTL.Status.Expired
+}
+
+// applyExpiryStatus는 한 번의 status 쓰기로 기록할 수 있도록 CreatedAt, ExpiredAt, Expired를 모두 계산해 채웁니다.
+// 이미 기록된 값은 바꾸지 않고, 만료 시각이 now 이전이면 Expired까지 설정합니다. 아직 만료 전이면 재큐잉 이유도 함께 채웁니다.
+func applyExpiryStatus(ttlResource *ttlv1alpha1.TTLResource, now time.Time) {
+	if ttlResource.Status.CreatedAt.IsZero() {
+		ttlResource.Status.CreatedAt = ttlStartTime(ttlResource)
+	}
+	if ttlResource.Status.ExpiredAt == nil && !ttlResource.Status.CreatedAt.IsZero() {
+		setExpiredAt(ttlResource)
+	}
+	if ttlResource.Status.ExpiredAt != nil && !now.Before(expiryTime(ttlResource)) {
+		ttlResource.Status.Expired = true
+	} else if ttlResource.Status.ExpiredAt != nil && !ttlResource.Status.Expired {
+		// 만료 전에는 만료 시각까지 재큐잉하므로 같은 status 쓰기에 이유를 함께 기록
+		ttlResource.Status.LastRequeueReason = RequeueReasonWaitingForExpiry
+	}
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// newWriteCountingReconciler는 TTLResource에 대한 쓰기 요청(spec, metadata, status)을 세는 reconciler를 만듭니다.
+func newWriteCountingReconciler(t *testing.T, fakeClock *clocktesting.FakeClock, writes *int, objs ...client.Object) *ResourceReconciler {
+	t.Helper()
+	count := func(obj client.Object) {
+		if _, ok := obj.(*ttlv1alpha1.TTLResource); ok {
+			*writes++
+		}
+	}
+	scheme := newTestScheme(t)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&ttlv1alpha1.TTLResource{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				obj.SetCreationTimestamp(metav1.NewTime(fakeClock.Now()))
+				count(obj)
+				return c.Create(ctx, obj, opts...)
+			},
+			Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+				count(obj)
+				return c.Update(ctx, obj, opts...)
+			},
+			Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+				count(obj)
+				return c.Patch(ctx, obj, patch, opts...)
+			},
+			SubResourceUpdate: func(ctx context.Context, c client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				count(obj)
+				return c.SubResource(subResource).Update(ctx, obj, opts...)
+			},
+		}).
+		Build()
+	return &ResourceReconciler{Client: c, Scheme: scheme, Clock: fakeClock}
+}
+
+func TestSteadyStateTTLResourceHasNoRedundantWrites(t *testing.T) {
+	g := NewWithT(t)
+
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	writes := 0
+	r := newWriteCountingReconciler(t, fakeClock, &writes, annotatedPod("uid-1"))
+
+	// 생성, 시작/만료 시각 기록, 만료 annotation 반영
+	reconcileKey(t, r, "default", "web")
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(writes).To(Equal(3))
+
+	// 바뀐 것이 없으면 owner와 TTLResource를 몇 번 reconcile해도 쓰지 않음
+	writes = 0
+	for range 3 {
+		fakeClock.Step(10 * time.Second)
+		reconcileKey(t, r, "default", "web")
+		reconcileKey(t, r, "default", "ttl-web")
+	}
+	g.Expect(writes).To(BeZero())
+}
+
+func TestStatusIsNotRewrittenWhenStartTimeIsUnknown(t *testing.T) {
+	g := NewWithT(t)
+
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	// creationTimestamp가 아직 없으면 status에 기록할 값이 없음
+	ttlResource := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{Name: "ttl-web", Namespace: "default"},
+		Spec:       ttlv1alpha1.TTLResourceSpec{TTLSeconds: 60},
+	}
+	writes := 0
+	r := newWriteCountingReconciler(t, fakeClock, &writes, ttlResource)
+
+	reconcileKey(t, r, "default", "ttl-web")
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(writes).To(BeZero())
+}
+
+func TestStatusOnlyUpdatesDoNotRequeue(t *testing.T) {
+	g := NewWithT(t)
+
+	old := &ttlv1alpha1.TTLResource{ObjectMeta: metav1.ObjectMeta{Name: "ttl-web", Namespace: "default", Generation: 1}}
+	statusOnly := old.DeepCopy()
+	statusOnly.ResourceVersion = "2"
+	statusOnly.Status.Expired = true
+	g.Expect(ttlResourceMetadataOrSpecChanged.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: statusOnly})).To(BeFalse())
+
+	confirmed := old.DeepCopy()
+	confirmed.Annotations = map[string]string{ConfirmDeleteAnnotationKey: "true"}
+	g.Expect(ttlResourceMetadataOrSpecChanged.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: confirmed})).To(BeTrue())
+
+	extended := old.DeepCopy()
+	extended.Generation = 2
+	g.Expect(ttlResourceMetadataOrSpecChanged.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: extended})).To(BeTrue())
+
+	// reconcile 밖에서 이미 기록된 만료 시각을 앞당기면 (quota pressure) 통과
+	scheduled := old.DeepCopy()
+	scheduled.Status.ExpiredAt = &metav1.Time{Time: time.Date(2025, 6, 1, 11, 0, 0, 0, time.UTC)}
+	expiredEarly := scheduled.DeepCopy()
+	expiredEarly.Status.ExpiredAt = &metav1.Time{Time: time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)}
+	g.Expect(ttlResourceMetadataOrSpecChanged.Update(event.UpdateEvent{ObjectOld: scheduled, ObjectNew: expiredEarly})).To(BeTrue())
+	// reconcile이 처음 채우는 만료 시각은 통과시키지 않음
+	g.Expect(ttlResourceMetadataOrSpecChanged.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: scheduled})).To(BeFalse())
+}
+
+func TestOverdueTTLResourceStatusIsWrittenOnce(t *testing.T) {
+	g := NewWithT(t)
+
+	created := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	fakeClock := clocktesting.NewFakeClock(created.Add(5 * time.Minute))
+	// operator가 내려가 있는 동안 만료 시각이 지나 status가 하나도 기록되지 않은 TTLResource
+	ttlResource := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{Name: "ttl-web", Namespace: "default", CreationTimestamp: metav1.NewTime(created)},
+		Spec:       ttlv1alpha1.TTLResourceSpec{TTLSeconds: 60},
+	}
+	var written []ttlv1alpha1.TTLResourceStatus
+	scheme := newTestScheme(t)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(ttlResource).
+		WithStatusSubresource(&ttlv1alpha1.TTLResource{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceUpdate: func(ctx context.Context, c client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				if tr, ok := obj.(*ttlv1alpha1.TTLResource); ok {
+					written = append(written, *tr.Status.DeepCopy())
+				}
+				return c.SubResource(subResource).Update(ctx, obj, opts...)
+			},
+		}).
+		Build()
+	r := &ResourceReconciler{Client: c, Scheme: scheme, Clock: fakeClock}
+
+	reconcileKey(t, r, "default", "ttl-web")
+
+	// CreatedAt, ExpiredAt, Expired를 따로 쓰지 않고 한 번에 기록
+	g.Expect(written).To(HaveLen(1))
+	g.Expect(written[0].CreatedAt.Time).To(BeTemporally("==", created))
+	g.Expect(written[0].ExpiredAt).NotTo(BeNil())
+	g.Expect(written[0].ExpiredAt.Time).To(BeTemporally("==", created.Add(time.Minute)))
+	g.Expect(written[0].Expired).To(BeTrue())
+}
+
+func TestExpiryStatusIsWrittenOncePerReconcile(t *testing.T) {
+	g := NewWithT(t)
+
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	writes := 0
+	r := newWriteCountingReconciler(t, fakeClock, &writes, annotatedPod("uid-1"))
+
+	reconcileKey(t, r, "default", "web")
+	writes = 0
+	// 첫 reconcile은 CreatedAt과 ExpiredAt을 한 번의 status 쓰기로 기록하고 만료 annotation을 반영
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(writes).To(Equal(2))
+	g.Expect(result.RequeueAfter).To(Equal(time.Minute))
+
+	// 만료 시각이 되면 Expired만 한 번 기록하고 삭제 진행
+	writes = 0
+	fakeClock.Step(time.Minute)
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(writes).To(Equal(1))
+}
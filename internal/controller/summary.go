@@ -0,0 +1,164 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=ttl.example.com,resources=ttlsummaries,verbs=get;list;watch;create;update
+// +kubebuilder:rbac:groups=ttl.example.com,resources=ttlsummaries/status,verbs=get;update;patch
+
+// TTLSummaryName은 클러스터마다 하나만 두는 TTLSummary의 이름입니다
+const TTLSummaryName = "cluster"
+
+// DefaultSummaryRecentDeletions는 TTLSummary에 남기는 최근 삭제 기록의 기본 개수입니다
+const DefaultSummaryRecentDeletions = 10
+
+// SummaryAggregator는 클러스터 전체 TTLResource의 status를 주기적으로 집계하여
+// cluster-scoped TTLSummary "cluster"에 기록합니다. 여러 클러스터의 TTL 활동을 중앙 컨트롤러가 한곳에서 읽기 위해 사용합니다.
+type SummaryAggregator struct {
+	// Client는 TTLResource 목록 조회와 TTLSummary 기록에 사용합니다
+	Client client.Client
+	// Interval은 집계 주기입니다
+	Interval time.Duration
+	// ClusterName은 spec.clusterName에 기록할 클러스터 이름입니다
+	ClusterName string
+	// RecentDeletions는 남길 최근 삭제 기록 수입니다. 0이면 DefaultSummaryRecentDeletions를 사용합니다
+	RecentDeletions int
+	// Clock은 만료 여부를 판단하고 집계 시각을 기록하는 시계입니다. nil이면 실제 시계를 사용합니다
+	Clock clock.Clock
+}
+
+// Start는 ctx가 끝날 때까지 Interval마다 TTLSummary를 갱신합니다.
+func (a *SummaryAggregator) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("summary-aggregator")
+
+	ticker := time.NewTicker(a.Interval)
+	defer ticker.Stop()
+	for {
+		if err := a.Run(ctx, clockOrReal(a.Clock).Now()); err != nil {
+			logger.Error(err, "Failed to update TTLSummary", "name", TTLSummaryName)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection은 여러 replica가 같은 TTLSummary를 번갈아 덮어쓰지 않도록 리더만 집계하게 합니다.
+func (a *SummaryAggregator) NeedLeaderElection() bool {
+	return true
+}
+
+// Run은 now 기준으로 TTLResource를 집계하여 TTLSummary를 만들거나 갱신합니다.
+func (a *SummaryAggregator) Run(ctx context.Context, now time.Time) error {
+	var list ttlv1alpha1.TTLResourceList
+	if err := a.Client.List(ctx, &list); err != nil {
+		return fmt.Errorf("failed to list TTLResources: %w", err)
+	}
+	status := summarize(list.Items, now, a.recentDeletions())
+
+	var summary ttlv1alpha1.TTLSummary
+	err := a.Client.Get(ctx, client.ObjectKey{Name: TTLSummaryName}, &summary)
+	if errors.IsNotFound(err) {
+		summary = ttlv1alpha1.TTLSummary{
+			ObjectMeta: metav1.ObjectMeta{Name: TTLSummaryName},
+			Spec:       ttlv1alpha1.TTLSummarySpec{ClusterName: a.ClusterName},
+		}
+		if err := a.Client.Create(ctx, &summary); err != nil {
+			return fmt.Errorf("failed to create TTLSummary: %w", err)
+		}
+	} else if err != nil {
+		return fmt.Errorf("failed to get TTLSummary: %w", err)
+	} else if summary.Spec.ClusterName != a.ClusterName {
+		summary.Spec.ClusterName = a.ClusterName
+		if err := a.Client.Update(ctx, &summary); err != nil {
+			return fmt.Errorf("failed to update TTLSummary: %w", err)
+		}
+	}
+
+	summary.Status = status
+	if err := a.Client.Status().Update(ctx, &summary); err != nil {
+		return fmt.Errorf("failed to update TTLSummary status: %w", err)
+	}
+	return nil
+}
+
+func (a *SummaryAggregator) recentDeletions() int {
+	if a.RecentDeletions > 0 {
+		return a.RecentDeletions
+	}
+	return DefaultSummaryRecentDeletions
+}
+
+// summarize는 TTLResource 목록의 status로 TTLSummary status를 계산합니다.
+// 보존되지 않은 TTLResource는 owner와 함께 삭제되므로 최근 삭제 기록은 보존된 TTLResource의 deletedAt으로만 만듭니다.
+func summarize(items []ttlv1alpha1.TTLResource, now time.Time, maxDeletions int) ttlv1alpha1.TTLSummaryStatus {
+	status := ttlv1alpha1.TTLSummaryStatus{Total: len(items), LastUpdated: metav1.NewTime(now)}
+	for i := range items {
+		ttlResource := &items[i]
+		expiredAt := ttlResource.Status.ExpiredAt
+		switch {
+		case retained(ttlResource):
+			status.Retained++
+			record := ttlv1alpha1.TTLDeletionRecord{
+				Namespace: ttlResource.Namespace,
+				Name:      ttlResource.Name,
+				DeletedAt: *ttlResource.Status.DeletedAt,
+			}
+			if owners := ownersOf(ttlResource); len(owners) > 0 {
+				record.OwnerKind = owners[0].Kind
+				record.OwnerName = owners[0].Name
+			}
+			status.RecentDeletions = append(status.RecentDeletions, record)
+		case expiredAt != nil && !now.Before(expiredAt.Time):
+			status.Expired++
+			if ttlResource.Status.DeferReason != "" {
+				status.Deferred++
+			}
+		default:
+			status.Pending++
+			if expiredAt != nil && (status.NextExpiryAt == nil || expiredAt.Before(status.NextExpiryAt)) {
+				status.NextExpiryAt = expiredAt.DeepCopy()
+				status.NextExpiryResource = ttlResource.Namespace + "/" + ttlResource.Name
+			}
+		}
+	}
+
+	sort.Slice(status.RecentDeletions, func(i, j int) bool {
+		return status.RecentDeletions[j].DeletedAt.Before(&status.RecentDeletions[i].DeletedAt)
+	})
+	if len(status.RecentDeletions) > maxDeletions {
+		status.RecentDeletions = status.RecentDeletions[:maxDeletions]
+	}
+	return status
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func summaryTTLResource(name string, expiredAt, deletedAt *time.Time) *ttlv1alpha1.TTLResource {
+	ttlResource := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec:       ttlv1alpha1.TTLResourceSpec{TTLSeconds: 60},
+	}
+	if expiredAt != nil {
+		ttlResource.Status.ExpiredAt = &metav1.Time{Time: *expiredAt}
+	}
+	if deletedAt != nil {
+		ttlResource.Status.DeletedAt = &metav1.Time{Time: *deletedAt}
+		ttlResource.Annotations = map[string]string{RetainedOwnersAnnotationKey: `[{"apiVersion":"v1","kind":"Pod","name":"` + name + `","uid":""}]`}
+	}
+	return ttlResource
+}
+
+func TestSummaryAggregatorWritesClusterSummary(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	now := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	soon, later, past := now.Add(time.Minute), now.Add(time.Hour), now.Add(-time.Minute)
+	older, newer := now.Add(-time.Hour), now.Add(-10*time.Minute)
+	deferred := summaryTTLResource("deferred", &past, nil)
+	deferred.Status.DeferReason = "BlockedByPDB: no disruptions allowed"
+	c := fake.NewClientBuilder().
+		WithScheme(newTestScheme(t)).
+		WithObjects(
+			summaryTTLResource("next", &soon, nil),
+			summaryTTLResource("later", &later, nil),
+			summaryTTLResource("new", nil, nil),
+			summaryTTLResource("overdue", &past, nil),
+			deferred,
+			summaryTTLResource("old-deleted", &older, &older),
+			summaryTTLResource("new-deleted", &newer, &newer),
+		).
+		WithStatusSubresource(&ttlv1alpha1.TTLResource{}, &ttlv1alpha1.TTLSummary{}).
+		Build()
+	a := &SummaryAggregator{Client: c, ClusterName: "prod-1"}
+
+	g.Expect(a.Run(ctx, now)).To(Succeed())
+
+	var summary ttlv1alpha1.TTLSummary
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: TTLSummaryName}, &summary)).To(Succeed())
+	g.Expect(summary.Spec.ClusterName).To(Equal("prod-1"))
+	g.Expect(summary.Status.Total).To(Equal(7))
+	g.Expect(summary.Status.Pending).To(Equal(3))
+	g.Expect(summary.Status.Expired).To(Equal(2))
+	g.Expect(summary.Status.Deferred).To(Equal(1))
+	g.Expect(summary.Status.Retained).To(Equal(2))
+	g.Expect(summary.Status.NextExpiryResource).To(Equal("default/next"))
+	g.Expect(summary.Status.NextExpiryAt.Time.Equal(soon)).To(BeTrue())
+	g.Expect(summary.Status.RecentDeletions).To(HaveLen(2))
+	g.Expect(summary.Status.RecentDeletions[0].Name).To(Equal("new-deleted"))
+	g.Expect(summary.Status.RecentDeletions[0].OwnerKind).To(Equal("Pod"))
+	g.Expect(summary.Status.RecentDeletions[1].Name).To(Equal("old-deleted"))
+	g.Expect(summary.Status.LastUpdated.Time.Equal(now)).To(BeTrue())
+
+	// 다음 주기에는 같은 TTLSummary를 갱신하고 최근 삭제 기록 수를 제한함
+	a.RecentDeletions = 1
+	g.Expect(a.Run(ctx, now.Add(2*time.Minute))).To(Succeed())
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: TTLSummaryName}, &summary)).To(Succeed())
+	g.Expect(summary.Status.Expired).To(Equal(3))
+	g.Expect(summary.Status.NextExpiryResource).To(Equal("default/later"))
+	g.Expect(summary.Status.RecentDeletions).To(ConsistOf(HaveField("Name", "new-deleted")))
+}
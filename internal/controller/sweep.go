@@ -0,0 +1,150 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// DefaultListPageSize는 정리 스윕에서 한 번에 조회하는 TTLResource 개수의 기본값입니다
+const DefaultListPageSize = 500
+
+// CleanupSweep은 owner가 사라졌는데 남아 있는 TTLResource를 찾아 삭제합니다.
+// 보통은 OwnerReference에 의해 GC되지만, 컨트롤러가 멈춰 있던 동안 생긴 잔여물을 정리하기 위해
+// manager 시작 시 한 번, Interval이 0보다 크면 주기적으로 실행됩니다.
+// TTLResource가 매우 많은 클러스터에서도 메모리와 API 타임아웃 문제가 없도록 Limit/Continue로 나누어 조회합니다.
+type CleanupSweep struct {
+	// Client는 owner 조회와 TTLResource 삭제에 사용합니다
+	Client client.Client
+	// Reader는 TTLResource 목록 조회에 사용합니다. 캐시는 Limit/Continue를 지원하지 않으므로 API reader를 사용합니다
+	Reader client.Reader
+	// Interval은 스윕 주기입니다. 0이면 시작 시 한 번만 실행합니다
+	Interval time.Duration
+	// PageSize는 한 번의 List 호출에서 가져오는 최대 개수입니다. 0 이하이면 DefaultListPageSize를 사용합니다
+	PageSize int64
+	// DeletionLimiter는 ResourceReconciler와 공유하는 전역 삭제 동시 실행 제한입니다. nil이면 제한하지 않습니다
+	DeletionLimiter *DeletionLimiter
+}
+
+// Start는 스윕을 실행하고, Interval이 있으면 ctx가 끝날 때까지 반복합니다. 스윕 실패는 manager를 중단시키지 않습니다.
+func (s *CleanupSweep) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("cleanup-sweep")
+
+	for {
+		deleted, err := s.Run(ctx)
+		if err != nil {
+			logger.Error(err, "Cleanup sweep failed")
+		} else {
+			logger.Info("Cleanup sweep finished", "deleted", deleted)
+		}
+
+		if s.Interval <= 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(s.Interval):
+		}
+	}
+}
+
+// NeedLeaderElection은 리더만 스윕을 실행하도록 합니다.
+func (s *CleanupSweep) NeedLeaderElection() bool {
+	return true
+}
+
+// Run은 resource 컨트롤러가 만든 TTLResource를 페이지 단위로 조회하며 고아 TTLResource를 삭제하고, 삭제한 개수를 반환합니다.
+func (s *CleanupSweep) Run(ctx context.Context) (int, error) {
+	logger := logf.FromContext(ctx).WithName("cleanup-sweep")
+
+	pageSize := s.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultListPageSize
+	}
+
+	deleted := 0
+	continueToken := ""
+	for {
+		// 페이지마다 새 목록을 사용하여 이전 페이지를 메모리에 남기지 않음
+		var page ttlv1alpha1.TTLResourceList
+		if err := s.Reader.List(ctx, &page,
+			client.MatchingLabels{TTLResourceLabelKey: TTLResourceLabelValue},
+			client.Limit(pageSize),
+			client.Continue(continueToken),
+		); err != nil {
+			return deleted, fmt.Errorf("failed to list TTLResources: %w", err)
+		}
+
+		for i := range page.Items {
+			ok, err := s.deleteIfOrphaned(ctx, &page.Items[i], logger)
+			if err != nil {
+				// 한 개의 실패로 스윕 전체를 멈추지 않음
+				logger.Error(err, "Failed to clean up TTLResource", "name", page.Items[i].Name, "namespace", page.Items[i].Namespace)
+				continue
+			}
+			if ok {
+				deleted++
+			}
+		}
+
+		continueToken = page.Continue
+		if continueToken == "" {
+			return deleted, nil
+		}
+	}
+}
+
+// deleteIfOrphaned는 TTLResource의 owner가 모두 사라졌으면 TTLResource를 삭제합니다.
+func (s *CleanupSweep) deleteIfOrphaned(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource, logger logr.Logger) (bool, error) {
+	if len(ttlResource.OwnerReferences) == 0 {
+		return false, nil
+	}
+
+	for _, ownerRef := range ttlResource.OwnerReferences {
+		owner, _, err := newOwnerObject(ownerRef, ttlResource.Namespace)
+		if err != nil {
+			// 지원하지 않는 owner는 판단할 수 없으므로 그대로 둠
+			return false, nil
+		}
+		err = s.Client.Get(ctx, client.ObjectKeyFromObject(owner), owner)
+		if err == nil && !uidMismatch(owner.GetUID(), ownerRef.UID) {
+			return false, nil
+		}
+		if err != nil && !errors.IsNotFound(err) {
+			return false, err
+		}
+	}
+
+	logger.Info("Deleting orphaned TTLResource", "name", ttlResource.Name, "namespace", ttlResource.Namespace)
+	if err := s.DeletionLimiter.Delete(ctx, s.Client, ttlResource); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func managedTTLResource(name, ownerName string) *ttlv1alpha1.TTLResource {
+	return &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       "default",
+			Labels:          map[string]string{TTLResourceLabelKey: TTLResourceLabelValue},
+			OwnerReferences: []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: ownerName}},
+		},
+		Spec: ttlv1alpha1.TTLResourceSpec{TTLSeconds: 60},
+	}
+}
+
+func TestCleanupSweepPaginatesAndDeletesOrphans(t *testing.T) {
+	g := NewWithT(t)
+
+	objs := []client.Object{
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "alive", Namespace: "default"}},
+		managedTTLResource("ttl-alive", "alive"),
+	}
+	for i := 0; i < 5; i++ {
+		name := "gone-" + strconv.Itoa(i)
+		objs = append(objs, managedTTLResource("ttl-"+name, name))
+	}
+
+	// fake client는 Limit/Continue를 지원하지 않으므로 interceptor에서 페이지를 나눔.
+	// 삭제가 페이지 위치에 영향을 주지 않도록 목록 조회용 reader와 삭제용 client를 분리
+	var limits []int64
+	reader := fake.NewClientBuilder().
+		WithScheme(newTestScheme(t)).
+		WithObjects(objs...).
+		WithInterceptorFuncs(interceptor.Funcs{
+			List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				listOpts := (&client.ListOptions{}).ApplyOptions(opts)
+				limits = append(limits, listOpts.Limit)
+				if err := c.List(ctx, list, opts...); err != nil {
+					return err
+				}
+				ttlList := list.(*ttlv1alpha1.TTLResourceList)
+				start := 0
+				if listOpts.Continue != "" {
+					start, _ = strconv.Atoi(listOpts.Continue)
+				}
+				end := min(start+int(listOpts.Limit), len(ttlList.Items))
+				if end < len(ttlList.Items) {
+					ttlList.Continue = strconv.Itoa(end)
+				}
+				ttlList.Items = ttlList.Items[start:end]
+				return nil
+			},
+		}).
+		Build()
+
+	c := fake.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(objs...).Build()
+
+	sweep := &CleanupSweep{Client: c, Reader: reader, PageSize: 2}
+	deleted, err := sweep.Run(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(deleted).To(Equal(5))
+	g.Expect(limits).To(Equal([]int64{2, 2, 2}))
+	g.Expect(c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-alive"},
+		&ttlv1alpha1.TTLResource{})).To(Succeed())
+}
+
+func TestCleanupSweepKeepsResourcesWithLiveOwners(t *testing.T) {
+	g := NewWithT(t)
+
+	manual := &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "manual",
+			Namespace:       "default",
+			OwnerReferences: []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: "gone"}},
+		},
+		Spec: ttlv1alpha1.TTLResourceSpec{TTLSeconds: 60},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(newTestScheme(t)).
+		WithObjects(
+			&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "alive", Namespace: "default"}},
+			managedTTLResource("ttl-alive", "alive"),
+			managedTTLResource("ttl-gone", "gone"),
+			manual,
+		).
+		Build()
+
+	sweep := &CleanupSweep{Client: c, Reader: c}
+	deleted, err := sweep.Run(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(deleted).To(Equal(1))
+
+	err = c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-gone"}, &ttlv1alpha1.TTLResource{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+	g.Expect(c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-alive"},
+		&ttlv1alpha1.TTLResource{})).To(Succeed())
+	// label이 없는 직접 작성한 TTLResource는 스윕 대상이 아님
+	g.Expect(c.Get(context.Background(), client.ObjectKeyFromObject(manual), &ttlv1alpha1.TTLResource{})).To(Succeed())
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// spec.targetRef와 OwnerReference가 모두 있을 때 삭제 대상을 정하는 방식
+const (
+	// TargetRefPrecedenceAuto는 직접 작성한 TTLResource에서는 targetRef를, 컨트롤러가 생성한 TTLResource에서는
+	// OwnerReference를 따릅니다 (기본값)
+	TargetRefPrecedenceAuto = "auto"
+	// TargetRefPrecedenceTargetRef는 항상 targetRef를 따릅니다
+	TargetRefPrecedenceTargetRef = "target-ref"
+	// TargetRefPrecedenceOwnerReferences는 항상 OwnerReference를 따릅니다
+	TargetRefPrecedenceOwnerReferences = "owner-references"
+)
+
+// ConditionTargetMismatch는 targetRef와 OwnerReference가 서로 다른 리소스를 가리켜 삭제를 거부했음을 나타냅니다
+const ConditionTargetMismatch = "TargetMismatch"
+
+// ConditionNoManagedOwner는 컨트롤러가 만든 TTLResource에 다른 컨트롤러가 붙인 OwnerReference만 남아 삭제를 거부했음을 나타냅니다
+const ConditionNoManagedOwner = "NoManagedOwner"
+
+// errNoManagedOwner는 컨트롤러가 만든 TTLResource에서 삭제해도 되는 owner를 찾지 못했음을 나타냅니다
+var errNoManagedOwner = errors.New("no ownerReference belongs to the resource this TTLResource was created for")
+
+// ValidateTargetRefPrecedence는 targetRef 우선순위 값이 올바른지 확인합니다.
+func ValidateTargetRefPrecedence(precedence string) error {
+	switch precedence {
+	case "", TargetRefPrecedenceAuto, TargetRefPrecedenceTargetRef, TargetRefPrecedenceOwnerReferences:
+		return nil
+	default:
+		return fmt.Errorf("unknown target-ref precedence %q (expected %s, %s or %s)",
+			precedence, TargetRefPrecedenceAuto, TargetRefPrecedenceTargetRef, TargetRefPrecedenceOwnerReferences)
+	}
+}
+
+// targetRefOwner는 spec.targetRef를 삭제 대상 목록에서 쓰는 OwnerReference 형태로 바꿉니다.
+func targetRefOwner(ref *ttlv1alpha1.TargetReference) metav1.OwnerReference {
+	return metav1.OwnerReference{APIVersion: ref.APIVersion, Kind: ref.Kind, Name: ref.Name}
+}
+
+// sameTarget은 두 참조가 같은 리소스(group, Kind, 이름)를 가리키는지 확인합니다. API 버전 차이는 무시합니다.
+func sameTarget(a, b metav1.OwnerReference) bool {
+	ga, errA := schema.ParseGroupVersion(a.APIVersion)
+	gb, errB := schema.ParseGroupVersion(b.APIVersion)
+	if errA != nil || errB != nil {
+		return false
+	}
+	return ga.Group == gb.Group && a.Kind == b.Kind && a.Name == b.Name
+}
+
+// targetRefWins는 targetRef와 OwnerReference가 모두 있을 때 targetRef를 따를지 결정합니다.
+func (r *ResourceReconciler) targetRefWins(ttlResource *ttlv1alpha1.TTLResource) bool {
+	switch r.TargetRefPrecedence {
+	case TargetRefPrecedenceTargetRef:
+		return true
+	case TargetRefPrecedenceOwnerReferences:
+		return false
+	default:
+		// 컨트롤러가 생성한 TTLResource의 OwnerReference는 실제 리소스에서 만들어진 것이므로 우선함
+		return ttlResource.Labels[TTLResourceLabelKey] != TTLResourceLabelValue
+	}
+}
+
+// deletionTargets는 만료 시 삭제할 리소스 목록을 반환합니다.
+// targetRef만 있으면 targetRef를, OwnerReference만 있으면 OwnerReference를 사용하고,
+// 둘 다 있으면 TargetRefPrecedence에 따라 하나를 고릅니다.
+// targetRef가 어떤 OwnerReference와도 다른 리소스를 가리키거나, 컨트롤러가 만든 TTLResource에 다른 컨트롤러가 붙인
+// OwnerReference만 남아 있으면 잘못된 리소스를 지우지 않도록 오류를 반환합니다.
+func (r *ResourceReconciler) deletionTargets(ttlResource *ttlv1alpha1.TTLResource) ([]metav1.OwnerReference, error) {
+	allOwners := ownersOf(ttlResource)
+	owners := r.managedOwners(ttlResource, allOwners)
+	if ttlResource.Spec.TargetRef == nil {
+		if len(owners) == 0 && len(allOwners) > 0 {
+			return nil, fmt.Errorf("%w: refusing to delete %s/%s added by another controller",
+				errNoManagedOwner, allOwners[0].Kind, allOwners[0].Name)
+		}
+		return owners, nil
+	}
+	target := targetRefOwner(ttlResource.Spec.TargetRef)
+	if len(owners) == 0 {
+		return []metav1.OwnerReference{target}, nil
+	}
+
+	for _, owner := range owners {
+		if !sameTarget(owner, target) {
+			continue
+		}
+		if r.targetRefWins(ttlResource) {
+			// UID 확인이 가능하도록 같은 리소스를 가리키는 OwnerReference를 사용
+			return []metav1.OwnerReference{owner}, nil
+		}
+		return owners, nil
+	}
+	return nil, fmt.Errorf("spec.targetRef %s/%s does not match any ownerReference (first is %s/%s)",
+		target.Kind, target.Name, owners[0].Kind, owners[0].Name)
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestTargetRefOnlyDeletesTarget(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	ttlResource.OwnerReferences = nil
+	ttlResource.Spec.TargetRef = &ttlv1alpha1.TargetReference{APIVersion: "v1", Kind: "Pod", Name: "web"}
+	r := newTestReconciler(t, pod, ttlResource)
+
+	reconcileKey(t, r, "default", "ttl-web")
+
+	err := r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue(), "targetRef Pod should be deleted")
+}
+
+func TestDeletionTargetsPrecedence(t *testing.T) {
+	g := NewWithT(t)
+
+	owners := []metav1.OwnerReference{
+		{APIVersion: "v1", Kind: "Pod", Name: "web"},
+		{APIVersion: "v1", Kind: "ConfigMap", Name: "web"},
+	}
+	for _, tc := range []struct {
+		precedence string
+		labeled    bool
+		expected   int
+	}{
+		// 직접 작성한 TTLResource는 targetRef만 삭제
+		{precedence: "", labeled: false, expected: 1},
+		// 컨트롤러가 생성한 TTLResource는 OwnerReference 전체를 삭제
+		{precedence: TargetRefPrecedenceAuto, labeled: true, expected: 2},
+		{precedence: TargetRefPrecedenceTargetRef, labeled: true, expected: 1},
+		{precedence: TargetRefPrecedenceOwnerReferences, labeled: false, expected: 2},
+	} {
+		ttlResource := &ttlv1alpha1.TTLResource{
+			ObjectMeta: metav1.ObjectMeta{Name: "ttl-web", Namespace: "default", OwnerReferences: owners},
+			Spec: ttlv1alpha1.TTLResourceSpec{
+				TTLSeconds: 60,
+				TargetRef:  &ttlv1alpha1.TargetReference{APIVersion: "v1", Kind: "Pod", Name: "web"},
+			},
+		}
+		if tc.labeled {
+			ttlResource.Labels = map[string]string{TTLResourceLabelKey: TTLResourceLabelValue}
+		}
+		r := &ResourceReconciler{TargetRefPrecedence: tc.precedence}
+
+		targets, err := r.deletionTargets(ttlResource)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(targets).To(HaveLen(tc.expected), "precedence %q labeled=%v", tc.precedence, tc.labeled)
+		g.Expect(targets[0].Kind).To(Equal("Pod"))
+	}
+}
+
+func TestMismatchedTargetRefRefusesDeletion(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	other := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"}}
+	ttlResource.Spec.TargetRef = &ttlv1alpha1.TargetReference{APIVersion: "v1", Kind: "Pod", Name: "db"}
+	r := newTestReconciler(t, pod, other, ttlResource)
+
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(BeZero())
+
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(other), &corev1.Pod{})).To(Succeed())
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(meta.IsStatusConditionTrue(latest.Status.Conditions, ConditionTargetMismatch)).To(BeTrue())
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// ConditionInvalidTargetSelector는 spec.targetSelector가 잘못되어 삭제를 거부했음을 나타냅니다
+const ConditionInvalidTargetSelector = "InvalidTargetSelector"
+
+// errInvalidTargetSelector는 spec.targetSelector를 고치기 전에는 대상을 고를 수 없음을 나타냅니다
+var errInvalidTargetSelector = stderrors.New("invalid spec.targetSelector")
+
+// selectorTargets는 spec.targetSelector와 일치하는 같은 네임스페이스의 리소스를 삭제 대상 목록 형태로 반환합니다.
+// 빈 selector는 네임스페이스의 모든 리소스와 일치하므로 거부하고, watch하는 Kind만 허용합니다.
+// 보호 대상 label/annotation이 있거나 이미 삭제 중인 리소스는 고르지 않습니다.
+func (r *ResourceReconciler) selectorTargets(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource) ([]metav1.OwnerReference, error) {
+	spec := ttlResource.Spec.TargetSelector
+	gv, err := schema.ParseGroupVersion(spec.APIVersion)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidTargetSelector, err)
+	}
+	gvk := gv.WithKind(spec.Kind)
+	if supported, ok := supportedKinds[spec.Kind]; !ok || supported != gvk || !slices.Contains(r.watchedKinds(), spec.Kind) {
+		return nil, fmt.Errorf("%w: kind %s %s is not watched by the operator", errInvalidTargetSelector, spec.APIVersion, spec.Kind)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidTargetSelector, err)
+	}
+	if selector.Empty() {
+		return nil, fmt.Errorf("%w: selector must not be empty", errInvalidTargetSelector)
+	}
+
+	listObj, err := r.Scheme.New(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err != nil {
+		return nil, err
+	}
+	list, ok := listObj.(client.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a list type", gvk.Kind+"List")
+	}
+	if err := r.List(ctx, list, client.InNamespace(ttlResource.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("failed to list %s for spec.targetSelector: %w", gvk.Kind, err)
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]metav1.OwnerReference, 0, len(items))
+	for _, item := range items {
+		obj, ok := item.(client.Object)
+		if !ok || obj.GetDeletionTimestamp() != nil || r.excludedResource(obj) != "" {
+			continue
+		}
+		targets = append(targets, metav1.OwnerReference{
+			APIVersion: spec.APIVersion,
+			Kind:       spec.Kind,
+			Name:       obj.GetName(),
+			UID:        obj.GetUID(),
+		})
+	}
+	return targets, nil
+}
+
+// appendSelectorTargets는 selector로 고른 대상 중 이미 목록에 있는 리소스를 빼고 덧붙입니다.
+func appendSelectorTargets(owners, selected []metav1.OwnerReference) []metav1.OwnerReference {
+	for _, target := range selected {
+		if !slices.ContainsFunc(owners, func(owner metav1.OwnerReference) bool { return sameTarget(owner, target) }) {
+			owners = append(owners, target)
+		}
+	}
+	return owners
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func labeledPod(name string, labels map[string]string) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels}}
+}
+
+func expiredSelectorTTLResource(selector metav1.LabelSelector) *ttlv1alpha1.TTLResource {
+	return &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "preview-cleanup",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+		},
+		Spec: ttlv1alpha1.TTLResourceSpec{
+			TTLSeconds:     60,
+			TargetSelector: &ttlv1alpha1.TargetSelector{APIVersion: "v1", Kind: "Pod", Selector: selector},
+		},
+	}
+}
+
+func TestTargetSelectorDeletesAllMatches(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	first := labeledPod("preview-1", map[string]string{"env": "preview"})
+	second := labeledPod("preview-2", map[string]string{"env": "preview"})
+	kept := labeledPod("prod", map[string]string{"env": "prod"})
+	ttlResource := expiredSelectorTTLResource(metav1.LabelSelector{MatchLabels: map[string]string{"env": "preview"}})
+	r := newTestReconciler(t, first, second, kept, ttlResource)
+
+	reconcileKey(t, r, "default", "preview-cleanup")
+
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(first), &corev1.Pod{}))).To(BeTrue())
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(second), &corev1.Pod{}))).To(BeTrue())
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(kept), &corev1.Pod{})).To(Succeed())
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &ttlv1alpha1.TTLResource{}))).To(BeTrue())
+}
+
+func TestTargetSelectorIsBoundedByBatchSize(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pods := []client.Object{}
+	for _, name := range []string{"preview-1", "preview-2", "preview-3"} {
+		pods = append(pods, labeledPod(name, map[string]string{"env": "preview"}))
+	}
+	ttlResource := expiredSelectorTTLResource(metav1.LabelSelector{MatchLabels: map[string]string{"env": "preview"}})
+	r := newTestReconciler(t, append(pods, ttlResource)...)
+	r.MaxDeletesPerCycle = 2
+
+	// 한 번에 2개까지만 삭제하고 남은 수를 기록한 뒤 재큐잉
+	result := reconcileKey(t, r, "default", "preview-cleanup")
+	g.Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+	var list corev1.PodList
+	g.Expect(r.List(ctx, &list)).To(Succeed())
+	g.Expect(list.Items).To(HaveLen(1))
+	var current ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &current)).To(Succeed())
+	g.Expect(current.Status.RemainingDeletions).To(Equal(1))
+
+	reconcileKey(t, r, "default", "preview-cleanup")
+	g.Expect(r.List(ctx, &list)).To(Succeed())
+	g.Expect(list.Items).To(BeEmpty())
+}
+
+func TestTargetSelectorRejectsEmptyOrUnwatchedSelector(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name     string
+		selector ttlv1alpha1.TargetSelector
+	}{
+		{name: "empty", selector: ttlv1alpha1.TargetSelector{APIVersion: "v1", Kind: "Pod"}},
+		{name: "unwatched", selector: ttlv1alpha1.TargetSelector{APIVersion: "v1", Kind: "Secret",
+			Selector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "preview"}}}},
+	} {
+		pod := labeledPod("preview-1", map[string]string{"env": "preview"})
+		ttlResource := expiredSelectorTTLResource(metav1.LabelSelector{})
+		ttlResource.Spec.TargetSelector = &tc.selector
+		r := newTestReconciler(t, pod, ttlResource)
+
+		reconcileKey(t, r, "default", "preview-cleanup")
+
+		// 네임스페이스 전체를 지우지 않도록 아무것도 삭제하지 않고 condition을 남김
+		g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed(), tc.name)
+		var current ttlv1alpha1.TTLResource
+		g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &current)).To(Succeed(), tc.name)
+		g.Expect(meta.IsStatusConditionTrue(current.Status.Conditions, ConditionInvalidTargetSelector)).To(BeTrue(), tc.name)
+	}
+}
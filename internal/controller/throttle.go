@@ -0,0 +1,40 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+// defaultThrottleDelay는 API 서버가 Retry-After 없이 429를 반환했을 때 기다리는 시간입니다
+const defaultThrottleDelay = 5 * time.Second
+
+// throttleDelay는 err가 API 서버의 throttling 응답(429 또는 Retry-After)이면 서버가 제안한 재시도 지연과 true를 반환합니다.
+func throttleDelay(err error) (time.Duration, bool) {
+	if err == nil {
+		return 0, false
+	}
+	if seconds, ok := errors.SuggestsClientDelay(err); ok && seconds > 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if errors.IsTooManyRequests(err) {
+		return defaultThrottleDelay, true
+	}
+	return 0, false
+}
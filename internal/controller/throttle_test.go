@@ -0,0 +1,111 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestThrottleDelay(t *testing.T) {
+	g := NewWithT(t)
+
+	delay, ok := throttleDelay(errors.NewTooManyRequests("slow down", 7))
+	g.Expect(ok).To(BeTrue())
+	g.Expect(delay).To(Equal(7 * time.Second))
+
+	delay, ok = throttleDelay(errors.NewTooManyRequests("slow down", 0))
+	g.Expect(ok).To(BeTrue())
+	g.Expect(delay).To(Equal(defaultThrottleDelay))
+
+	_, ok = throttleDelay(stderrors.New("connection reset by peer"))
+	g.Expect(ok).To(BeFalse())
+	_, ok = throttleDelay(nil)
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestThrottledDeletionHonorsRetryAfter(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	scheme := newTestScheme(t)
+	throttling := true
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(pod, ttlResource).
+		WithStatusSubresource(&ttlv1alpha1.TTLResource{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				if _, ok := obj.(*corev1.Pod); ok && throttling {
+					return errors.NewTooManyRequests("too many requests", 7)
+				}
+				return c.Delete(ctx, obj, opts...)
+			},
+		}).
+		Build()
+	r := &ResourceReconciler{Client: c, Scheme: scheme}
+	before := testutil.ToFloat64(apiThrottledTotal.WithLabelValues("delete"))
+
+	// 서버가 제안한 지연만큼 기다리고 삭제 실패로 세지 않음
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(Equal(7 * time.Second))
+	g.Expect(testutil.ToFloat64(apiThrottledTotal.WithLabelValues("delete"))).To(Equal(before + 1))
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(latest.Status.RetryCount).To(BeZero())
+	g.Expect(meta.FindStatusCondition(latest.Status.Conditions, ConditionDeletionFailed)).To(BeNil())
+
+	throttling = false
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{}))).To(BeTrue())
+}
+
+func TestThrottledReconcileRequeuesAfterSuggestedDelay(t *testing.T) {
+	g := NewWithT(t)
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	scheme := newTestScheme(t)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(pod, ttlResource).
+		WithStatusSubresource(&ttlv1alpha1.TTLResource{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceUpdate: func(context.Context, client.Client, string, client.Object, ...client.SubResourceUpdateOption) error {
+				return errors.NewTooManyRequests("too many requests", 3)
+			},
+		}).
+		Build()
+	r := &ResourceReconciler{Client: c, Scheme: scheme}
+
+	// status 업데이트가 throttle되면 오류 대신 제안된 지연으로 재큐잉
+	result := reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(result.RequeueAfter).To(Equal(3 * time.Second))
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// +kubebuilder:rbac:groups="",resources=pods;services,verbs=create
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=create
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=create
+
+const (
+	// TrashedFromAnnotationKey는 보관용 사본에 원본 리소스("namespace/name")를 기록하는 annotation 키입니다
+	TrashedFromAnnotationKey = "ttl.example.com/trashed-from"
+	// TrashedAtAnnotationKey는 보관용 사본을 만든 시각(RFC3339)을 기록하는 annotation 키입니다
+	TrashedAtAnnotationKey = "ttl.example.com/trashed-at"
+	// TrashedServiceTypeAnnotationKey는 ClusterIP로 바꾼 Service 사본에 원래 type을 기록하는 annotation 키입니다
+	TrashedServiceTypeAnnotationKey = "ttl.example.com/trashed-service-type"
+	// trashSchedulingGate는 보관용 Pod 사본이 스케줄되어 다시 실행되지 않도록 막는 scheduling gate입니다
+	trashSchedulingGate = "ttl.example.com/trashed"
+)
+
+// annotationPrefix는 Operator가 사용하는 annotation 키의 접두사입니다
+const annotationPrefix = "ttl.example.com/"
+
+// trashName은 원본 네임스페이스와 이름을 붙인 사본 이름을 만듭니다.
+// "a-b/c"와 "a/b-c"처럼 이어 붙이면 같아지거나 잘린 앞부분이 같은 원본끼리 부딪히지 않도록
+// "namespace/name"의 해시를 붙이고, Service 이름 제한(63자)에 맞추어 앞부분을 자릅니다.
+func trashName(namespace, name string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(namespace + "/" + name))
+	suffix := fmt.Sprintf("-%08x", h.Sum32())
+
+	value := namespace + "-" + name
+	if maxLen := 63 - len(suffix); len(value) > maxLen {
+		value = strings.TrimRight(value[:maxLen], "-.")
+	}
+	return value + suffix
+}
+
+// trashCopy는 owner를 TrashNamespace에 다시 만들 수 있도록 서버가 채우는 필드와 바꿀 수 없는 필드를 지운 사본을 만듭니다.
+// 사본이 다시 실행되지 않도록 Pod는 scheduling gate를, Deployment는 replicas 0을, Job은 suspend를 지정하고,
+// LoadBalancer/NodePort Service는 클라우드 리소스를 만들지 않도록 ClusterIP로 바꿉니다.
+func (r *ResourceReconciler) trashCopy(owner client.Object, now time.Time) (client.Object, error) {
+	copied := owner.DeepCopyObject().(client.Object)
+	extra := map[string]string{}
+
+	switch obj := copied.(type) {
+	case *corev1.Pod:
+		obj.Spec.NodeName = ""
+		obj.Spec.EphemeralContainers = nil
+		obj.Spec.SchedulingGates = append(obj.Spec.SchedulingGates, corev1.PodSchedulingGate{Name: trashSchedulingGate})
+		obj.Status = corev1.PodStatus{}
+	case *corev1.Service:
+		if obj.Spec.Type == corev1.ServiceTypeLoadBalancer || obj.Spec.Type == corev1.ServiceTypeNodePort {
+			extra[TrashedServiceTypeAnnotationKey] = string(obj.Spec.Type)
+			obj.Spec.Type = corev1.ServiceTypeClusterIP
+			obj.Spec.ExternalTrafficPolicy = ""
+			obj.Spec.AllocateLoadBalancerNodePorts = nil
+			obj.Spec.LoadBalancerClass = nil
+			obj.Spec.HealthCheckNodePort = 0
+		}
+		obj.Spec.ClusterIP = ""
+		obj.Spec.ClusterIPs = nil
+		for i := range obj.Spec.Ports {
+			obj.Spec.Ports[i].NodePort = 0
+		}
+		obj.Status = corev1.ServiceStatus{}
+	case *appsv1.Deployment:
+		obj.Spec.Replicas = ptr.To[int32](0)
+		obj.Status = appsv1.DeploymentStatus{}
+	case *batchv1.Job:
+		// Job controller가 붙인 selector와 label은 원본 Job의 UID를 가리키므로 지움
+		obj.Spec.Suspend = ptr.To(true)
+		obj.Spec.Selector = nil
+		obj.Spec.ManualSelector = nil
+		stripJobControllerLabels(obj.Labels)
+		stripJobControllerLabels(obj.Spec.Template.Labels)
+		obj.Status = batchv1.JobStatus{}
+	case *corev1.ConfigMap, *corev1.Endpoints: //nolint:staticcheck // 직접 만든 Endpoints도 보관
+		// 그대로 보관
+	case *discoveryv1.EndpointSlice:
+		// 보관용 네임스페이스의 Service에 트래픽이 가지 않도록 Service 연결 label을 지움
+		delete(obj.Labels, discoveryv1.LabelServiceName)
+		delete(obj.Labels, discoveryv1.LabelManagedBy)
+	default:
+		return nil, fmt.Errorf("trash is not supported for %T", owner)
+	}
+
+	copied.SetName(trashName(owner.GetNamespace(), owner.GetName()))
+	copied.SetNamespace(r.TrashNamespace)
+	copied.SetGenerateName("")
+	copied.SetUID("")
+	copied.SetResourceVersion("")
+	copied.SetGeneration(0)
+	copied.SetCreationTimestamp(metav1.Time{})
+	copied.SetDeletionTimestamp(nil)
+	copied.SetDeletionGracePeriodSeconds(nil)
+	copied.SetOwnerReferences(nil)
+	copied.SetFinalizers(nil)
+	copied.SetManagedFields(nil)
+
+	// 원본의 TTL 관련 annotation은 사본에 적용되지 않도록 모두 지우고, 보관 기간(TrashTTL)만 새로 지정
+	annotations := map[string]string{}
+	for key, value := range copied.GetAnnotations() {
+		if !strings.HasPrefix(key, annotationPrefix) {
+			annotations[key] = value
+		}
+	}
+	for key, value := range extra {
+		annotations[key] = value
+	}
+	annotations[TrashedFromAnnotationKey] = owner.GetNamespace() + "/" + owner.GetName()
+	annotations[TrashedAtAnnotationKey] = now.UTC().Format(time.RFC3339)
+	if r.TrashTTL > 0 {
+		annotations[TTLAnnotationKey] = strconv.Itoa(int(r.TrashTTL.Seconds()))
+		// 보관 기간이 끝난 사본은 다시 보관하지 않고 삭제
+		annotations[ExpiryActionAnnotationKey] = ExpiryActionDelete
+	}
+	copied.SetAnnotations(annotations)
+	return copied, nil
+}
+
+// moveToTrash는 owner의 사본을 TrashNamespace에 만듭니다. 이전 시도에서 이미 만든 사본이 있으면 그대로 둡니다.
+// 사본을 만들지 못했거나 같은 이름의 사본이 다른 원본의 것이면 원본을 삭제하지 않도록 오류를 반환합니다.
+func (r *ResourceReconciler) moveToTrash(ctx context.Context, owner client.Object, logger logr.Logger) error {
+	copied, err := r.trashCopy(owner, r.now())
+	if err != nil {
+		return err
+	}
+	if err := r.Create(ctx, copied); err != nil {
+		if errors.IsAlreadyExists(err) {
+			return r.checkExistingTrashCopy(ctx, owner, copied, logger)
+		}
+		return fmt.Errorf("failed to copy %s/%s to trash namespace %s: %w", owner.GetNamespace(), owner.GetName(), r.TrashNamespace, err)
+	}
+	logger.Info("Copied expired resource to trash namespace", "owner", owner.GetNamespace()+"/"+owner.GetName(),
+		"copy", r.TrashNamespace+"/"+copied.GetName(), "trashTTL", r.TrashTTL)
+	return nil
+}
+
+// checkExistingTrashCopy는 이미 있는 사본이 owner의 사본인지 확인합니다.
+// 다른 원본의 사본이면 owner의 사본이 없는 것이므로 오류를 반환합니다.
+func (r *ResourceReconciler) checkExistingTrashCopy(ctx context.Context, owner, copied client.Object, logger logr.Logger) error {
+	source := owner.GetNamespace() + "/" + owner.GetName()
+	existing := copied.DeepCopyObject().(client.Object)
+	if err := r.Get(ctx, client.ObjectKeyFromObject(copied), existing); err != nil {
+		return fmt.Errorf("failed to get existing trash copy %s/%s of %s: %w", r.TrashNamespace, copied.GetName(), source, err)
+	}
+	if from := existing.GetAnnotations()[TrashedFromAnnotationKey]; from != source {
+		return fmt.Errorf("trash copy %s/%s belongs to %q, not %s", r.TrashNamespace, copied.GetName(), from, source)
+	}
+	logger.V(1).Info("Trash copy already exists", "owner", owner.GetName(), "copy", r.TrashNamespace+"/"+copied.GetName())
+	return nil
+}
+
+// stripJobControllerLabels는 Job controller가 자동으로 붙이는 label을 지웁니다.
+func stripJobControllerLabels(labels map[string]string) {
+	for _, key := range []string{"controller-uid", "job-name", batchv1.ControllerUidLabel, batchv1.JobNameLabel} {
+		delete(labels, key)
+	}
+}
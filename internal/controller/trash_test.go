@@ -0,0 +1,152 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestTrashActionCopiesOwnerBeforeDeleting(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	pod.UID = "pod-uid"
+	pod.ResourceVersion = ""
+	pod.Annotations = map[string]string{TTLAnnotationKey: "60", ExpiryActionAnnotationKey: ExpiryActionTrash, "team": "a"}
+	pod.Spec = corev1.PodSpec{NodeName: "node-1", Containers: []corev1.Container{{Name: "app", Image: "nginx"}}}
+	r := newTestReconciler(t, pod, ttlResource)
+	r.TrashNamespace = "trash"
+	r.TrashTTL = 24 * time.Hour
+
+	reconcileKey(t, r, "default", "ttl-web")
+
+	err := r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue(), "original Pod should be deleted")
+
+	var copied corev1.Pod
+	g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "trash", Name: trashName("default", "web")}, &copied)).To(Succeed())
+	g.Expect(copied.Spec.Containers).To(HaveLen(1))
+	g.Expect(copied.Spec.NodeName).To(BeEmpty())
+	g.Expect(copied.Spec.SchedulingGates).To(ContainElement(corev1.PodSchedulingGate{Name: trashSchedulingGate}))
+	g.Expect(copied.Annotations).To(HaveKeyWithValue("team", "a"))
+	g.Expect(copied.Annotations).To(HaveKeyWithValue(TrashedFromAnnotationKey, "default/web"))
+	g.Expect(copied.Annotations).To(HaveKeyWithValue(TTLAnnotationKey, "86400"))
+	g.Expect(copied.Annotations).To(HaveKeyWithValue(ExpiryActionAnnotationKey, ExpiryActionDelete))
+}
+
+func TestTrashActionWithoutNamespaceDeletes(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	pod.Annotations = map[string]string{ExpiryActionAnnotationKey: ExpiryActionTrash}
+	r := newTestReconciler(t, pod, ttlResource)
+
+	reconcileKey(t, r, "default", "ttl-web")
+
+	err := r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+	var pods corev1.PodList
+	g.Expect(r.List(ctx, &pods)).To(Succeed())
+	g.Expect(pods.Items).To(BeEmpty())
+}
+
+func TestTrashNameDoesNotCollide(t *testing.T) {
+	g := NewWithT(t)
+
+	// 이어 붙이면 같은 이름이 되는 원본
+	g.Expect(trashName("a-b", "c")).NotTo(Equal(trashName("a", "b-c")))
+
+	// 잘린 앞부분이 같은 긴 이름
+	long := strings.Repeat("x", 70)
+	first, second := trashName("default", long+"-1"), trashName("default", long+"-2")
+	g.Expect(first).NotTo(Equal(second))
+	g.Expect(len(first)).To(BeNumerically("<=", 63))
+	g.Expect(len(second)).To(BeNumerically("<=", 63))
+}
+
+func TestTrashActionKeepsOwnerWhenCopyBelongsToAnotherOwner(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	pod.Annotations = map[string]string{ExpiryActionAnnotationKey: ExpiryActionTrash}
+	// 같은 사본 이름을 가진 다른 원본의 사본이 이미 있는 상황
+	foreign := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        trashName("default", "web"),
+		Namespace:   "trash",
+		Annotations: map[string]string{TrashedFromAnnotationKey: "other/web"},
+	}}
+	r := newTestReconciler(t, pod, ttlResource, foreign)
+	r.TrashNamespace = "trash"
+
+	reconcileKey(t, r, "default", "ttl-web")
+
+	// 원본의 사본이 없으므로 원본과 TTLResource를 삭제하지 않음
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &ttlv1alpha1.TTLResource{})).To(Succeed())
+	var existing corev1.Pod
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(foreign), &existing)).To(Succeed())
+	g.Expect(existing.Annotations).To(HaveKeyWithValue(TrashedFromAnnotationKey, "other/web"))
+}
+
+func TestTrashCopyNeutralizesWorkloads(t *testing.T) {
+	g := NewWithT(t)
+	r := &ResourceReconciler{TrashNamespace: "trash"}
+
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "dev", UID: "uid", ResourceVersion: "42"},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](3)},
+	}
+	copied, err := r.trashCopy(deploy, time.Now())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(copied.GetNamespace()).To(Equal("trash"))
+	g.Expect(copied.GetUID()).To(BeEmpty())
+	g.Expect(copied.GetResourceVersion()).To(BeEmpty())
+	g.Expect(*copied.(*appsv1.Deployment).Spec.Replicas).To(BeEquivalentTo(0))
+	g.Expect(*deploy.Spec.Replicas).To(BeEquivalentTo(3), "original should not be modified")
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "lb", Namespace: "dev"},
+		Spec: corev1.ServiceSpec{
+			Type:      corev1.ServiceTypeLoadBalancer,
+			ClusterIP: "10.0.0.1",
+			Ports:     []corev1.ServicePort{{Port: 80, NodePort: 30080}},
+		},
+	}
+	copied, err = r.trashCopy(svc, time.Now())
+	g.Expect(err).NotTo(HaveOccurred())
+	copiedSvc := copied.(*corev1.Service)
+	g.Expect(copiedSvc.Spec.Type).To(Equal(corev1.ServiceTypeClusterIP))
+	g.Expect(copiedSvc.Spec.ClusterIP).To(BeEmpty())
+	g.Expect(copiedSvc.Spec.Ports[0].NodePort).To(BeZero())
+	g.Expect(copiedSvc.Annotations).To(HaveKeyWithValue(TrashedServiceTypeAnnotationKey, "LoadBalancer"))
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// inheritedOwnerTTL은 리소스의 controller owner를 최대 OwnerTraversalDepth 단계까지 따라 올라가
+// TTL annotation이 있는 상위 리소스를 찾고, 그 리소스와 같은 시각에 만료되는 spec을 반환합니다.
+// 예: Deployment에만 TTL을 지정하면 Deployment → ReplicaSet → Pod 전체가 같은 시각에 만료됩니다.
+// 조회할 수 없는 owner(권한 없음, 삭제됨)를 만나면 순회를 멈춥니다.
+func (r *ResourceReconciler) inheritedOwnerTTL(ctx context.Context, obj client.Object, logger logr.Logger) (ttlv1alpha1.TTLResourceSpec, bool, error) {
+	current := obj
+	for depth := 0; depth < r.OwnerTraversalDepth; depth++ {
+		ref := metav1.GetControllerOf(current)
+		if ref == nil {
+			return ttlv1alpha1.TTLResourceSpec{}, false, nil
+		}
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			return ttlv1alpha1.TTLResourceSpec{}, false, nil
+		}
+
+		owner := &metav1.PartialObjectMetadata{}
+		owner.SetGroupVersionKind(gv.WithKind(ref.Kind))
+		if err := r.Get(ctx, client.ObjectKey{Namespace: obj.GetNamespace(), Name: ref.Name}, owner); err != nil {
+			if errors.IsNotFound(err) || errors.IsForbidden(err) {
+				return ttlv1alpha1.TTLResourceSpec{}, false, nil
+			}
+			return ttlv1alpha1.TTLResourceSpec{}, false, err
+		}
+		if uidMismatch(owner.UID, ref.UID) {
+			return ttlv1alpha1.TTLResourceSpec{}, false, nil
+		}
+
+		if value, ok := owner.Annotations[TTLAnnotationKey]; ok {
+			ttlSeconds, err := parseTTLAnnotationSeconds(value)
+			if err != nil {
+				return ttlv1alpha1.TTLResourceSpec{}, false, nil
+			}
+			startTime := owner.CreationTimestamp
+			logger.V(1).Info("Inheriting TTL from owner", "resource", obj.GetName(),
+				"owner", ref.Kind+"/"+ref.Name, "depth", depth+1, "ttlSeconds", ttlSeconds)
+			return ttlv1alpha1.TTLResourceSpec{TTLSeconds: ttlSeconds, StartTime: &startTime}, true, nil
+		}
+		current = owner
+	}
+	return ttlv1alpha1.TTLResourceSpec{}, false, nil
+}
+
+// requestsForDeploymentTree는 Deployment와, 그 Deployment의 selector에 해당하면서 TTL annotation이 없는 Pod를
+// reconcile하도록 요청을 만듭니다. Deployment의 TTL이 바뀌면 상속받은 Pod의 TTLResource도 갱신됩니다.
+func (r *ResourceReconciler) requestsForDeploymentTree(ctx context.Context, obj client.Object) []reconcile.Request {
+	requests := []reconcile.Request{{NamespacedName: client.ObjectKeyFromObject(obj)}}
+
+	deploy, ok := obj.(*appsv1.Deployment)
+	if !ok || deploy.Spec.Selector == nil {
+		return requests
+	}
+	selector, err := metav1.LabelSelectorAsSelector(deploy.Spec.Selector)
+	if err != nil {
+		return requests
+	}
+
+	var pods corev1.PodList
+	if err := r.List(ctx, &pods, client.InNamespace(deploy.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list Pods for Deployment", "deployment", deploy.Name)
+		return requests
+	}
+	for _, pod := range pods.Items {
+		if _, explicit := pod.Annotations[TTLAnnotationKey]; explicit {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&pod)})
+	}
+	return requests
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestOwnerTraversalInheritsDeploymentTTL(t *testing.T) {
+	g := NewWithT(t)
+
+	for _, tc := range []struct {
+		depth   int
+		inherit bool
+	}{
+		{depth: 0, inherit: false},
+		{depth: 1, inherit: false},
+		{depth: 2, inherit: true},
+	} {
+		objs := newDeploymentPodTree(time.Hour, "7200", "")
+		pod := objs[2]
+		pod.SetAnnotations(nil)
+		r := newTestReconciler(t, objs...)
+		r.OwnerTraversalDepth = tc.depth
+
+		reconcileKey(t, r, "default", pod.GetName())
+
+		var ttlResource ttlv1alpha1.TTLResource
+		err := r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-" + pod.GetName()}, &ttlResource)
+		if !tc.inherit {
+			g.Expect(errors.IsNotFound(err)).To(BeTrue(), "depth %d should not reach the Deployment", tc.depth)
+			continue
+		}
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(ttlResource.Spec.TTLSeconds).To(Equal(7200))
+		// Deployment와 같은 시각에 만료되도록 Deployment 생성 시각을 기준으로 함
+		deploy := objs[0].(*appsv1.Deployment)
+		g.Expect(ttlResource.Spec.StartTime).NotTo(BeNil())
+		g.Expect(ttlResource.Spec.StartTime.Unix()).To(Equal(deploy.CreationTimestamp.Unix()))
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// TTLFromAnnotationKey는 TTL 카운트다운을 TTLResource 생성 시각 대신 어느 시각부터 셀지 지정하는 annotation 키입니다
+	TTLFromAnnotationKey = "ttl.example.com/from"
+	// TTLFromLastModified는 managedFields에 기록된 마지막 수정 시각부터 TTL을 세는 값입니다.
+	// 리소스가 수정될 때마다 카운트다운이 다시 시작되므로 "N시간 동안 수정되지 않으면 삭제"에 사용합니다
+	TTLFromLastModified = "lastModified"
+	// TTLFromInitComplete는 Pod의 init container가 모두 끝난 시각부터 TTL을 세는 값입니다.
+	// init 이후 멈춘 Pod를 정리하는 데 사용합니다
+	TTLFromInitComplete = "initComplete"
+
+	// FieldManager는 operator가 리소스를 수정할 때 사용하는 field manager 이름입니다.
+	// operator 자신의 수정(삭제 예정 표시 등)은 lastModified 계산에서 제외됩니다
+	FieldManager = "ttl-operator"
+)
+
+// ttlFromStartTime은 ttl.example.com/from annotation에 따라 TTL 카운트다운의 기준 시각을 반환합니다.
+// annotation이 없거나 알 수 없는 값이면 false를 반환하여 기본 기준 시각(TTLResource 생성 시각)을 사용합니다.
+func ttlFromStartTime(obj client.Object, logger logr.Logger) (*metav1.Time, bool) {
+	from, ok := obj.GetAnnotations()[TTLFromAnnotationKey]
+	if !ok {
+		return nil, false
+	}
+	switch from {
+	case TTLFromLastModified:
+		lastModified := lastModifiedTime(obj)
+		return &lastModified, true
+	case TTLFromInitComplete:
+		initComplete := initCompleteTime(obj)
+		return &initComplete, true
+	default:
+		logger.Info("Unknown TTL start annotation value, counting from TTLResource creation",
+			"annotation", TTLFromAnnotationKey, "value", from, "resource", client.ObjectKeyFromObject(obj))
+		return nil, false
+	}
+}
+
+// lastModifiedTime은 managedFields 중 가장 최근 수정 시각을 반환합니다.
+// 모든 field manager의 수정을 기준으로 하되 operator 자신의 수정과 status 등 subresource 쓰기
+// (kubelet의 readiness 갱신, 컨트롤러의 replica 수 갱신 등)는 제외하며, managedFields가 없으면 리소스 생성 시각을 반환합니다.
+func lastModifiedTime(obj client.Object) metav1.Time {
+	lastModified := obj.GetCreationTimestamp()
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Manager == FieldManager || entry.Subresource != "" || entry.Time == nil {
+			continue
+		}
+		if entry.Time.After(lastModified.Time) {
+			lastModified = *entry.Time
+		}
+	}
+	return lastModified
+}
+
+// initCompleteTime은 Pod의 init container가 모두 끝난 시각(가장 늦게 끝난 init container의 종료 시각)을 반환합니다.
+// 계속 실행되는 sidecar(restartPolicy: Always)는 제외하며, Pod가 아니거나 init container가 없거나
+// 아직 끝나지 않은 init container가 있으면 리소스 생성 시각을 반환합니다.
+func initCompleteTime(obj client.Object) metav1.Time {
+	created := obj.GetCreationTimestamp()
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return created
+	}
+
+	statuses := map[string]corev1.ContainerStatus{}
+	for _, status := range pod.Status.InitContainerStatuses {
+		statuses[status.Name] = status
+	}
+	var completed metav1.Time
+	for _, container := range pod.Spec.InitContainers {
+		if container.RestartPolicy != nil && *container.RestartPolicy == corev1.ContainerRestartPolicyAlways {
+			continue
+		}
+		status, ok := statuses[container.Name]
+		if !ok || status.State.Terminated == nil || status.State.Terminated.ExitCode != 0 {
+			return created
+		}
+		if finishedAt := status.State.Terminated.FinishedAt; finishedAt.After(completed.Time) {
+			completed = finishedAt
+		}
+	}
+	if completed.IsZero() {
+		return created
+	}
+	return completed
+}
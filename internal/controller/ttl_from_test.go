@@ -0,0 +1,186 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func managedFieldsEntry(manager string, at time.Time) metav1.ManagedFieldsEntry {
+	t := metav1.NewTime(at)
+	return metav1.ManagedFieldsEntry{Manager: manager, Operation: metav1.ManagedFieldsOperationUpdate, Time: &t}
+}
+
+func TestLastModifiedTime(t *testing.T) {
+	g := NewWithT(t)
+	created := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)}}
+	// managedFields가 없으면 생성 시각
+	g.Expect(lastModifiedTime(pod).Time).To(Equal(created))
+
+	pod.ManagedFields = []metav1.ManagedFieldsEntry{
+		managedFieldsEntry("kubectl-client-side-apply", created.Add(time.Hour)),
+		managedFieldsEntry("kubectl-edit", created.Add(2*time.Hour)),
+		// operator 자신의 수정은 제외
+		managedFieldsEntry(FieldManager, created.Add(3*time.Hour)),
+	}
+	g.Expect(lastModifiedTime(pod).Time).To(Equal(created.Add(2 * time.Hour)))
+
+	// status subresource 쓰기(kubelet의 readiness 갱신 등)는 spec 수정보다 나중이어도 제외
+	status := managedFieldsEntry("kubelet", created.Add(4*time.Hour))
+	status.Subresource = "status"
+	pod.ManagedFields = append(pod.ManagedFields, status)
+	g.Expect(lastModifiedTime(pod).Time).To(Equal(created.Add(2 * time.Hour)))
+}
+
+func TestTTLFromStartTime(t *testing.T) {
+	g := NewWithT(t)
+	logger := logf.Log
+	created := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		CreationTimestamp: metav1.NewTime(created),
+		ManagedFields:     []metav1.ManagedFieldsEntry{managedFieldsEntry("kubectl-edit", created.Add(time.Hour))},
+	}}
+
+	_, ok := ttlFromStartTime(pod, logger)
+	g.Expect(ok).To(BeFalse())
+
+	pod.Annotations = map[string]string{TTLFromAnnotationKey: "somethingElse"}
+	_, ok = ttlFromStartTime(pod, logger)
+	g.Expect(ok).To(BeFalse())
+
+	pod.Annotations[TTLFromAnnotationKey] = TTLFromLastModified
+	start, ok := ttlFromStartTime(pod, logger)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(start.Time).To(Equal(created.Add(time.Hour)))
+}
+
+func TestReconcileTTLFromLastModified(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	modified := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:      "idle",
+		Namespace: "default",
+		Annotations: map[string]string{
+			TTLAnnotationKey:     "3600",
+			TTLFromAnnotationKey: TTLFromLastModified,
+		},
+		ManagedFields: []metav1.ManagedFieldsEntry{managedFieldsEntry("kubectl-edit", modified)},
+	}}
+	r := newTestReconciler(t, pod)
+
+	reconcileKey(t, r, "default", "idle")
+
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "ttl-idle"}, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.Spec.StartTime).NotTo(BeNil())
+	g.Expect(ttlResource.Spec.StartTime.Time).To(BeTemporally("==", modified))
+
+	// 다시 수정되면 카운트다운을 새 수정 시각부터 다시 셈
+	var latest corev1.Pod
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &latest)).To(Succeed())
+	latest.ManagedFields = append(latest.ManagedFields, managedFieldsEntry("kubectl-edit", modified.Add(time.Hour)))
+	g.Expect(r.Update(ctx, &latest)).To(Succeed())
+
+	reconcileKey(t, r, "default", "idle")
+	g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "ttl-idle"}, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.Spec.StartTime.Time).To(BeTemporally("==", modified.Add(time.Hour)))
+}
+
+func terminatedInit(name string, exitCode int32, finishedAt time.Time) corev1.ContainerStatus {
+	return corev1.ContainerStatus{Name: name, State: corev1.ContainerState{
+		Terminated: &corev1.ContainerStateTerminated{ExitCode: exitCode, FinishedAt: metav1.NewTime(finishedAt)},
+	}}
+}
+
+func TestInitCompleteTime(t *testing.T) {
+	g := NewWithT(t)
+	created := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	always := corev1.ContainerRestartPolicyAlways
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+		Spec: corev1.PodSpec{InitContainers: []corev1.Container{
+			{Name: "migrate"},
+			{Name: "fetch"},
+			// sidecar는 끝나지 않으므로 제외
+			{Name: "proxy", RestartPolicy: &always},
+		}},
+	}
+	// init container 상태가 아직 없으면 생성 시각
+	g.Expect(initCompleteTime(pod).Time).To(Equal(created))
+
+	// 아직 끝나지 않은 init container가 있으면 생성 시각
+	pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
+		terminatedInit("migrate", 0, created.Add(time.Minute)),
+		{Name: "fetch", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+		{Name: "proxy", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+	}
+	g.Expect(initCompleteTime(pod).Time).To(Equal(created))
+
+	// 실패로 끝난 init container는 다시 실행되므로 완료로 보지 않음
+	pod.Status.InitContainerStatuses[1] = terminatedInit("fetch", 1, created.Add(2*time.Minute))
+	g.Expect(initCompleteTime(pod).Time).To(Equal(created))
+
+	// 모두 끝나면 가장 늦게 끝난 시각
+	pod.Status.InitContainerStatuses[1] = terminatedInit("fetch", 0, created.Add(2*time.Minute))
+	g.Expect(initCompleteTime(pod).Time).To(Equal(created.Add(2 * time.Minute)))
+
+	// init container가 없거나 Pod가 아니면 생성 시각
+	g.Expect(initCompleteTime(&corev1.Pod{ObjectMeta: pod.ObjectMeta}).Time).To(Equal(created))
+	g.Expect(initCompleteTime(&corev1.ConfigMap{ObjectMeta: pod.ObjectMeta}).Time).To(Equal(created))
+}
+
+func TestReconcileTTLFromInitComplete(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	finished := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "wedged",
+			Namespace: "default",
+			Annotations: map[string]string{
+				TTLAnnotationKey:     "3600",
+				TTLFromAnnotationKey: TTLFromInitComplete,
+			},
+		},
+		Spec:   corev1.PodSpec{InitContainers: []corev1.Container{{Name: "init"}}},
+		Status: corev1.PodStatus{InitContainerStatuses: []corev1.ContainerStatus{terminatedInit("init", 0, finished)}},
+	}
+	r := newTestReconciler(t, pod)
+
+	reconcileKey(t, r, "default", "wedged")
+
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "ttl-wedged"}, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.Spec.StartTime).NotTo(BeNil())
+	g.Expect(ttlResource.Spec.StartTime.Time).To(BeTemporally("==", finished))
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Secret 권한은 --enable-ttl-from-secret을 켤 때만 필요하므로 manager-role이 아닌 config/rbac/ttl_from_secret_role.yaml에 있습니다
+
+// TTLFromSecretAnnotationKey는 TTL 값을 annotation 대신 같은 네임스페이스 Secret의 키에서 읽을 때 "<secret-name>/<key>"를 지정하는 annotation 키입니다
+const TTLFromSecretAnnotationKey = "ttl.example.com/ttl-from-secret"
+
+// TTLSourceSecret은 ttl-from-secret annotation이 가리키는 Secret에서 읽은 TTL입니다
+const TTLSourceSecret = "secret"
+
+// EventReasonTTLSecretUnavailable은 ttl-from-secret이 가리키는 Secret이나 키를 읽을 수 없어 TTL을 적용하지 않았음을 알리는 Event reason입니다
+const EventReasonTTLSecretUnavailable = "TTLSecretUnavailable"
+
+// parseTTLSecretRef는 ttl-from-secret annotation 값을 Secret 이름과 키로 나눕니다.
+func parseTTLSecretRef(value string) (name, key string, err error) {
+	name, key, found := strings.Cut(strings.TrimSpace(value), "/")
+	if !found || name == "" || key == "" || strings.Contains(key, "/") {
+		return "", "", fmt.Errorf("expected <secret-name>/<key>, got %q", value)
+	}
+	return name, key, nil
+}
+
+// secretTTL은 ttl-from-secret annotation이 가리키는 Secret 키의 값을 TTL annotation과 같은 형식으로 반환합니다.
+// Secret이나 키가 없거나 값이 잘못되었으면 owner에 Warning Event를 남기고 ok가 false이며, 이 경우 기존 TTLResource는 그대로 둡니다.
+// Event와 로그에는 Secret에 저장된 값을 남기지 않습니다. Secret은 캐시하지 않으므로 APIReader로 읽습니다.
+func (r *ResourceReconciler) secretTTL(ctx context.Context, obj client.Object, ref string, logger logr.Logger) (string, bool, error) {
+	if !r.EnableTTLFromSecret {
+		r.reportTTLSecretUnavailable(obj, ref, "ttl-from-secret is disabled; start the operator with --enable-ttl-from-secret", logger)
+		return "", false, nil
+	}
+	name, key, err := parseTTLSecretRef(ref)
+	if err != nil {
+		r.reportTTLSecretUnavailable(obj, ref, err.Error(), logger)
+		return "", false, nil
+	}
+
+	reader := r.APIReader
+	if reader == nil {
+		reader = r.Client
+	}
+	var secret corev1.Secret
+	if err := reader.Get(ctx, client.ObjectKey{Namespace: obj.GetNamespace(), Name: name}, &secret); err != nil {
+		if errors.IsNotFound(err) {
+			r.reportTTLSecretUnavailable(obj, ref, fmt.Sprintf("secret %q not found", name), logger)
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	data, found := secret.Data[key]
+	if !found {
+		r.reportTTLSecretUnavailable(obj, ref, fmt.Sprintf("key %q not found in secret %q", key, name), logger)
+		return "", false, nil
+	}
+	// 파일에서 만든 Secret은 끝에 개행이 붙는 경우가 많음
+	value := strings.TrimSpace(string(data))
+	if _, err := parseTTL(value); err != nil {
+		r.reportTTLSecretUnavailable(obj, ref, fmt.Sprintf("key %q of secret %q is not a valid TTL", key, name), logger)
+		return "", false, nil
+	}
+	return value, true, nil
+}
+
+// reportTTLSecretUnavailable은 ttl-from-secret을 적용하지 못한 이유를 로그와 owner의 Warning Event로 알립니다.
+// 잘못된 TTL annotation과 같이 같은 리소스의 같은 이유는 한 번만 알립니다.
+func (r *ResourceReconciler) reportTTLSecretUnavailable(obj client.Object, ref, reason string, logger logr.Logger) {
+	logger.Info("TTL secret unavailable, skipping", "resource", client.ObjectKeyFromObject(obj), "ref", ref, "reason", reason)
+	if r.Recorder == nil {
+		return
+	}
+	reported := TTLFromSecretAnnotationKey + "=" + ref + ": " + reason
+	if previous, ok := r.invalidTTLReported.Swap(client.ObjectKeyFromObject(obj).String(), reported); ok && previous == reported {
+		return
+	}
+	r.Recorder.Event(obj, corev1.EventTypeWarning, EventReasonTTLSecretUnavailable,
+		fmt.Sprintf("TTL not applied: %s %q: %s", TTLFromSecretAnnotationKey, ref, reason))
+}
+
+// requestsForTTLSecret은 Secret이 생성, 변경, 삭제되었을 때 ttl-from-secret annotation으로
+// 그 Secret을 가리키는 리소스를 다시 reconcile하도록 요청을 만듭니다.
+func (r *ResourceReconciler) requestsForTTLSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	logger := logf.FromContext(ctx)
+
+	var requests []reconcile.Request
+	for _, list := range r.watchedLists() {
+		if err := r.List(ctx, list, client.InNamespace(obj.GetNamespace())); err != nil {
+			logger.Error(err, "Failed to list resources for ttl-from-secret", "namespace", obj.GetNamespace())
+			continue
+		}
+		_ = meta.EachListItem(list, func(item runtime.Object) error {
+			o, ok := item.(client.Object)
+			if !ok {
+				return nil
+			}
+			if name, _, err := parseTTLSecretRef(o.GetAnnotations()[TTLFromSecretAnnotationKey]); err == nil && name == obj.GetName() {
+				requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(o)})
+			}
+			return nil
+		})
+	}
+	return requests
+}
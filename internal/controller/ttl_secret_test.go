@@ -0,0 +1,162 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func secretTTLPod() *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		Namespace:   "default",
+		Annotations: map[string]string{TTLFromSecretAnnotationKey: "lifecycle/ttl"},
+	}}
+}
+
+func lifecycleSecret(data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "lifecycle", Namespace: "default"}, Data: data}
+}
+
+func TestTTLIsReadFromReferencedSecret(t *testing.T) {
+	g := NewWithT(t)
+
+	r := newTestReconciler(t, secretTTLPod(), lifecycleSecret(map[string][]byte{"ttl": []byte("120\n")}))
+	r.EnableTTLFromSecret = true
+	reconcileKey(t, r, "default", "web")
+
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.Spec.TTLSeconds).To(Equal(120))
+	g.Expect(ttlSourceOf(&ttlResource)).To(Equal(TTLSourceSecret))
+}
+
+func TestPlainTTLAnnotationTakesPrecedenceOverSecret(t *testing.T) {
+	g := NewWithT(t)
+
+	pod := secretTTLPod()
+	pod.Annotations[TTLAnnotationKey] = "60"
+	r := newTestReconciler(t, pod, lifecycleSecret(map[string][]byte{"ttl": []byte("120")}))
+	r.EnableTTLFromSecret = true
+	reconcileKey(t, r, "default", "web")
+
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.Spec.TTLSeconds).To(Equal(60))
+}
+
+func TestUnavailableTTLSecretIsSkippedWithWarning(t *testing.T) {
+	cases := map[string]*corev1.Secret{
+		"missing secret": nil,
+		"missing key":    lifecycleSecret(map[string][]byte{"other": []byte("120")}),
+		"invalid value":  lifecycleSecret(map[string][]byte{"ttl": []byte("soon")}),
+	}
+	for name, secret := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			objs := []client.Object{secretTTLPod()}
+			if secret != nil {
+				objs = append(objs, secret)
+			}
+			r := newTestReconciler(t, objs...)
+			r.EnableTTLFromSecret = true
+			recorder := record.NewFakeRecorder(10)
+			r.Recorder = recorder
+
+			reconcileKey(t, r, "default", "web")
+			reconcileKey(t, r, "default", "web")
+
+			err := r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &ttlv1alpha1.TTLResource{})
+			g.Expect(errors.IsNotFound(err)).To(BeTrue())
+			// 같은 이유는 한 번만 알리고, Secret의 값은 남기지 않음
+			g.Expect(recorder.Events).To(HaveLen(1))
+			g.Expect(<-recorder.Events).To(And(ContainSubstring(EventReasonTTLSecretUnavailable), Not(ContainSubstring("soon"))))
+		})
+	}
+}
+
+func TestTTLFromSecretIsIgnoredUnlessEnabled(t *testing.T) {
+	g := NewWithT(t)
+
+	r := newTestReconciler(t, secretTTLPod(), lifecycleSecret(map[string][]byte{"ttl": []byte("120")}))
+	recorder := record.NewFakeRecorder(10)
+	r.Recorder = recorder
+	reconcileKey(t, r, "default", "web")
+
+	err := r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &ttlv1alpha1.TTLResource{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+	g.Expect(<-recorder.Events).To(ContainSubstring("--enable-ttl-from-secret"))
+}
+
+func TestTTLSecretIsReadThroughAPIReader(t *testing.T) {
+	g := NewWithT(t)
+
+	// 캐시(Client)에는 Secret이 없고 APIReader로만 읽을 수 있는 상황
+	r := newTestReconciler(t, secretTTLPod())
+	r.EnableTTLFromSecret = true
+	r.APIReader = fake.NewClientBuilder().
+		WithScheme(r.Scheme).
+		WithObjects(lifecycleSecret(map[string][]byte{"ttl": []byte("120")})).
+		Build()
+	reconcileKey(t, r, "default", "web")
+
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &ttlResource)).To(Succeed())
+	g.Expect(ttlResource.Spec.TTLSeconds).To(Equal(120))
+}
+
+func TestSecretChangeRequeuesReferencingResources(t *testing.T) {
+	g := NewWithT(t)
+
+	other := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "api",
+		Namespace:   "default",
+		Annotations: map[string]string{TTLFromSecretAnnotationKey: "other/ttl"},
+	}}
+	secret := lifecycleSecret(map[string][]byte{"ttl": []byte("120")})
+	r := newTestReconciler(t, secretTTLPod(), other, secret)
+
+	// metadata만 watch하므로 map 함수는 PartialObjectMetadata를 받음
+	requests := r.requestsForTTLSecret(context.Background(), &metav1.PartialObjectMetadata{ObjectMeta: secret.ObjectMeta})
+	g.Expect(requests).To(HaveLen(1))
+	g.Expect(requests[0].Name).To(Equal("web"))
+}
+
+func TestParseTTLSecretRef(t *testing.T) {
+	g := NewWithT(t)
+
+	name, key, err := parseTTLSecretRef("lifecycle/ttl")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(name).To(Equal("lifecycle"))
+	g.Expect(key).To(Equal("ttl"))
+	for _, value := range []string{"", "lifecycle", "/ttl", "lifecycle/", "a/b/c"} {
+		_, _, err := parseTTLSecretRef(value)
+		g.Expect(err).To(HaveOccurred(), value)
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// ttlResourceOwnerIndex는 resource 컨트롤러가 만든 TTLResource를 owner 이름으로 찾기 위한 field index입니다.
+const ttlResourceOwnerIndex = "ttl.example.com/owner-name"
+
+// TTLResourceNameData는 TTLResource 이름 템플릿에서 사용할 수 있는 owner 정보입니다.
+type TTLResourceNameData struct {
+	Kind      string
+	Name      string
+	Namespace string
+	UID       string
+}
+
+// TTLResourceNamer는 owner에 대한 TTLResource 이름을 만듭니다.
+// nil이거나 템플릿이 없으면 기존과 같은 "ttl-<name>"을 사용합니다.
+type TTLResourceNamer struct {
+	tmpl *template.Template
+}
+
+// ParseTTLResourceNameTemplate은 --ttlresource-name-template 값을 파싱합니다.
+// 빈 값이면 nil을 반환하여 기본 이름("ttl-<name>")을 사용합니다. Kind는 대문자를 포함하므로 lower 함수를 제공합니다.
+// 예시 owner로 두 번 렌더링하여 결과가 같고 DNS-1123 이름이며, owner마다 다른 이름이 나오는지 확인합니다.
+func ParseTTLResourceNameTemplate(value string) (*TTLResourceNamer, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	tmpl, err := template.New("ttlresource-name").
+		Option("missingkey=error").
+		Funcs(template.FuncMap{"lower": strings.ToLower}).
+		Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TTLResource name template: %w", err)
+	}
+	namer := &TTLResourceNamer{tmpl: tmpl}
+
+	samples := []TTLResourceNameData{
+		{Kind: "Pod", Name: "web", Namespace: "default", UID: "00000000-0000-0000-0000-000000000001"},
+		{Kind: "Deployment", Name: "api", Namespace: "team-a", UID: "00000000-0000-0000-0000-000000000002"},
+	}
+	seen := map[string]bool{}
+	for _, sample := range samples {
+		name, err := namer.render(sample)
+		if err != nil {
+			return nil, err
+		}
+		again, err := namer.render(sample)
+		if err != nil {
+			return nil, err
+		}
+		if again != name {
+			return nil, fmt.Errorf("TTLResource name template is not deterministic: rendered %q and %q for %s/%s", name, again, sample.Kind, sample.Name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("TTLResource name template renders %q for different owners; include at least {{.Name}}", name)
+		}
+		seen[name] = true
+	}
+	return namer, nil
+}
+
+// render는 템플릿을 data로 렌더링하고 결과가 TTLResource 이름으로 쓸 수 있는지 확인합니다.
+// owner와 같은 이름은 reconcile 요청에서 owner와 TTLResource를 구분할 수 없으므로 거부합니다.
+func (n *TTLResourceNamer) render(data TTLResourceNameData) (string, error) {
+	var b strings.Builder
+	if err := n.tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("failed to render TTLResource name template: %w", err)
+	}
+	name := b.String()
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return "", fmt.Errorf("TTLResource name template rendered invalid name %q for %s/%s: %s", name, data.Kind, data.Name, strings.Join(errs, "; "))
+	}
+	if name == data.Name {
+		return "", fmt.Errorf("TTLResource name template rendered the owner name %q itself", name)
+	}
+	return name, nil
+}
+
+// Name은 owner에 대한 TTLResource 이름을 반환합니다.
+func (n *TTLResourceNamer) Name(kind string, owner metav1.Object) (string, error) {
+	if n == nil || n.tmpl == nil {
+		return "ttl-" + owner.GetName(), nil
+	}
+	return n.render(TTLResourceNameData{
+		Kind:      kind,
+		Name:      owner.GetName(),
+		Namespace: owner.GetNamespace(),
+		UID:       string(owner.GetUID()),
+	})
+}
+
+// ownsTTLResource는 ref가 가리키는 owner에 대해 만든 TTLResource 이름이 ttlResource의 이름과 같은지 확인합니다.
+func (n *TTLResourceNamer) ownsTTLResource(ttlResource *ttlv1alpha1.TTLResource, ref metav1.OwnerReference) bool {
+	owner := &metav1.ObjectMeta{Name: ref.Name, Namespace: ttlResource.Namespace, UID: ref.UID}
+	name, err := n.Name(ref.Kind, owner)
+	return err == nil && name == ttlResource.Name
+}
+
+// managedOwnerNames는 resource 컨트롤러가 만든 TTLResource에서 이름 템플릿으로 이 TTLResource를 만든 owner 이름을 반환합니다.
+// field index와 TTLResource가 삭제될 때 owner를 다시 reconcile하는 데 사용합니다.
+func (n *TTLResourceNamer) managedOwnerNames(ttlResource *ttlv1alpha1.TTLResource) []string {
+	if ttlResource.Labels[TTLResourceLabelKey] != TTLResourceLabelValue {
+		return nil
+	}
+	if n == nil || n.tmpl == nil {
+		// 기본 이름은 OwnerReference 없이도 이름에서 owner를 알 수 있음
+		if name, ok := strings.CutPrefix(ttlResource.Name, "ttl-"); ok && name != "" {
+			return []string{name}
+		}
+		return nil
+	}
+	var names []string
+	for _, ref := range ttlResource.OwnerReferences {
+		if n.ownsTTLResource(ttlResource, ref) {
+			names = append(names, ref.Name)
+		}
+	}
+	return names
+}
+
+// indexTTLResourceOwner는 ttlResourceOwnerIndex의 index 함수입니다.
+func (n *TTLResourceNamer) indexTTLResourceOwner(obj client.Object) []string {
+	ttlResource, ok := obj.(*ttlv1alpha1.TTLResource)
+	if !ok {
+		return nil
+	}
+	return n.managedOwnerNames(ttlResource)
+}
+
+// ttlResourceNamesFor는 owner 이름으로 관련 TTLResource 이름을 찾습니다.
+// 기본 이름은 owner 이름에서 바로 계산하고, 템플릿을 사용하면 owner가 이미 사라져 Kind와 UID를 모를 수 있으므로
+// owner 이름 field index로 조회합니다.
+func (r *ResourceReconciler) ttlResourceNamesFor(ctx context.Context, ownerKey client.ObjectKey) ([]string, error) {
+	if r.TTLResourceNamer == nil || r.TTLResourceNamer.tmpl == nil {
+		return []string{"ttl-" + ownerKey.Name}, nil
+	}
+	var list ttlv1alpha1.TTLResourceList
+	if err := r.List(ctx, &list, client.InNamespace(ownerKey.Namespace),
+		client.MatchingFields{ttlResourceOwnerIndex: ownerKey.Name}); err != nil {
+		return nil, fmt.Errorf("failed to list TTLResources of %s: %w", ownerKey, err)
+	}
+	names := make([]string, 0, len(list.Items))
+	for _, item := range list.Items {
+		names = append(names, item.Name)
+	}
+	return names, nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestParseTTLResourceNameTemplate(t *testing.T) {
+	g := NewWithT(t)
+
+	namer, err := ParseTTLResourceNameTemplate("")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(namer.Name("Pod", annotatedPod("uid-1"))).To(Equal("ttl-web"))
+
+	namer, err = ParseTTLResourceNameTemplate("{{.Kind | lower}}-{{.Name}}-ttl")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(namer.Name("Pod", annotatedPod("uid-1"))).To(Equal("pod-web-ttl"))
+
+	for _, value := range []string{
+		"{{.Kind}}-{{.Name}}", // 대문자는 DNS-1123 이름이 아님
+		"ttl",                 // owner마다 같은 이름
+		"{{.Name}}",           // owner 이름과 같음
+		"ttl-{{.Owner}}",      // 없는 필드
+		"ttl-{{.Name",
+	} {
+		_, err := ParseTTLResourceNameTemplate(value)
+		g.Expect(err).To(HaveOccurred(), value)
+	}
+}
+
+func TestTTLResourceNameTemplateIsUsedForCreateAndCleanup(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	namer, err := ParseTTLResourceNameTemplate("{{.Kind | lower}}-{{.Name}}-ttl")
+	g.Expect(err).NotTo(HaveOccurred())
+	scheme := newTestScheme(t)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(annotatedPod("uid-1")).
+		WithStatusSubresource(&ttlv1alpha1.TTLResource{}).
+		WithIndex(&ttlv1alpha1.TTLResource{}, ttlResourceOwnerIndex, namer.indexTTLResourceOwner).
+		Build()
+	r := &ResourceReconciler{Client: c, Scheme: scheme, TTLResourceNamer: namer}
+	key := client.ObjectKey{Namespace: "default", Name: "pod-web-ttl"}
+
+	reconcileKey(t, r, "default", "web")
+	var ttlResource ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, key, &ttlResource)).To(Succeed())
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "ttl-web"}, &ttlv1alpha1.TTLResource{}))).To(BeTrue())
+	g.Expect(r.managedOwners(&ttlResource, ownersOf(&ttlResource))).To(HaveLen(1))
+
+	// 만료 전에 TTLResource가 삭제되면 템플릿으로 owner를 찾아 다시 reconcile
+	requests := r.requestsForDeletedTTLResource(ctx, &ttlResource)
+	g.Expect(requests).To(HaveLen(1))
+	g.Expect(requests[0].Name).To(Equal("web"))
+
+	// owner가 사라지면 Kind와 UID를 몰라도 index로 찾아 정리
+	var pod corev1.Pod
+	g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "web"}, &pod)).To(Succeed())
+	g.Expect(r.Delete(ctx, &pod)).To(Succeed())
+	reconcileKey(t, r, "default", "web")
+	g.Expect(errors.IsNotFound(r.Get(ctx, key, &ttlv1alpha1.TTLResource{}))).To(BeTrue())
+}
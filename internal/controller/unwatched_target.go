@@ -0,0 +1,94 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// newUnwatchedObject는 watch하지 않는 Kind의 리소스를 조회하거나 삭제하기 위한 빈 unstructured 객체를 반환합니다.
+func newUnwatchedObject(gvk schema.GroupVersionKind, name, namespace string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+	return obj
+}
+
+// unwatchedTarget은 ownerRef가 watch하지 않는 Kind이면서 spec.targetRef로 지정한 대상인지 확인합니다.
+// TTL annotation을 watch하는 Kind와 별개로, 직접 작성한 TTLResource는 Operator에 권한이 있는 어떤 Kind든 삭제할 수 있습니다.
+func unwatchedTarget(ttlResource *ttlv1alpha1.TTLResource, ownerRef metav1.OwnerReference) bool {
+	if ttlResource.Spec.TargetRef == nil || !sameTarget(targetRefOwner(ttlResource.Spec.TargetRef), ownerRef) {
+		return false
+	}
+	_, gvk, err := newOwnerObject(ownerRef, ttlResource.Namespace)
+	return err != nil && !gvk.Empty()
+}
+
+// checkUnwatchedTargets는 targetRef로 지정한 watch하지 않는 Kind의 대상을 조회할 수 있는지 미리 확인합니다.
+// 삭제 전 확인(delete-if, wait-for-lease 등)도 대상을 조회하므로, 권한이 없거나 클러스터에 없는 Kind이면
+// 그 확인들이 알 수 없는 오류로 실패하기 전에 원인을 배치 결과와 같은 형태로 반환합니다.
+func (r *ResourceReconciler) checkUnwatchedTargets(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource,
+	owners []metav1.OwnerReference, logger logr.Logger) batchResult {
+	var result batchResult
+	for _, ownerRef := range owners {
+		if !unwatchedTarget(ttlResource, ownerRef) {
+			continue
+		}
+		if _, err := r.getOwnerObject(ctx, ownerRef, ttlResource.Namespace); err != nil && !errors.IsNotFound(err) {
+			r.recordUnwatchedTargetError(&result, ownerRef, err, logger)
+		}
+	}
+	return result
+}
+
+// recordUnwatchedTargetError는 watch하지 않는 Kind의 대상을 조회하지 못한 원인을 배치 결과에 기록합니다.
+// 권한이 없으면 forbidden으로, 클러스터에 없는 Kind면 failed로 기록하며 메시지에 원인을 남깁니다.
+func (r *ResourceReconciler) recordUnwatchedTargetError(result *batchResult, ownerRef metav1.OwnerReference, err error, logger logr.Logger) {
+	target := ownerRef.Kind + "/" + ownerRef.Name
+	kind := metricKind(ownerRef.Kind)
+	switch {
+	case errors.IsForbidden(err):
+		ownerDeletionsTotal.WithLabelValues(kind, "forbidden").Inc()
+		logger.Info("Operator lacks RBAC for targetRef kind", "apiVersion", ownerRef.APIVersion, "kind", ownerRef.Kind,
+			"name", ownerRef.Name, "error", err.Error())
+		result.forbidden = append(result.forbidden, missingRBACTarget(ownerRef))
+	case meta.IsNoMatchError(err):
+		ownerDeletionsTotal.WithLabelValues(kind, "failed").Inc()
+		logger.Error(err, "targetRef kind is not served by the API server", "apiVersion", ownerRef.APIVersion, "kind", ownerRef.Kind)
+		result.failed = append(result.failed, fmt.Sprintf("%s (kind not served by the API server)", target))
+	default:
+		ownerDeletionsTotal.WithLabelValues(kind, "failed").Inc()
+		logger.Error(err, "Failed to get targetRef resource", "ownerRef", ownerRef)
+		result.failed = append(result.failed, target)
+	}
+}
+
+// missingRBACTarget은 권한이 없어 조회하거나 삭제하지 못한 watch하지 않는 Kind의 대상을 원인과 함께 나타냅니다.
+func missingRBACTarget(ownerRef metav1.OwnerReference) string {
+	return fmt.Sprintf("%s/%s (operator has no RBAC permission for %s %s)", ownerRef.Kind, ownerRef.Name, ownerRef.APIVersion, ownerRef.Kind)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func expiredWidgetTTLResource() *ttlv1alpha1.TTLResource {
+	return &ttlv1alpha1.TTLResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "ttl-web",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+		},
+		Spec: ttlv1alpha1.TTLResourceSpec{
+			TTLSeconds: 60,
+			TargetRef:  &ttlv1alpha1.TargetReference{APIVersion: "example.com/v1", Kind: "Widget", Name: "web"},
+		},
+	}
+}
+
+func TestTargetRefToUnwatchedKindIsDeleted(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	ttlResource := expiredWidgetTTLResource()
+	r := newTestReconciler(t, ttlResource, newWidget(nil))
+
+	reconcileKey(t, r, "default", "ttl-web")
+	err := r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "web"}, newWidget(nil))
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+	g.Expect(errors.IsNotFound(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &ttlv1alpha1.TTLResource{}))).To(BeTrue())
+}
+
+func TestTargetRefToUnwatchedKindWithoutRBAC(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	ttlResource := expiredWidgetTTLResource()
+	scheme := newTestScheme(t)
+	forbidden := func(obj client.Object) error {
+		if u, ok := obj.(*unstructured.Unstructured); ok && u.GroupVersionKind() == widgetGVK {
+			return errors.NewForbidden(schema.GroupResource{Group: "example.com", Resource: "widgets"}, u.GetName(), nil)
+		}
+		return nil
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(ttlResource, newWidget(nil)).
+		WithStatusSubresource(&ttlv1alpha1.TTLResource{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				if err := forbidden(obj); err != nil {
+					return err
+				}
+				return c.Get(ctx, key, obj, opts...)
+			},
+		}).
+		Build()
+	r := &ResourceReconciler{Client: c, Scheme: scheme}
+
+	// 권한이 없으면 삭제하지 않고 원인을 DeletionForbidden condition으로 남김
+	reconcileKey(t, r, "default", "ttl-web")
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	condition := meta.FindStatusCondition(latest.Status.Conditions, ConditionDeletionForbidden)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Message).To(ContainSubstring("Widget/web (operator has no RBAC permission for example.com/v1 Widget)"))
+}
+
+func TestUnwatchedOwnerReferenceIsNotDeleted(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	// targetRef가 아닌 OwnerReference의 watch하지 않는 Kind는 삭제하지 않음
+	ttlResource := expiredWidgetTTLResource()
+	ttlResource.Spec.TargetRef = nil
+	ttlResource.OwnerReferences = []metav1.OwnerReference{{APIVersion: "example.com/v1", Kind: "Widget", Name: "web"}}
+	r := newTestReconciler(t, ttlResource, newWidget(nil))
+
+	reconcileKey(t, r, "default", "ttl-web")
+	g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "web"}, newWidget(nil))).To(Succeed())
+}
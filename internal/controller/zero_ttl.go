@@ -0,0 +1,84 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+// spec.ttlSeconds가 0인 TTLResource의 처리 방식
+const (
+	// ZeroTTLBehaviorNeverExpire는 만료하지 않는 TTLResource로 보고 아무것도 하지 않습니다 (기본값)
+	ZeroTTLBehaviorNeverExpire = "never-expire"
+	// ZeroTTLBehaviorInvalid는 잘못된 설정으로 보고 InvalidTTL condition과 Warning 이벤트로 알립니다
+	ZeroTTLBehaviorInvalid = "invalid"
+)
+
+// ConditionInvalidTTL은 spec.ttlSeconds가 잘못되어 TTLResource를 처리하지 않고 있음을 나타냅니다
+const ConditionInvalidTTL = "InvalidTTL"
+
+// ValidateZeroTTLBehavior는 --zero-ttl-behavior 값이 올바른지 확인합니다.
+func ValidateZeroTTLBehavior(behavior string) error {
+	switch behavior {
+	case "", ZeroTTLBehaviorNeverExpire, ZeroTTLBehaviorInvalid:
+		return nil
+	default:
+		return fmt.Errorf("unknown zero TTL behavior %q (expected %s or %s)",
+			behavior, ZeroTTLBehaviorNeverExpire, ZeroTTLBehaviorInvalid)
+	}
+}
+
+// reportZeroTTL은 --zero-ttl-behavior=invalid일 때 spec.ttlSeconds가 0인 TTLResource에 InvalidTTL condition을 기록하고
+// 처음 한 번 Warning 이벤트를 남깁니다. owner는 삭제하지 않습니다.
+func (r *ResourceReconciler) reportZeroTTL(ctx context.Context, ttlResource *ttlv1alpha1.TTLResource, logger logr.Logger) (ctrl.Result, error) {
+	message := "spec.ttlSeconds is 0, which is treated as a misconfiguration (--zero-ttl-behavior=invalid); the TTLResource will not expire"
+	changed := meta.SetStatusCondition(&ttlResource.Status.Conditions, metav1.Condition{
+		Type:               ConditionInvalidTTL,
+		Status:             metav1.ConditionTrue,
+		Reason:             "ZeroTTLSeconds",
+		Message:            message,
+		ObservedGeneration: ttlResource.Generation,
+		LastTransitionTime: metav1.NewTime(r.now()),
+	})
+	if !changed {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("TTLResource has zero ttlSeconds, treating it as invalid", "name", ttlResource.Name)
+	r.recordEvent(ttlResource, corev1.EventTypeWarning, ConditionInvalidTTL, message)
+	if err := r.updateStatus(ctx, ttlResource); err != nil {
+		if errors.IsConflict(err) {
+			r.ConflictLog.Info(logger.V(1), "Conflict updating TTLResource status, will retry", "name", ttlResource.Name)
+			return r.retryOnConflict(ctx, ttlResource, logger), nil
+		}
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
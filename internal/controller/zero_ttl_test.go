@@ -0,0 +1,103 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ttlv1alpha1 "github.com/seoyeon0201/ttl-operator/api/v1alpha1"
+)
+
+func TestZeroTTLNeverExpiresByDefault(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	ttlResource.Spec.TTLSeconds = 0
+	r := newTestReconciler(t, pod, ttlResource)
+	recorder := record.NewFakeRecorder(10)
+	r.Recorder = recorder
+
+	reconcileKey(t, r, "default", "ttl-web")
+
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(latest.Status.Conditions).To(BeEmpty())
+	g.Expect(recorder.Events).NotTo(Receive())
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+}
+
+func TestZeroTTLInvalidModeWarnsOnce(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	pod, ttlResource := expiredOwner(t, &corev1.Pod{}, nil)
+	ttlResource.Spec.TTLSeconds = 0
+	r := newTestReconciler(t, pod, ttlResource)
+	recorder := record.NewFakeRecorder(10)
+	r.Recorder = recorder
+	r.ZeroTTLBehavior = ZeroTTLBehaviorInvalid
+
+	reconcileKey(t, r, "default", "ttl-web")
+	reconcileKey(t, r, "default", "ttl-web")
+
+	var latest ttlv1alpha1.TTLResource
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(ttlResource), &latest)).To(Succeed())
+	g.Expect(meta.IsStatusConditionTrue(latest.Status.Conditions, ConditionInvalidTTL)).To(BeTrue())
+	g.Expect(recorder.Events).To(Receive(ContainSubstring("Warning " + ConditionInvalidTTL)))
+	g.Expect(recorder.Events).NotTo(Receive())
+	// 잘못된 설정이어도 owner는 삭제하지 않음
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+}
+
+func TestZeroTTLAnnotationNeverCreatesTTLResource(t *testing.T) {
+	g := NewWithT(t)
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		Namespace:   "default",
+		Annotations: map[string]string{TTLAnnotationKey: "0"},
+	}}
+	r := newTestReconciler(t, pod)
+	recorder := record.NewFakeRecorder(10)
+	r.Recorder = recorder
+	r.ZeroTTLBehavior = ZeroTTLBehaviorInvalid
+
+	reconcileKey(t, r, "default", "web")
+
+	var list ttlv1alpha1.TTLResourceList
+	g.Expect(r.List(context.Background(), &list)).To(Succeed())
+	g.Expect(list.Items).To(BeEmpty())
+	g.Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonInvalidTTLAnnotation)))
+}
+
+func TestValidateZeroTTLBehavior(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(ValidateZeroTTLBehavior("")).To(Succeed())
+	g.Expect(ValidateZeroTTLBehavior(ZeroTTLBehaviorNeverExpire)).To(Succeed())
+	g.Expect(ValidateZeroTTLBehavior(ZeroTTLBehaviorInvalid)).To(Succeed())
+	g.Expect(ValidateZeroTTLBehavior("delete")).NotTo(Succeed())
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/seoyeon0201/ttl-operator/internal/controller"
+)
+
+// ttlannotationlog is for logging in this package.
+var ttlannotationlog = logf.Log.WithName("ttl-annotation-webhook")
+
+// SetupTTLAnnotationWebhookWithManager는 TTL을 적용할 수 있는 모든 Kind에 TTL annotation 경고 webhook을 등록합니다.
+// warnExpiry가 true이면 TTL annotation과 함께 생성되는 리소스에 만료 예정 시각을 경고로 알려줍니다.
+// notifyAllowedHosts에 없는 호스트를 notify annotation에 지정하면 거부합니다.
+func SetupTTLAnnotationWebhookWithManager(mgr ctrl.Manager, excludedNamespaces []string, warnExpiry bool, notifyAllowedHosts []string) error {
+	validator := &TTLAnnotationValidator{
+		ExcludedNamespaces: excludedNamespaces,
+		WarnExpiry:         warnExpiry,
+		NotifyAllowedHosts: notifyAllowedHosts,
+	}
+	for _, obj := range []client.Object{
+		&corev1.Pod{},
+		&corev1.Service{},
+		&appsv1.Deployment{},
+		&corev1.ConfigMap{},
+		&batchv1.Job{},
+	} {
+		if err := ctrl.NewWebhookManagedBy(mgr).For(obj).WithValidator(validator).Complete(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate--v1-pod,mutating=false,failurePolicy=ignore,sideEffects=None,groups="",resources=pods,verbs=create;update,versions=v1,name=vpod-ttl.ttl.example.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate--v1-service,mutating=false,failurePolicy=ignore,sideEffects=None,groups="",resources=services,verbs=create;update,versions=v1,name=vservice-ttl.ttl.example.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-apps-v1-deployment,mutating=false,failurePolicy=ignore,sideEffects=None,groups=apps,resources=deployments,verbs=create;update,versions=v1,name=vdeployment-ttl.ttl.example.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate--v1-configmap,mutating=false,failurePolicy=ignore,sideEffects=None,groups="",resources=configmaps,verbs=create;update,versions=v1,name=vconfigmap-ttl.ttl.example.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-batch-v1-job,mutating=false,failurePolicy=ignore,sideEffects=None,groups=batch,resources=jobs,verbs=create;update,versions=v1,name=vjob-ttl.ttl.example.com,admissionReviewVersions=v1
+
+// TTLAnnotationValidator는 TTL 삭제가 꺼진 네임스페이스의 리소스에 TTL annotation이 추가되면
+// 요청을 거부하지 않고 admission 경고로 annotation이 효과가 없음을 알려줍니다.
+// delete-if annotation의 CEL 식이 잘못되었으면 요청을 거부합니다.
+type TTLAnnotationValidator struct {
+	// ExcludedNamespaces는 operator의 --excluded-namespaces와 같은 값입니다
+	ExcludedNamespaces []string
+	// WarnExpiry가 true이면 TTL annotation과 함께 생성되는 리소스에 언제 만료되는지 경고로 알려줍니다
+	WarnExpiry bool
+	// NotifyAllowedHosts는 operator의 --notify-allowed-hosts와 같은 값입니다
+	NotifyAllowedHosts []string
+	// Clock은 생성 시각으로 사용할 시계입니다. nil이면 실제 시계를 사용합니다
+	Clock clock.Clock
+}
+
+var _ admission.CustomValidator = &TTLAnnotationValidator{}
+
+// ValidateCreate는 TTL annotation과 함께 생성되는 리소스에 경고를 반환합니다.
+// WarnExpiry가 켜져 있으면 만료 예정 시각도 함께 알려줍니다.
+func (v *TTLAnnotationValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	warnings := v.warnings(ctx, nil, obj)
+	if len(warnings) == 0 {
+		// 제외된 네임스페이스의 리소스는 만료되지 않으므로 만료 예정 시각을 알리지 않음
+		warnings = v.expiryWarnings(obj)
+	}
+	return warnings, v.validateAnnotations(nil, obj)
+}
+
+// ValidateUpdate는 TTL annotation이 추가되거나 바뀐 리소스에 경고를 반환합니다.
+func (v *TTLAnnotationValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return v.warnings(ctx, oldObj, newObj), v.validateAnnotations(oldObj, newObj)
+}
+
+// ValidateDelete는 아무 것도 하지 않습니다.
+func (v *TTLAnnotationValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateAnnotations는 값이 잘못되면 만료 처리가 의도와 다르게 동작하는 annotation을 확인합니다.
+func (v *TTLAnnotationValidator) validateAnnotations(oldObj, newObj runtime.Object) error {
+	if err := validateDeleteIf(oldObj, newObj); err != nil {
+		return err
+	}
+	return v.validateNotify(oldObj, newObj)
+}
+
+// validateDeleteIf는 newObj의 delete-if annotation이 새로 지정되었거나 바뀌었으면 CEL 식으로 컴파일되는지 확인합니다.
+// 이미 저장된 식을 그대로 두는 업데이트는 operator 버전이 바뀌어도 막히지 않도록 확인하지 않습니다.
+func validateDeleteIf(oldObj, newObj runtime.Object) error {
+	obj, ok := newObj.(client.Object)
+	if !ok {
+		return nil
+	}
+	expression, ok := obj.GetAnnotations()[controller.DeleteIfAnnotationKey]
+	if !ok {
+		return nil
+	}
+	if old, ok := oldObj.(client.Object); ok {
+		if previous, had := old.GetAnnotations()[controller.DeleteIfAnnotationKey]; had && previous == expression {
+			return nil
+		}
+	}
+	if _, err := controller.CompileDeleteIf(expression); err != nil {
+		return fmt.Errorf("invalid %s annotation %q: %w", controller.DeleteIfAnnotationKey, expression, err)
+	}
+	return nil
+}
+
+// validateNotify는 newObj의 notify annotation이 새로 지정되었거나 바뀌었으면
+// 알림 대상 형식이 올바르고 호스트가 NotifyAllowedHosts에 있는지 확인합니다.
+func (v *TTLAnnotationValidator) validateNotify(oldObj, newObj runtime.Object) error {
+	obj, ok := newObj.(client.Object)
+	if !ok {
+		return nil
+	}
+	value, ok := obj.GetAnnotations()[controller.NotifyAnnotationKey]
+	if !ok {
+		return nil
+	}
+	if old, ok := oldObj.(client.Object); ok {
+		if previous, had := old.GetAnnotations()[controller.NotifyAnnotationKey]; had && previous == value {
+			return nil
+		}
+	}
+	if _, err := controller.ParseNotifyTargets(value, v.NotifyAllowedHosts); err != nil {
+		return fmt.Errorf("invalid %s annotation: %w", controller.NotifyAnnotationKey, err)
+	}
+	return nil
+}
+
+// warnings는 newObj의 TTL annotation이 새로 지정되었고 네임스페이스가 제외되어 있으면 경고를 반환합니다.
+func (v *TTLAnnotationValidator) warnings(ctx context.Context, oldObj, newObj runtime.Object) admission.Warnings {
+	obj, ok := newObj.(client.Object)
+	if !ok {
+		return nil
+	}
+	value, hasTTL := obj.GetAnnotations()[controller.TTLAnnotationKey]
+	if !hasTTL {
+		return nil
+	}
+	// 이미 있던 annotation을 그대로 두는 업데이트에는 경고하지 않음
+	if old, ok := oldObj.(client.Object); ok && old.GetAnnotations()[controller.TTLAnnotationKey] == value {
+		return nil
+	}
+
+	// 생성 요청의 객체에는 네임스페이스가 비어 있을 수 있으므로 요청의 네임스페이스를 우선 사용
+	namespace := obj.GetNamespace()
+	if req, err := admission.RequestFromContext(ctx); err == nil && req.Namespace != "" {
+		namespace = req.Namespace
+	}
+	if !slices.Contains(v.ExcludedNamespaces, namespace) {
+		return nil
+	}
+
+	ttlannotationlog.V(1).Info("Warning about TTL annotation in excluded namespace", "namespace", namespace, "name", obj.GetName())
+	return admission.Warnings{fmt.Sprintf(
+		"annotation %s has no effect: namespace %q is listed in the ttl-operator --excluded-namespaces flag, so TTL deletions are disabled there",
+		controller.TTLAnnotationKey, namespace)}
+}
+
+// expiryWarnings는 WarnExpiry가 켜져 있으면 생성되는 리소스의 TTL annotation으로 계산한 만료 예정 시각을 경고로 반환합니다.
+// 생성 요청에는 아직 creationTimestamp가 없으므로 지금을 생성 시각으로 봅니다.
+// 잘못된 TTL 값은 reconcile이 owner에 Event로 알리므로 여기서는 경고하지 않습니다.
+func (v *TTLAnnotationValidator) expiryWarnings(newObj runtime.Object) admission.Warnings {
+	obj, ok := newObj.(client.Object)
+	if !v.WarnExpiry || !ok {
+		return nil
+	}
+	createdAt := obj.GetCreationTimestamp().Time
+	if createdAt.IsZero() {
+		createdAt = v.now()
+	}
+	preview, ok, err := controller.PreviewExpiry(obj.GetAnnotations(), createdAt)
+	if err != nil || !ok {
+		return nil
+	}
+	message := fmt.Sprintf("This resource will expire at %s", preview.ExpiredAt.UTC().Format(time.RFC3339))
+	if preview.MaxJitter > 0 {
+		message += fmt.Sprintf(" (plus up to %s of jitter)", preview.MaxJitter)
+	}
+	return admission.Warnings{message}
+}
+
+// now는 Clock의 현재 시각을 반환합니다. Clock이 없으면 실제 시각을 사용합니다.
+func (v *TTLAnnotationValidator) now() time.Time {
+	if v.Clock == nil {
+		return time.Now()
+	}
+	return v.Clock.Now()
+}
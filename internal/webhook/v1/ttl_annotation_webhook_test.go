@@ -0,0 +1,155 @@
+/*
+Copyright 2025 seoyeon.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/seoyeon0201/ttl-operator/internal/controller"
+)
+
+func podWithTTL(namespace, ttl string) *corev1.Pod {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: namespace}}
+	if ttl != "" {
+		pod.Annotations = map[string]string{controller.TTLAnnotationKey: ttl}
+	}
+	return pod
+}
+
+func TestTTLAnnotationValidatorWarnsInExcludedNamespace(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	v := &TTLAnnotationValidator{ExcludedNamespaces: []string{"kube-system", "prod"}}
+
+	warnings, err := v.ValidateCreate(ctx, podWithTTL("prod", "3600"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(warnings).To(HaveLen(1))
+	g.Expect(warnings[0]).To(ContainSubstring("--excluded-namespaces"))
+	g.Expect(warnings[0]).To(ContainSubstring(`"prod"`))
+
+	// 제외되지 않은 네임스페이스와 annotation이 없는 리소스에는 경고하지 않음
+	warnings, _ = v.ValidateCreate(ctx, podWithTTL("default", "3600"))
+	g.Expect(warnings).To(BeEmpty())
+	warnings, _ = v.ValidateCreate(ctx, podWithTTL("prod", ""))
+	g.Expect(warnings).To(BeEmpty())
+
+	// 업데이트에서는 annotation이 추가되거나 바뀐 경우에만 경고
+	warnings, _ = v.ValidateUpdate(ctx, podWithTTL("prod", ""), podWithTTL("prod", "3600"))
+	g.Expect(warnings).To(HaveLen(1))
+	warnings, _ = v.ValidateUpdate(ctx, podWithTTL("prod", "3600"), podWithTTL("prod", "7200"))
+	g.Expect(warnings).To(HaveLen(1))
+	warnings, _ = v.ValidateUpdate(ctx, podWithTTL("prod", "3600"), podWithTTL("prod", "3600"))
+	g.Expect(warnings).To(BeEmpty())
+}
+
+func TestTTLAnnotationValidatorUsesRequestNamespace(t *testing.T) {
+	g := NewWithT(t)
+	v := &TTLAnnotationValidator{ExcludedNamespaces: []string{"prod"}}
+
+	// 생성 요청의 객체에 네임스페이스가 없으면 요청의 네임스페이스를 사용
+	ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{Namespace: "prod"},
+	})
+	warnings, err := v.ValidateCreate(ctx, podWithTTL("", "3600"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(warnings).To(HaveLen(1))
+}
+
+func TestTTLAnnotationValidatorRejectsInvalidDeleteIf(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	v := &TTLAnnotationValidator{}
+	withDeleteIf := func(expression string) *corev1.Pod {
+		pod := podWithTTL("default", "3600")
+		pod.Annotations[controller.DeleteIfAnnotationKey] = expression
+		return pod
+	}
+
+	_, err := v.ValidateCreate(ctx, withDeleteIf("object.status.phase == 'Succeeded'"))
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = v.ValidateCreate(ctx, withDeleteIf("object.status.phase =="))
+	g.Expect(err).To(MatchError(ContainSubstring(controller.DeleteIfAnnotationKey)))
+	_, err = v.ValidateCreate(ctx, withDeleteIf("object.metadata.name + 'x'"))
+	g.Expect(err).To(MatchError(ContainSubstring("must evaluate to bool")))
+
+	// 이미 저장된 식을 그대로 두는 업데이트는 막지 않음
+	_, err = v.ValidateUpdate(ctx, withDeleteIf("1 +"), withDeleteIf("1 +"))
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = v.ValidateUpdate(ctx, podWithTTL("default", "3600"), withDeleteIf("1 +"))
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestTTLAnnotationValidatorRejectsInvalidNotifyTargets(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	v := &TTLAnnotationValidator{NotifyAllowedHosts: []string{"hooks.slack.com", "nats"}}
+	withNotify := func(value string) *corev1.Pod {
+		pod := podWithTTL("default", "3600")
+		pod.Annotations[controller.NotifyAnnotationKey] = value
+		return pod
+	}
+
+	_, err := v.ValidateCreate(ctx, withNotify("https://hooks.slack.com/services/T0/B0/x, nats://nats:4222/team-a"))
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = v.ValidateCreate(ctx, withNotify("#team-a"))
+	g.Expect(err).To(MatchError(ContainSubstring(controller.NotifyAnnotationKey)))
+	_, err = v.ValidateCreate(ctx, withNotify("http://169.254.169.254/latest/meta-data"))
+	g.Expect(err).To(MatchError(ContainSubstring("--notify-allowed-hosts")))
+
+	// 이미 저장된 값을 그대로 두는 업데이트는 막지 않음
+	_, err = v.ValidateUpdate(ctx, withNotify("#team-a"), withNotify("#team-a"))
+	g.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestTTLAnnotationValidatorWarnsExpiryOnCreate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	now := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	v := &TTLAnnotationValidator{ExcludedNamespaces: []string{"prod"}, WarnExpiry: true, Clock: clocktesting.NewFakeClock(now)}
+
+	warnings, err := v.ValidateCreate(ctx, podWithTTL("default", "3600"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(warnings).To(ConsistOf("This resource will expire at 2025-06-01T11:00:00Z"))
+
+	// 소수 초 TTL과 jitter도 reconcile과 같은 규칙으로 계산
+	pod := podWithTTL("default", "90.5")
+	pod.Annotations[controller.TTLJitterAnnotationKey] = "30"
+	warnings, _ = v.ValidateCreate(ctx, pod)
+	g.Expect(warnings).To(ConsistOf("This resource will expire at 2025-06-01T10:01:30Z (plus up to 30s of jitter)"))
+
+	// TTL이 없거나 disabled이거나 잘못된 값, 만료되지 않는 제외 네임스페이스에는 만료 시각을 알리지 않음
+	for _, pod := range []*corev1.Pod{podWithTTL("default", ""), podWithTTL("default", controller.TTLDisabledValue), podWithTTL("default", "soon")} {
+		warnings, _ = v.ValidateCreate(ctx, pod)
+		g.Expect(warnings).To(BeEmpty())
+	}
+	warnings, _ = v.ValidateCreate(ctx, podWithTTL("prod", "3600"))
+	g.Expect(warnings).To(HaveLen(1))
+	g.Expect(warnings[0]).To(ContainSubstring("--excluded-namespaces"))
+
+	// 업데이트에는 만료 시각을 알리지 않음
+	warnings, _ = v.ValidateUpdate(ctx, podWithTTL("default", ""), podWithTTL("default", "3600"))
+	g.Expect(warnings).To(BeEmpty())
+}